@@ -0,0 +1,27 @@
+package bolt
+
+import "os"
+
+const defaultPath = "oort.db"
+
+type Config interface {
+	Path() string
+}
+
+type config struct {
+	path string
+}
+
+func NewConfig() Config {
+	path := os.Getenv("BOLT_PATH")
+	if path == "" {
+		path = defaultPath
+	}
+	return config{
+		path: path,
+	}
+}
+
+func (c config) Path() string {
+	return c.path
+}
@@ -1,6 +1,7 @@
 package configs
 
 import (
+	"github.com/c12s/oort/internal/configs/bolt"
 	"github.com/c12s/oort/internal/configs/nats"
 	"github.com/c12s/oort/internal/configs/neo4j"
 	"github.com/c12s/oort/internal/configs/server"
@@ -8,21 +9,28 @@ import (
 
 type Config interface {
 	Neo4j() neo4j.Config
+	Bolt() bolt.Config
 	Nats() nats.Config
 	Server() server.Config
 }
 
 type config struct {
 	neo4j  neo4j.Config
+	bolt   bolt.Config
 	nats   nats.Config
 	server server.Config
 }
 
 func NewConfig() (Config, error) {
+	serverConfig, err := server.NewConfig()
+	if err != nil {
+		return nil, err
+	}
 	return &config{
 		neo4j:  neo4j.NewConfig(),
+		bolt:   bolt.NewConfig(),
 		nats:   nats.NewConfig(),
-		server: server.NewConfig(),
+		server: serverConfig,
 	}, nil
 }
 
@@ -30,6 +38,10 @@ func (c config) Neo4j() neo4j.Config {
 	return c.neo4j
 }
 
+func (c config) Bolt() bolt.Config {
+	return c.bolt
+}
+
 func (c config) Nats() nats.Config {
 	return c.nats
 }
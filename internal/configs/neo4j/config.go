@@ -3,35 +3,124 @@ package neo4j
 import (
 	"fmt"
 	"os"
+	"strconv"
+	"time"
 )
 
+const defaultScheme = "bolt"
+
+// Auth schemes oort can authenticate to neo4j with
+const (
+	AuthNone   = "none"
+	AuthBasic  = "basic"
+	AuthBearer = "bearer"
+)
+
+// Config holds the connection settings of the neo4j driver.
+// Pool settings and timeouts that are zero keep the driver defaults.
 type Config interface {
 	Uri() string
 	Username() string
 	Password() string
 	DbName() string
+	// AuthScheme defaults to basic if a username is set and to none otherwise
+	AuthScheme() string
+	BearerToken() string
+	// CaCertPath is a PEM file of the certificate authorities trusted instead of the system ones,
+	// TLS is only used with the bolt+s, bolt+ssc, neo4j+s and neo4j+ssc schemes
+	// and the CA is only accepted with bolt+s and neo4j+s, the +ssc schemes skip verification
+	CaCertPath() string
+	ClientCertPath() string
+	ClientKeyPath() string
+	MaxConnectionPoolSize() int
+	MaxConnectionLifetime() time.Duration
+	ConnectionAcquisitionTimeout() time.Duration
+	ConnectionLivenessCheckTimeout() time.Duration
+	SocketConnectTimeout() time.Duration
+	SocketKeepalive() bool
+	MaxTransactionRetryTime() time.Duration
 }
 
 type config struct {
-	hostname string
-	port     string
-	username string
-	password string
-	dbName   string
+	scheme                         string
+	hostname                       string
+	port                           string
+	username                       string
+	password                       string
+	dbName                         string
+	authScheme                     string
+	bearerToken                    string
+	caCertPath                     string
+	clientCertPath                 string
+	clientKeyPath                  string
+	maxConnectionPoolSize          int
+	maxConnectionLifetime          time.Duration
+	connectionAcquisitionTimeout   time.Duration
+	connectionLivenessCheckTimeout time.Duration
+	socketConnectTimeout           time.Duration
+	socketKeepalive                bool
+	maxTransactionRetryTime        time.Duration
 }
 
 func NewConfig() Config {
+	scheme := os.Getenv("NEO4J_SCHEME")
+	if scheme == "" {
+		scheme = defaultScheme
+	}
+	username := os.Getenv("NEO4J_USERNAME")
+	authScheme := os.Getenv("NEO4J_AUTH_SCHEME")
+	if authScheme == "" {
+		authScheme = AuthNone
+		if username != "" {
+			authScheme = AuthBasic
+		}
+	}
+	// the driver keeps sockets alive unless told otherwise
+	socketKeepalive, err := strconv.ParseBool(os.Getenv("NEO4J_SOCKET_KEEPALIVE"))
+	if err != nil {
+		socketKeepalive = true
+	}
 	return config{
-		hostname: os.Getenv("NEO4J_HOSTNAME"),
-		port:     os.Getenv("NEO4J_BOLT_PORT"),
-		username: os.Getenv("NEO4J_USERNAME"),
-		password: os.Getenv("NEO4J_PASSWORD"),
-		dbName:   os.Getenv("NEO4J_DBNAME"),
+		scheme:                         scheme,
+		hostname:                       os.Getenv("NEO4J_HOSTNAME"),
+		port:                           os.Getenv("NEO4J_BOLT_PORT"),
+		username:                       username,
+		password:                       os.Getenv("NEO4J_PASSWORD"),
+		dbName:                         os.Getenv("NEO4J_DBNAME"),
+		authScheme:                     authScheme,
+		bearerToken:                    os.Getenv("NEO4J_BEARER_TOKEN"),
+		caCertPath:                     os.Getenv("NEO4J_TLS_CA_CERT"),
+		clientCertPath:                 os.Getenv("NEO4J_TLS_CLIENT_CERT"),
+		clientKeyPath:                  os.Getenv("NEO4J_TLS_CLIENT_KEY"),
+		maxConnectionPoolSize:          positiveInt("NEO4J_MAX_CONNECTION_POOL_SIZE"),
+		maxConnectionLifetime:          positiveDuration("NEO4J_MAX_CONNECTION_LIFETIME"),
+		connectionAcquisitionTimeout:   positiveDuration("NEO4J_CONNECTION_ACQUISITION_TIMEOUT"),
+		connectionLivenessCheckTimeout: positiveDuration("NEO4J_CONNECTION_LIVENESS_CHECK_TIMEOUT"),
+		socketConnectTimeout:           positiveDuration("NEO4J_SOCKET_CONNECT_TIMEOUT"),
+		socketKeepalive:                socketKeepalive,
+		maxTransactionRetryTime:        positiveDuration("NEO4J_MAX_TRANSACTION_RETRY_TIME"),
+	}
+}
+
+// missing or malformed values are zero, so that the driver defaults are kept
+func positiveInt(key string) int {
+	value, err := strconv.Atoi(os.Getenv(key))
+	if err != nil || value <= 0 {
+		return 0
 	}
+	return value
+}
+
+func positiveDuration(key string) time.Duration {
+	value, err := time.ParseDuration(os.Getenv(key))
+	if err != nil || value <= 0 {
+		return 0
+	}
+	return value
 }
 
 func (c config) Uri() string {
-	return fmt.Sprintf("bolt://%s:%s", c.hostname, c.port)
+	return fmt.Sprintf("%s://%s:%s", c.scheme, c.hostname, c.port)
 }
 
 func (c config) Username() string {
@@ -45,3 +134,51 @@ func (c config) Password() string {
 func (c config) DbName() string {
 	return c.dbName
 }
+
+func (c config) AuthScheme() string {
+	return c.authScheme
+}
+
+func (c config) BearerToken() string {
+	return c.bearerToken
+}
+
+func (c config) CaCertPath() string {
+	return c.caCertPath
+}
+
+func (c config) ClientCertPath() string {
+	return c.clientCertPath
+}
+
+func (c config) ClientKeyPath() string {
+	return c.clientKeyPath
+}
+
+func (c config) MaxConnectionPoolSize() int {
+	return c.maxConnectionPoolSize
+}
+
+func (c config) MaxConnectionLifetime() time.Duration {
+	return c.maxConnectionLifetime
+}
+
+func (c config) ConnectionAcquisitionTimeout() time.Duration {
+	return c.connectionAcquisitionTimeout
+}
+
+func (c config) ConnectionLivenessCheckTimeout() time.Duration {
+	return c.connectionLivenessCheckTimeout
+}
+
+func (c config) SocketConnectTimeout() time.Duration {
+	return c.socketConnectTimeout
+}
+
+func (c config) SocketKeepalive() bool {
+	return c.socketKeepalive
+}
+
+func (c config) MaxTransactionRetryTime() time.Duration {
+	return c.maxTransactionRetryTime
+}
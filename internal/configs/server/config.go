@@ -1,21 +1,78 @@
 package server
 
-import "os"
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/c12s/oort/internal/domain"
+)
+
+const defaultPolicySweepInterval = time.Minute
+
+// Repos oort can store the resource graph in
+const (
+	RepoNeo4j = "neo4j"
+	// RepoMemory keeps the graph in memory, it is lost on restart
+	RepoMemory = "memory"
+	// RepoBolt keeps the graph in an embedded bbolt database file
+	RepoBolt = "bolt"
+)
 
 type Config interface {
 	Port() string
+	PolicySweepInterval() time.Duration
+	MaxInheritanceDepth() int
+	Repo() string
 }
 
 type config struct {
-	port string
+	port                string
+	policySweepInterval time.Duration
+	maxInheritanceDepth int
+	repo                string
 }
 
-func NewConfig() Config {
-	return config{
-		port: os.Getenv("OORT_PORT"),
+// NewConfig fails if OORT_REPO names an unknown repo, it defaults to RepoNeo4j if it is empty
+func NewConfig() (Config, error) {
+	// missing or malformed values fall back to the defaults
+	policySweepInterval, err := time.ParseDuration(os.Getenv("OORT_POLICY_SWEEP_INTERVAL"))
+	if err != nil || policySweepInterval <= 0 {
+		policySweepInterval = defaultPolicySweepInterval
 	}
+	maxInheritanceDepth, err := strconv.Atoi(os.Getenv("OORT_MAX_INHERITANCE_DEPTH"))
+	if err != nil || maxInheritanceDepth <= 0 {
+		maxInheritanceDepth = domain.DefaultMaxInheritanceDepth
+	}
+	repo := os.Getenv("OORT_REPO")
+	switch repo {
+	case "":
+		repo = RepoNeo4j
+	case RepoNeo4j, RepoMemory, RepoBolt:
+	default:
+		return nil, fmt.Errorf("unknown repo %q in OORT_REPO, expected %s, %s or %s", repo, RepoNeo4j, RepoMemory, RepoBolt)
+	}
+	return config{
+		port:                os.Getenv("OORT_PORT"),
+		policySweepInterval: policySweepInterval,
+		maxInheritanceDepth: maxInheritanceDepth,
+		repo:                repo,
+	}, nil
 }
 
 func (c config) Port() string {
 	return c.port
 }
+
+func (c config) PolicySweepInterval() time.Duration {
+	return c.policySweepInterval
+}
+
+func (c config) MaxInheritanceDepth() int {
+	return c.maxInheritanceDepth
+}
+
+func (c config) Repo() string {
+	return c.repo
+}
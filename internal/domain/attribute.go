@@ -1,5 +1,13 @@
 package domain
 
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var ErrAttributeValueInvalid = errors.New("attribute value doesn't match its kind")
+
 type AttributeKind int64
 
 const (
@@ -7,8 +15,68 @@ const (
 	Float64
 	String
 	Bool
+	StringList
+	Int64List
+	// Timestamp values are time.Time
+	Timestamp
+	// Duration values are time.Duration
+	Duration
 )
 
+func (kind AttributeKind) String() string {
+	switch kind {
+	case Int64:
+		return "int64"
+	case Float64:
+		return "float64"
+	case String:
+		return "string"
+	case Bool:
+		return "bool"
+	case StringList:
+		return "string list"
+	case Int64List:
+		return "int64 list"
+	case Timestamp:
+		return "timestamp"
+	case Duration:
+		return "duration"
+	default:
+		return "unknown"
+	}
+}
+
+func (kind AttributeKind) validValue(value interface{}) bool {
+	switch kind {
+	case Int64:
+		_, ok := value.(int64)
+		return ok
+	case Float64:
+		_, ok := value.(float64)
+		return ok
+	case String:
+		_, ok := value.(string)
+		return ok
+	case Bool:
+		_, ok := value.(bool)
+		return ok
+	case StringList:
+		_, ok := value.([]string)
+		return ok
+	case Int64List:
+		_, ok := value.([]int64)
+		return ok
+	case Timestamp:
+		_, ok := value.(time.Time)
+		return ok
+	case Duration:
+		_, ok := value.(time.Duration)
+		return ok
+	default:
+		return false
+	}
+}
+
 type AttributeId struct {
 	name string
 }
@@ -27,6 +95,8 @@ type Attribute struct {
 	id    AttributeId
 	kind  AttributeKind
 	value interface{}
+	// inheritable attributes are resolved for all descendants of their resource that don't define them
+	inheritable bool
 }
 
 func NewAttribute(id AttributeId, kind AttributeKind, value interface{}) (*Attribute, error) {
@@ -48,3 +118,18 @@ func (attr Attribute) Kind() AttributeKind {
 func (attr Attribute) Value() interface{} {
 	return attr.value
 }
+
+func (attr Attribute) Inheritable() bool {
+	return attr.inheritable
+}
+
+func (attr *Attribute) SetInheritable(inheritable bool) {
+	attr.inheritable = inheritable
+}
+
+func (attr Attribute) Validate() error {
+	if !attr.kind.validValue(attr.value) {
+		return fmt.Errorf("%w: %q is declared as %s, got %T", ErrAttributeValueInvalid, attr.Name(), attr.kind, attr.value)
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package domain
+
+import "sort"
+
+// ParentAttributePrefix prefixes the names under which the attributes of ancestors are exposed,
+// attribute a of the nearest ancestor of kind k is available as parent_k_a
+const ParentAttributePrefix = "parent_"
+
+func ParentAttributeName(ancestorKind, attrName string) string {
+	return ParentAttributePrefix + ancestorKind + "_" + attrName
+}
+
+// AncestorAttributes holds the attributes stored on an ancestor of a resource,
+// Distance is the length of the shortest INHERITS_FROM path from the resource to the ancestor
+type AncestorAttributes struct {
+	Ancestor   Resource
+	Distance   int
+	Attributes []Attribute
+}
+
+// ResolveAttributes extends the attributes of a resource with the attributes of its ancestors.
+// Inheritable attributes are added under their own name if the resource doesn't define them,
+// and every attribute of an ancestor is added under its parent name, in both cases the nearest ancestor wins.
+// Ancestors at the same distance are ordered by name, so that resolution is deterministic.
+func ResolveAttributes(own []Attribute, ancestors []AncestorAttributes) []Attribute {
+	sorted := append(make([]AncestorAttributes, 0, len(ancestors)), ancestors...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Distance != sorted[j].Distance {
+			return sorted[i].Distance < sorted[j].Distance
+		}
+		return sorted[i].Ancestor.Name() < sorted[j].Ancestor.Name()
+	})
+
+	resolved := append(make([]Attribute, 0, len(own)), own...)
+	present := make(map[string]bool, len(own))
+	for _, attr := range own {
+		present[attr.Name()] = true
+	}
+	add := func(name string, attr Attribute) {
+		if present[name] {
+			return
+		}
+		present[name] = true
+		attr.id = AttributeId{name: name}
+		resolved = append(resolved, attr)
+	}
+	for _, ancestor := range sorted {
+		for _, attr := range ancestor.Attributes {
+			if attr.Inheritable() {
+				add(attr.Name(), attr)
+			}
+			add(ParentAttributeName(ancestor.Ancestor.Kind(), attr.Name()), attr)
+		}
+	}
+	return resolved
+}
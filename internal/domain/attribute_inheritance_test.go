@@ -0,0 +1,101 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func inheritableAttr(name string, kind AttributeKind, value interface{}) Attribute {
+	a := attr(name, kind, value)
+	a.SetInheritable(true)
+	return a
+}
+
+func newTestAncestor(t *testing.T, name string, distance int, attrs ...Attribute) AncestorAttributes {
+	ancestor, err := NewResourceFromName(name)
+	assert.Nil(t, err)
+	return AncestorAttributes{Ancestor: *ancestor, Distance: distance, Attributes: attrs}
+}
+
+func TestResolveAttributes(t *testing.T) {
+	testCases := []struct {
+		own         []Attribute
+		ancestors   []AncestorAttributes
+		resolved    map[string]interface{}
+		description string
+	}{
+		{
+			own: []Attribute{attr("region", String, "eu")},
+			ancestors: []AncestorAttributes{
+				newTestAncestor(t, "org/1", 1, inheritableAttr("region", String, "us")),
+			},
+			resolved: map[string]interface{}{
+				"region":            "eu",
+				"parent_org_region": "us",
+			},
+			description: "own attribute wins",
+		},
+		{
+			ancestors: []AncestorAttributes{
+				newTestAncestor(t, "org/1", 2, inheritableAttr("region", String, "us")),
+				newTestAncestor(t, "team/1", 1, inheritableAttr("region", String, "eu")),
+			},
+			resolved: map[string]interface{}{
+				"region":             "eu",
+				"parent_org_region":  "us",
+				"parent_team_region": "eu",
+			},
+			description: "nearest ancestor wins",
+		},
+		{
+			ancestors: []AncestorAttributes{
+				newTestAncestor(t, "org/1", 1, attr("tenant_tier", String, "gold")),
+			},
+			resolved: map[string]interface{}{
+				"parent_org_tenant_tier": "gold",
+			},
+			description: "non inheritable attribute is only exposed under its parent name",
+		},
+		{
+			ancestors: []AncestorAttributes{
+				newTestAncestor(t, "org/2", 1, inheritableAttr("region", String, "us")),
+				newTestAncestor(t, "org/1", 1, inheritableAttr("region", String, "eu")),
+				newTestAncestor(t, "org/0", 2, inheritableAttr("region", String, "apac")),
+			},
+			resolved: map[string]interface{}{
+				"region":            "eu",
+				"parent_org_region": "eu",
+			},
+			description: "ancestors at the same distance are ordered by name",
+		},
+	}
+
+	for _, testCase := range testCases {
+		c := testCase
+		t.Run(c.description, func(t *testing.T) {
+			t.Parallel()
+			resolved := make(map[string]interface{})
+			for _, a := range ResolveAttributes(c.own, c.ancestors) {
+				_, duplicate := resolved[a.Name()]
+				assert.False(t, duplicate, a.Name())
+				resolved[a.Name()] = a.Value()
+			}
+			assert.Equal(t, c.resolved, resolved)
+		})
+	}
+}
+
+func TestParentAttributeCondition(t *testing.T) {
+	ancestors := []AncestorAttributes{newTestAncestor(t, "org/1", 1, attr("region", String, "eu"))}
+	object := ResolveAttributes(nil, ancestors)
+
+	condition, err := NewCondition(`obj_parent_org_region == "eu"`)
+	assert.Nil(t, err)
+	result := condition.Eval(nil, object, nil)
+	assert.Nil(t, result.Err)
+	assert.True(t, result.Value)
+
+	strict := ConditionTypeEnv{Object: ConditionScope{Kinds: map[string]AttributeKind{}, Strict: true}}
+	assert.Nil(t, condition.TypeCheck(strict))
+}
@@ -0,0 +1,91 @@
+package domain
+
+import "context"
+
+// ResourceAttributes holds everything the attributes of a resource are resolved from
+type ResourceAttributes struct {
+	// Attributes are the ones stored on the resource itself
+	Attributes []Attribute
+	Ancestors  []AncestorAttributes
+	// Schema is nil if no schema is defined for the kind of the resource
+	Schema *AttributeSchema
+}
+
+// Resolve extends the attributes of the resource with the attributes of its ancestors and the schema defaults,
+// inherited values take precedence over schema defaults
+func (r ResourceAttributes) Resolve() []Attribute {
+	attrs := ResolveAttributes(r.Attributes, r.Ancestors)
+	if r.Schema != nil {
+		return r.Schema.ApplyDefaults(attrs)
+	}
+	return attrs
+}
+
+// ReadAuthorizationData reads the data of an authorization check with the single purpose methods of the repo,
+// repos that answer those without a round trip run it inside one read to implement GetAuthorizationData
+func ReadAuthorizationData(ctx context.Context, repo RHABACRepo, req GetAuthorizationDataReq) GetAuthorizationDataResp {
+	hierarchyResp := repo.GetPermissionHierarchy(ctx, GetPermissionHierarchyReq{
+		Subject:        req.Subject,
+		Object:         req.Object,
+		PermissionName: req.PermissionName,
+	})
+	if hierarchyResp.Error != nil {
+		return GetAuthorizationDataResp{Error: hierarchyResp.Error}
+	}
+	algorithmResp := repo.GetCombiningAlgorithm(ctx, GetCombiningAlgorithmReq{PermissionName: req.PermissionName})
+	if algorithmResp.Error != nil {
+		return GetAuthorizationDataResp{Error: algorithmResp.Error}
+	}
+	subject, err := readResourceAttributes(ctx, repo, req.Subject)
+	if err != nil {
+		return GetAuthorizationDataResp{Error: err}
+	}
+	object, err := readResourceAttributes(ctx, repo, req.Object)
+	if err != nil {
+		return GetAuthorizationDataResp{Error: err}
+	}
+	constraintsResp := repo.GetSoDConstraints(ctx, GetSoDConstraintsReq{
+		Kind:           SoDDynamic,
+		PermissionName: req.PermissionName,
+	})
+	if constraintsResp.Error != nil {
+		return GetAuthorizationDataResp{Error: constraintsResp.Error}
+	}
+	breakGlassResp := repo.GetActiveBreakGlass(ctx, GetActiveBreakGlassReq{
+		Subject:        req.Subject,
+		Object:         req.Object,
+		PermissionName: req.PermissionName,
+		Now:            req.Now,
+	})
+	if breakGlassResp.Error != nil {
+		return GetAuthorizationDataResp{Error: breakGlassResp.Error}
+	}
+	return GetAuthorizationDataResp{
+		Hierarchy:      hierarchyResp.Hierarchy,
+		Algorithm:      algorithmResp.Algorithm,
+		Subject:        subject,
+		Object:         object,
+		SoDConstraints: constraintsResp.Constraints,
+		BreakGlass:     breakGlassResp.BreakGlass,
+	}
+}
+
+func readResourceAttributes(ctx context.Context, repo RHABACRepo, resource Resource) (ResourceAttributes, error) {
+	resourceResp := repo.GetResource(ctx, GetResourceReq{Resource: resource})
+	if resourceResp.Error != nil {
+		return ResourceAttributes{}, resourceResp.Error
+	}
+	ancestorsResp := repo.GetAncestorAttributes(ctx, GetAncestorAttributesReq{Resource: resource})
+	if ancestorsResp.Error != nil {
+		return ResourceAttributes{}, ancestorsResp.Error
+	}
+	schemaResp := repo.GetAttributeSchema(ctx, GetAttributeSchemaReq{ResourceKind: resource.Kind()})
+	if schemaResp.Error != nil {
+		return ResourceAttributes{}, schemaResp.Error
+	}
+	return ResourceAttributes{
+		Attributes: resourceResp.Resource.Attributes,
+		Ancestors:  ancestorsResp.Ancestors,
+		Schema:     schemaResp.Schema,
+	}, nil
+}
@@ -0,0 +1,120 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrBatchAborted   = errors.New("batch aborted, another operation failed")
+	ErrUnknownBatchOp = errors.New("batch operation unknown")
+)
+
+// BatchOp is an administration request that can be applied as part of a batch
+type BatchOp interface {
+	batchOp()
+}
+
+func (CreateResourceReq) batchOp()              {}
+func (DeleteResourceReq) batchOp()              {}
+func (PutAttributeReq) batchOp()                {}
+func (DeleteAttributeReq) batchOp()             {}
+func (CreateRelationReq) batchOp()              {}
+func (DeleteRelationReq) batchOp()              {}
+func (CreatePolicyReq) batchOp()                {}
+func (DeletePolicyReq) batchOp()                {}
+func (PutAttributeSchemaReq) batchOp()          {}
+func (DeleteAttributeSchemaReq) batchOp()       {}
+func (SetCombiningAlgorithmReq) batchOp()       {}
+func (CreatePermissionImplicationReq) batchOp() {}
+func (DeletePermissionImplicationReq) batchOp() {}
+func (CreateSoDConstraintReq) batchOp()         {}
+func (DeleteSoDConstraintReq) batchOp()         {}
+func (PutRelationTypeReq) batchOp()             {}
+func (DeleteRelationTypeReq) batchOp()          {}
+
+// BatchReq holds the ops to apply in order, either all of them are applied or none
+type BatchReq struct {
+	Ops []BatchOp
+}
+
+// BatchResp holds one result per op in the order of the request.
+// If the batch failed, the result of the failed op holds its error and the results of the other ops hold ErrBatchAborted
+type BatchResp struct {
+	Results []AdministrationResp
+	// Error is the error the batch failed with, nil if all ops were applied
+	Error error
+}
+
+// NewBatchResp builds the response of a batch of n ops, failed is the index of the op that failed with err.
+// A failure no op caused, like a failed commit, has a negative index and is the result of every op.
+func NewBatchResp(n, failed int, err error) BatchResp {
+	results := make([]AdministrationResp, n)
+	if err == nil {
+		return BatchResp{Results: results}
+	}
+	for i := range results {
+		switch {
+		case failed < 0 || i == failed:
+			results[i].Error = err
+		default:
+			results[i].Error = ErrBatchAborted
+		}
+	}
+	return BatchResp{Results: results, Error: err}
+}
+
+// ApplyBatchOp applies the op with the matching method of the repo,
+// repos that stage the ops of a batch apply them one by one through it
+func ApplyBatchOp(ctx context.Context, repo RHABACRepo, op BatchOp) AdministrationResp {
+	switch req := op.(type) {
+	case CreateResourceReq:
+		return repo.CreateResource(ctx, req)
+	case DeleteResourceReq:
+		return repo.DeleteResource(ctx, req)
+	case PutAttributeReq:
+		return repo.PutAttribute(ctx, req)
+	case DeleteAttributeReq:
+		return repo.DeleteAttribute(ctx, req)
+	case CreateRelationReq:
+		return repo.CreateRelation(ctx, req)
+	case DeleteRelationReq:
+		return repo.DeleteRelation(ctx, req)
+	case CreatePolicyReq:
+		return repo.CreatePolicy(ctx, req)
+	case DeletePolicyReq:
+		return repo.DeletePolicy(ctx, req)
+	case PutAttributeSchemaReq:
+		return repo.PutAttributeSchema(ctx, req)
+	case DeleteAttributeSchemaReq:
+		return repo.DeleteAttributeSchema(ctx, req)
+	case SetCombiningAlgorithmReq:
+		return repo.SetCombiningAlgorithm(ctx, req)
+	case CreatePermissionImplicationReq:
+		return repo.CreatePermissionImplication(ctx, req)
+	case DeletePermissionImplicationReq:
+		return repo.DeletePermissionImplication(ctx, req)
+	case CreateSoDConstraintReq:
+		return repo.CreateSoDConstraint(ctx, req)
+	case DeleteSoDConstraintReq:
+		return repo.DeleteSoDConstraint(ctx, req)
+	case PutRelationTypeReq:
+		return repo.PutRelationType(ctx, req)
+	case DeleteRelationTypeReq:
+		return repo.DeleteRelationType(ctx, req)
+	default:
+		return AdministrationResp{Error: fmt.Errorf("%w: %T", ErrUnknownBatchOp, op)}
+	}
+}
+
+// ApplyBatch applies the ops in order until one fails,
+// the caller has to discard the changes of the applied ops if the response holds an error
+func ApplyBatch(ctx context.Context, repo RHABACRepo, req BatchReq) BatchResp {
+	for i, op := range req.Ops {
+		if resp := ApplyBatchOp(ctx, repo, op); resp.Error != nil {
+			return NewBatchResp(len(req.Ops), i, resp.Error)
+		}
+	}
+	return NewBatchResp(len(req.Ops), -1, nil)
+}
@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewBatchResp(t *testing.T) {
+	errFailed := errors.New("failed")
+	testCases := []struct {
+		failed      int
+		err         error
+		results     []error
+		description string
+	}{
+		{
+			failed:      -1,
+			err:         nil,
+			results:     []error{nil, nil, nil},
+			description: "all ops applied",
+		},
+		{
+			failed:      1,
+			err:         errFailed,
+			results:     []error{ErrBatchAborted, errFailed, ErrBatchAborted},
+			description: "op failed",
+		},
+		{
+			failed:      -1,
+			err:         errFailed,
+			results:     []error{errFailed, errFailed, errFailed},
+			description: "batch failed without a failed op",
+		},
+	}
+	for _, testCase := range testCases {
+		c := testCase
+		t.Run(c.description, func(t *testing.T) {
+			t.Parallel()
+			resp := NewBatchResp(len(c.results), c.failed, c.err)
+			assert.Equal(t, c.err, resp.Error)
+			errs := make([]error, len(resp.Results))
+			for i, result := range resp.Results {
+				errs[i] = result.Error
+			}
+			assert.Equal(t, c.results, errs)
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// BreakGlassPermissionPrefix prefixes the permission that governs breaking glass,
+// a subject may break glass for permission p on an object only if it holds oort.breakglass.p on it
+const BreakGlassPermissionPrefix = "oort.breakglass."
+
+const (
+	DefaultBreakGlassDuration = time.Hour
+	MaxBreakGlassDuration     = 4 * time.Hour
+)
+
+var (
+	ErrBreakGlassJustificationEmpty = errors.New("break glass justification empty")
+	ErrBreakGlassDuration           = errors.New("break glass duration out of range")
+	ErrBreakGlassPermission         = errors.New("break glass not allowed for break glass permissions")
+	ErrBreakGlassNotPermitted       = errors.New("subject not permitted to break glass")
+)
+
+func BreakGlassPermissionName(permissionName string) string {
+	return BreakGlassPermissionPrefix + permissionName
+}
+
+func IsBreakGlassPermissionName(permissionName string) bool {
+	return strings.HasPrefix(permissionName, BreakGlassPermissionPrefix)
+}
+
+// BreakGlass is an emergency allow of one permission for a subject on an object,
+// it is recorded once as an audit entry and never modified afterwards
+type BreakGlass struct {
+	id             string
+	subject        Resource
+	object         Resource
+	permissionName string
+	justification  string
+	grantedAt      time.Time
+	expiresAt      time.Time
+}
+
+// NewBreakGlass uses the DefaultBreakGlassDuration if duration is zero
+func NewBreakGlass(id string, subject, object Resource, permissionName, justification string, grantedAt time.Time, duration time.Duration) (*BreakGlass, error) {
+	// glass is broken for one concrete permission, never for a whole wildcard namespace
+	if permissionName == "" || strings.Contains(permissionName, PermissionNameSingleWildcard) {
+		return nil, ErrInvalidPermissionName
+	}
+	if IsBreakGlassPermissionName(permissionName) {
+		return nil, ErrBreakGlassPermission
+	}
+	if strings.TrimSpace(justification) == "" {
+		return nil, ErrBreakGlassJustificationEmpty
+	}
+	if duration == 0 {
+		duration = DefaultBreakGlassDuration
+	}
+	if duration < 0 || duration > MaxBreakGlassDuration {
+		return nil, ErrBreakGlassDuration
+	}
+	return &BreakGlass{
+		id:             id,
+		subject:        subject,
+		object:         object,
+		permissionName: permissionName,
+		justification:  justification,
+		grantedAt:      grantedAt,
+		expiresAt:      grantedAt.Add(duration),
+	}, nil
+}
+
+// RestoreBreakGlass rebuilds a recorded break glass without validating it again
+func RestoreBreakGlass(id string, subject, object Resource, permissionName, justification string, grantedAt, expiresAt time.Time) BreakGlass {
+	return BreakGlass{
+		id:             id,
+		subject:        subject,
+		object:         object,
+		permissionName: permissionName,
+		justification:  justification,
+		grantedAt:      grantedAt,
+		expiresAt:      expiresAt,
+	}
+}
+
+func (b BreakGlass) Id() string {
+	return b.id
+}
+
+func (b BreakGlass) Subject() Resource {
+	return b.subject
+}
+
+func (b BreakGlass) Object() Resource {
+	return b.object
+}
+
+func (b BreakGlass) PermissionName() string {
+	return b.permissionName
+}
+
+func (b BreakGlass) Justification() string {
+	return b.justification
+}
+
+func (b BreakGlass) GrantedAt() time.Time {
+	return b.grantedAt
+}
+
+func (b BreakGlass) ExpiresAt() time.Time {
+	return b.expiresAt
+}
+
+func (b BreakGlass) Active(at time.Time) bool {
+	return !at.Before(b.grantedAt) && at.Before(b.expiresAt)
+}
+
+// BreakGlassUse records one check that a break glass allowed after the policies denied
+type BreakGlassUse struct {
+	breakGlassId string
+	usedAt       time.Time
+}
+
+func NewBreakGlassUse(breakGlassId string, usedAt time.Time) BreakGlassUse {
+	return BreakGlassUse{
+		breakGlassId: breakGlassId,
+		usedAt:       usedAt,
+	}
+}
+
+func (u BreakGlassUse) BreakGlassId() string {
+	return u.breakGlassId
+}
+
+func (u BreakGlassUse) UsedAt() time.Time {
+	return u.usedAt
+}
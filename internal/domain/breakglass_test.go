@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewBreakGlass(t *testing.T) {
+	grantedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		permissionName string
+		justification  string
+		duration       time.Duration
+		expiresAt      time.Time
+		err            error
+		description    string
+	}{
+		{
+			permissionName: "db.write",
+			justification:  "incident 42",
+			expiresAt:      grantedAt.Add(DefaultBreakGlassDuration),
+			description:    "default duration",
+		},
+		{
+			permissionName: "db.write",
+			justification:  "incident 42",
+			duration:       MaxBreakGlassDuration,
+			expiresAt:      grantedAt.Add(MaxBreakGlassDuration),
+			description:    "max duration",
+		},
+		{
+			permissionName: "db.write",
+			justification:  "incident 42",
+			duration:       MaxBreakGlassDuration + time.Second,
+			err:            ErrBreakGlassDuration,
+			description:    "duration too long",
+		},
+		{
+			permissionName: "db.write",
+			justification:  "incident 42",
+			duration:       -time.Second,
+			err:            ErrBreakGlassDuration,
+			description:    "negative duration",
+		},
+		{
+			permissionName: "db.write",
+			justification:  "  ",
+			err:            ErrBreakGlassJustificationEmpty,
+			description:    "blank justification",
+		},
+		{
+			permissionName: "db.*",
+			justification:  "incident 42",
+			err:            ErrInvalidPermissionName,
+			description:    "wildcard permission",
+		},
+		{
+			permissionName: BreakGlassPermissionName("db.write"),
+			justification:  "incident 42",
+			err:            ErrBreakGlassPermission,
+			description:    "break glass permission",
+		},
+	}
+
+	for _, testCase := range testCases {
+		c := testCase
+		t.Run(c.description, func(t *testing.T) {
+			t.Parallel()
+			breakGlass, err := NewBreakGlass("id", RootResource, RootResource, c.permissionName, c.justification, grantedAt, c.duration)
+			assert.ErrorIs(t, err, c.err)
+			if c.err != nil {
+				return
+			}
+			assert.Equal(t, c.expiresAt, breakGlass.ExpiresAt())
+			assert.True(t, breakGlass.Active(grantedAt))
+			assert.False(t, breakGlass.Active(c.expiresAt))
+			assert.False(t, breakGlass.Active(grantedAt.Add(-time.Second)))
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package domain
+
+import "errors"
+
+// CombiningAlgorithm decides how the results of the permissions found for a permission name are combined.
+// Levels are always visited from the nearest subject and object level to the farthest one.
+type CombiningAlgorithm int
+
+const (
+	// CombiningAlgorithmDenyOverrides lets the nearest level with an applicable permission decide,
+	// a deny in that level overrides any allow
+	CombiningAlgorithmDenyOverrides CombiningAlgorithm = iota
+	// CombiningAlgorithmPermitOverrides lets the nearest level with an applicable permission decide,
+	// an allow in that level overrides any deny
+	CombiningAlgorithmPermitOverrides
+	// CombiningAlgorithmFirstApplicable lets the first applicable permission of the nearest level decide,
+	// within a level the permissions are ordered by name with denies before allows
+	CombiningAlgorithmFirstApplicable
+	// CombiningAlgorithmDenyUnlessPermit allows if any permission in the hierarchy allows and denies otherwise
+	CombiningAlgorithmDenyUnlessPermit
+	// CombiningAlgorithmPermitUnlessDeny denies if any permission in the hierarchy denies and allows otherwise
+	CombiningAlgorithmPermitUnlessDeny
+)
+
+const DefaultCombiningAlgorithm = CombiningAlgorithmDenyOverrides
+
+var ErrUnknownCombiningAlgorithm = errors.New("combining algorithm unknown")
+
+func (a CombiningAlgorithm) String() string {
+	switch a {
+	case CombiningAlgorithmDenyOverrides:
+		return "deny-overrides"
+	case CombiningAlgorithmPermitOverrides:
+		return "permit-overrides"
+	case CombiningAlgorithmFirstApplicable:
+		return "first-applicable"
+	case CombiningAlgorithmDenyUnlessPermit:
+		return "deny-unless-permit"
+	case CombiningAlgorithmPermitUnlessDeny:
+		return "permit-unless-deny"
+	default:
+		return "unknown"
+	}
+}
+
+func (a CombiningAlgorithm) Validate() error {
+	if a < CombiningAlgorithmDenyOverrides || a > CombiningAlgorithmPermitUnlessDeny {
+		return ErrUnknownCombiningAlgorithm
+	}
+	return nil
+}
+
+// overriding returns the result that decides a level as soon as one permission produces it
+func (a CombiningAlgorithm) overriding() EvalResult {
+	if a == CombiningAlgorithmPermitOverrides || a == CombiningAlgorithmDenyUnlessPermit {
+		return EvalResultAllowed
+	}
+	return EvalResultDenied
+}
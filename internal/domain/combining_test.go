@@ -0,0 +1,179 @@
+package domain
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCombiningAlgorithms(t *testing.T) {
+	allow := newTestPermission(t, PermissionKindAllow, "")
+	deny := newTestPermission(t, PermissionKindDeny, "")
+	notApplicable := newTestPermission(t, PermissionKindDeny, "sub_clearance > 5")
+	sub := []Attribute{attr("clearance", Int64, int64(3))}
+
+	testCases := []struct {
+		hierarchy   PermissionHierarchy
+		results     map[CombiningAlgorithm]EvalResult
+		description string
+	}{
+		{
+			hierarchy: PermissionHierarchy{
+				0: PermissionObjHierarchy{0: PermissionLevel{deny, allow}},
+			},
+			results: map[CombiningAlgorithm]EvalResult{
+				CombiningAlgorithmDenyOverrides:    EvalResultDenied,
+				CombiningAlgorithmPermitOverrides:  EvalResultAllowed,
+				CombiningAlgorithmFirstApplicable:  EvalResultDenied,
+				CombiningAlgorithmDenyUnlessPermit: EvalResultAllowed,
+				CombiningAlgorithmPermitUnlessDeny: EvalResultDenied,
+			},
+			description: "deny before allow in the same level",
+		},
+		{
+			hierarchy: PermissionHierarchy{
+				0: PermissionObjHierarchy{0: PermissionLevel{allow, deny}},
+			},
+			results: map[CombiningAlgorithm]EvalResult{
+				CombiningAlgorithmDenyOverrides:    EvalResultDenied,
+				CombiningAlgorithmPermitOverrides:  EvalResultAllowed,
+				CombiningAlgorithmFirstApplicable:  EvalResultDenied,
+				CombiningAlgorithmDenyUnlessPermit: EvalResultAllowed,
+				CombiningAlgorithmPermitUnlessDeny: EvalResultDenied,
+			},
+			description: "allow before deny in the same level",
+		},
+		{
+			hierarchy: PermissionHierarchy{
+				0: PermissionObjHierarchy{
+					0:  PermissionLevel{allow},
+					-1: PermissionLevel{deny},
+				},
+			},
+			results: map[CombiningAlgorithm]EvalResult{
+				CombiningAlgorithmDenyOverrides:    EvalResultAllowed,
+				CombiningAlgorithmPermitOverrides:  EvalResultAllowed,
+				CombiningAlgorithmFirstApplicable:  EvalResultAllowed,
+				CombiningAlgorithmDenyUnlessPermit: EvalResultAllowed,
+				CombiningAlgorithmPermitUnlessDeny: EvalResultDenied,
+			},
+			description: "allow on the nearer object level, deny on the farther one",
+		},
+		{
+			hierarchy: PermissionHierarchy{
+				0: PermissionObjHierarchy{
+					0:  PermissionLevel{notApplicable},
+					-1: PermissionLevel{allow},
+				},
+			},
+			results: map[CombiningAlgorithm]EvalResult{
+				CombiningAlgorithmDenyOverrides:    EvalResultAllowed,
+				CombiningAlgorithmPermitOverrides:  EvalResultAllowed,
+				CombiningAlgorithmFirstApplicable:  EvalResultAllowed,
+				CombiningAlgorithmDenyUnlessPermit: EvalResultAllowed,
+				CombiningAlgorithmPermitUnlessDeny: EvalResultAllowed,
+			},
+			description: "nothing applicable on the nearer object level",
+		},
+		{
+			hierarchy: PermissionHierarchy{
+				0: PermissionObjHierarchy{
+					0:  PermissionLevel{notApplicable, deny},
+					-1: PermissionLevel{allow},
+				},
+			},
+			results: map[CombiningAlgorithm]EvalResult{
+				CombiningAlgorithmDenyOverrides:    EvalResultDenied,
+				CombiningAlgorithmPermitOverrides:  EvalResultDenied,
+				CombiningAlgorithmFirstApplicable:  EvalResultDenied,
+				CombiningAlgorithmDenyUnlessPermit: EvalResultAllowed,
+				CombiningAlgorithmPermitUnlessDeny: EvalResultDenied,
+			},
+			description: "only a deny applicable on the nearer object level",
+		},
+		{
+			hierarchy: PermissionHierarchy{
+				0:  PermissionObjHierarchy{0: PermissionLevel{allow}},
+				-1: PermissionObjHierarchy{0: PermissionLevel{deny}},
+			},
+			results: map[CombiningAlgorithm]EvalResult{
+				CombiningAlgorithmDenyOverrides:    EvalResultAllowed,
+				CombiningAlgorithmPermitOverrides:  EvalResultAllowed,
+				CombiningAlgorithmFirstApplicable:  EvalResultAllowed,
+				CombiningAlgorithmDenyUnlessPermit: EvalResultAllowed,
+				CombiningAlgorithmPermitUnlessDeny: EvalResultDenied,
+			},
+			description: "allow on the nearer subject level, deny inherited",
+		},
+		{
+			hierarchy: PermissionHierarchy{
+				0:  PermissionObjHierarchy{0: PermissionLevel{notApplicable}},
+				-1: PermissionObjHierarchy{0: PermissionLevel{allow}},
+			},
+			results: map[CombiningAlgorithm]EvalResult{
+				CombiningAlgorithmDenyOverrides:    EvalResultAllowed,
+				CombiningAlgorithmPermitOverrides:  EvalResultAllowed,
+				CombiningAlgorithmFirstApplicable:  EvalResultAllowed,
+				CombiningAlgorithmDenyUnlessPermit: EvalResultAllowed,
+				CombiningAlgorithmPermitUnlessDeny: EvalResultAllowed,
+			},
+			description: "nothing applicable on the nearest subject level",
+		},
+		{
+			hierarchy: PermissionHierarchy{
+				0:  PermissionObjHierarchy{0: PermissionLevel{notApplicable}, -1: PermissionLevel{notApplicable}},
+				-1: PermissionObjHierarchy{-1: PermissionLevel{deny}},
+				-2: PermissionObjHierarchy{0: PermissionLevel{allow}},
+			},
+			results: map[CombiningAlgorithm]EvalResult{
+				CombiningAlgorithmDenyOverrides:    EvalResultDenied,
+				CombiningAlgorithmPermitOverrides:  EvalResultDenied,
+				CombiningAlgorithmFirstApplicable:  EvalResultDenied,
+				CombiningAlgorithmDenyUnlessPermit: EvalResultAllowed,
+				CombiningAlgorithmPermitUnlessDeny: EvalResultDenied,
+			},
+			description: "nearest subject level with an applicable permission decides",
+		},
+		{
+			hierarchy: PermissionHierarchy{},
+			results: map[CombiningAlgorithm]EvalResult{
+				CombiningAlgorithmDenyOverrides:    EvalResultDenied,
+				CombiningAlgorithmPermitOverrides:  EvalResultDenied,
+				CombiningAlgorithmFirstApplicable:  EvalResultDenied,
+				CombiningAlgorithmDenyUnlessPermit: EvalResultDenied,
+				CombiningAlgorithmPermitUnlessDeny: EvalResultAllowed,
+			},
+			description: "no permissions",
+		},
+	}
+	for _, testCase := range testCases {
+		c := testCase
+		for algorithm, result := range c.results {
+			algorithm, result := algorithm, result
+			t.Run(fmt.Sprintf("%s - %s", c.description, algorithm), func(t *testing.T) {
+				t.Parallel()
+				decision := c.hierarchy.Eval(PermissionEvalRequest{Subject: sub, Algorithm: algorithm})
+				assert.Equal(t, result, decision.Result)
+			})
+		}
+	}
+}
+
+func TestCombiningAlgorithmDecidingPermission(t *testing.T) {
+	allow := newTestPermission(t, PermissionKindAllow, "")
+	deny := newTestPermission(t, PermissionKindDeny, "")
+	hierarchy := PermissionHierarchy{
+		0:  PermissionObjHierarchy{0: PermissionLevel{deny}},
+		-1: PermissionObjHierarchy{-2: PermissionLevel{allow}},
+	}
+	decision := hierarchy.Eval(PermissionEvalRequest{Algorithm: CombiningAlgorithmDenyUnlessPermit, Explain: true})
+	assert.Equal(t, EvalResultAllowed, decision.Result)
+	assert.Equal(t, &EvaluatedPermission{Permission: allow, SubjectPriority: -1, ObjectPriority: -2}, decision.Explanation.Deciding)
+}
+
+func TestCombiningAlgorithmValidate(t *testing.T) {
+	assert.Nil(t, CombiningAlgorithmPermitUnlessDeny.Validate())
+	assert.ErrorIs(t, CombiningAlgorithm(5).Validate(), ErrUnknownCombiningAlgorithm)
+	assert.ErrorIs(t, CombiningAlgorithm(-1).Validate(), ErrUnknownCombiningAlgorithm)
+}
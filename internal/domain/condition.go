@@ -2,62 +2,110 @@ package domain
 
 import (
 	"errors"
-	"github.com/Knetic/govaluate"
 	"go/ast"
 	"go/parser"
 	"go/token"
 	"strings"
+	"time"
 )
 
 type Condition struct {
 	expression string
+	language   ConditionLanguage
+	compiled   CompiledCondition
 }
 
 func NewCondition(expression string) (*Condition, error) {
-	if err := validate(expression); err != nil {
+	return NewConditionInLanguage(expression, DefaultConditionLanguage)
+}
+
+func NewConditionInLanguage(expression string, language ConditionLanguage) (*Condition, error) {
+	condition := &Condition{
+		expression: expression,
+		language:   language,
+	}
+	if condition.IsEmpty() {
+		return condition, nil
+	}
+	engine, err := conditionEngine(language)
+	if err != nil {
 		return nil, err
 	}
-	return &Condition{
-		expression: expression,
-	}, nil
+	compiled, err := compiledConditions.compile(engine, expression)
+	if err != nil {
+		return nil, err
+	}
+	condition.compiled = compiled
+	return condition, nil
 }
 
 func (c Condition) Expression() string {
 	return c.expression
 }
+
+func (c Condition) Language() ConditionLanguage {
+	return c.language
+}
+
 func (c Condition) IsEmpty() bool {
 	return c.expression == ""
 }
-func (c Condition) Eval(sub, obj, env []Attribute) bool {
-	if c.IsEmpty() {
-		return true
-	}
 
-	goeExpr, err := govaluate.NewEvaluableExpression(c.expression)
-	if err != nil {
-		return false
-	}
+// ConditionEvalResult separates conditions that evaluated to false
+// from conditions that couldn't be evaluated at all
+type ConditionEvalResult struct {
+	Value bool
+	// Err is set if the condition couldn't be evaluated, Value is false in that case
+	Err error
+	// MissingAttribute names the first variable that had no matching attribute
+	MissingAttribute string
+}
+
+func (r ConditionEvalResult) Errored() bool {
+	return r.Err != nil
+}
+
+func (c Condition) Eval(sub, obj, env []Attribute) ConditionEvalResult {
+	return c.EvalWithRelations(sub, obj, env, nil)
+}
 
-	parameters := make(map[string]interface{}, 8)
-	for _, attr := range sub {
-		parameters[SubVarNamePrefix+attr.Name()] = attr.Value()
+// EvalWithRelations evaluates a condition that may use graph predicates,
+// relations must hold every predicate returned by GraphPredicates
+func (c Condition) EvalWithRelations(sub, obj, env []Attribute, relations GraphRelations) ConditionEvalResult {
+	if c.IsEmpty() {
+		return ConditionEvalResult{Value: true}
 	}
-	for _, attr := range obj {
-		parameters[ObjVarNamePrefix+attr.Name()] = attr.Value()
+	engine, err := conditionEngine(c.language)
+	if err != nil {
+		return ConditionEvalResult{Err: err}
 	}
-	for _, attr := range env {
-		parameters[EnvVarNamePrefix+attr.Name()] = attr.Value()
+	compiled := c.compiled
+	if compiled == nil {
+		// conditions that weren't created through NewCondition are compiled on first use
+		compiled, err = compiledConditions.compile(engine, c.expression)
+		if err != nil {
+			return ConditionEvalResult{Err: err}
+		}
 	}
+	return engine.Eval(compiled, sub, obj, env, relations)
+}
 
-	result, err := goeExpr.Evaluate(parameters)
-	if err != nil {
-		return false
-	}
-	boolResult, ok := result.(bool)
-	if !ok {
-		return false
+// parameterValue converts timestamps to unix seconds and durations to seconds,
+// which is the representation govaluate uses for date literals,
+// so that they can be compared and combined with the usual arithmetic operators
+func parameterValue(attr Attribute) interface{} {
+	switch value := attr.Value().(type) {
+	case time.Time:
+		return unixSeconds(value)
+	case time.Duration:
+		return value.Seconds()
+	default:
+		return value
 	}
-	return boolResult
+}
+
+func unixSeconds(t time.Time) float64 {
+	return float64(t.UnixNano()) / float64(time.Second)
 }
 
 const (
@@ -87,6 +135,9 @@ var (
 	ErrInvalidVariableName = errors.New("expression variable name invalid")
 	ErrInvalidNode         = errors.New("expression nodes must be literals, variable names or supported operations")
 	ErrParsing             = errors.New("not an expression")
+	ErrMissingAttribute    = errors.New("condition attribute missing")
+	ErrConditionEval       = errors.New("condition evaluation failed")
+	ErrConditionNotBool    = errors.New("condition result isn't a bool")
 )
 
 func validate(expression string) error {
@@ -97,6 +148,11 @@ func validate(expression string) error {
 	if err != nil {
 		return ErrParsing
 	}
+	return validateExpr(expr)
+}
+
+func validateExpr(expr ast.Expr) error {
+	var err error
 	ast.Inspect(expr, func(n ast.Node) bool {
 		switch x := n.(type) {
 		case *ast.BasicLit:
@@ -109,6 +165,20 @@ func validate(expression string) error {
 			if !validOperation(x.Op) {
 				err = ErrInvalidOperation
 			}
+		case *ast.CallExpr:
+			// function names aren't variables, so only the arguments are inspected further
+			if err = validateCall(x); err != nil {
+				return false
+			}
+			if conditionFunctions[x.Fun.(*ast.Ident).Name].graph {
+				return false
+			}
+			for _, arg := range x.Args {
+				if err = validateExpr(arg); err != nil {
+					break
+				}
+			}
+			return false
 		case nil:
 		default:
 			err = ErrInvalidNode
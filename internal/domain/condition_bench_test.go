@@ -0,0 +1,91 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/Knetic/govaluate"
+)
+
+const benchmarkExpression = "sub_clearance >= obj_level && (env_region == \"eu\" || sub_admin)"
+
+var (
+	benchmarkSub = []Attribute{
+		{id: AttributeId{name: "clearance"}, kind: Int64, value: int64(5)},
+		{id: AttributeId{name: "admin"}, kind: Bool, value: false},
+	}
+	benchmarkObj = []Attribute{
+		{id: AttributeId{name: "level"}, kind: Int64, value: int64(3)},
+	}
+	benchmarkEnv = []Attribute{
+		{id: AttributeId{name: "region"}, kind: String, value: "eu"},
+	}
+	benchmarkResult bool
+)
+
+// BenchmarkConditionEvalParsed measures the previous evaluation path,
+// which parsed the expression on every call
+func BenchmarkConditionEvalParsed(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		expr, err := govaluate.NewEvaluableExpression(benchmarkExpression)
+		if err != nil {
+			b.Fatal(err)
+		}
+		parameters := make(map[string]interface{}, 8)
+		for _, attr := range benchmarkSub {
+			parameters[SubVarNamePrefix+attr.Name()] = attr.Value()
+		}
+		for _, attr := range benchmarkObj {
+			parameters[ObjVarNamePrefix+attr.Name()] = attr.Value()
+		}
+		for _, attr := range benchmarkEnv {
+			parameters[EnvVarNamePrefix+attr.Name()] = attr.Value()
+		}
+		result, err := expr.Evaluate(parameters)
+		if err != nil {
+			b.Fatal(err)
+		}
+		benchmarkResult = result.(bool)
+	}
+}
+
+func BenchmarkConditionEvalCompiled(b *testing.B) {
+	condition, err := NewCondition(benchmarkExpression)
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchmarkResult = condition.Eval(benchmarkSub, benchmarkObj, benchmarkEnv).Value
+	}
+}
+
+// BenchmarkConditionLoadAndEval measures the path taken by the repo mappers,
+// where the condition is rebuilt from its stored text before every evaluation
+func BenchmarkConditionLoadAndEval(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		condition, err := NewCondition(benchmarkExpression)
+		if err != nil {
+			b.Fatal(err)
+		}
+		benchmarkResult = condition.Eval(benchmarkSub, benchmarkObj, benchmarkEnv).Value
+	}
+}
+
+func BenchmarkConditionLoadAndEvalParallel(b *testing.B) {
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		var result bool
+		for pb.Next() {
+			condition, err := NewCondition(benchmarkExpression)
+			if err != nil {
+				b.Error(err)
+				return
+			}
+			result = condition.Eval(benchmarkSub, benchmarkObj, benchmarkEnv).Value
+		}
+		benchmarkResult = result
+	})
+}
@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"container/list"
+	"sync"
+)
+
+const DefaultConditionCacheSize = 4096
+
+// conditionCache is a bounded LRU cache of validated and compiled expressions keyed by language and expression text.
+// Conditions are rebuilt from their stored text on every permission hierarchy lookup,
+// so the cache is what keeps the hot evaluation path from re-parsing the same expressions.
+type conditionCache struct {
+	mu       sync.Mutex
+	capacity int
+	entries  map[conditionCacheKey]*list.Element
+	order    *list.List
+}
+
+type conditionCacheKey struct {
+	language   ConditionLanguage
+	expression string
+}
+
+type conditionCacheEntry struct {
+	key      conditionCacheKey
+	compiled CompiledCondition
+}
+
+func newConditionCache(capacity int) *conditionCache {
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &conditionCache{
+		capacity: capacity,
+		entries:  make(map[conditionCacheKey]*list.Element, capacity),
+		order:    list.New(),
+	}
+}
+
+var compiledConditions = newConditionCache(DefaultConditionCacheSize)
+
+func (c *conditionCache) compile(engine ConditionEngine, expression string) (CompiledCondition, error) {
+	key := conditionCacheKey{language: engine.Language(), expression: expression}
+	if compiled, ok := c.get(key); ok {
+		return compiled, nil
+	}
+	// only valid expressions are cached, so a hit also skips validation,
+	// compiling is done outside of the lock, concurrent misses for the same expression
+	// may both compile it, but only one of the results is kept
+	compiled, err := engine.Compile(expression)
+	if err != nil {
+		return nil, err
+	}
+	return c.put(key, compiled), nil
+}
+
+func (c *conditionCache) get(key conditionCacheKey) (CompiledCondition, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(elem)
+	return elem.Value.(*conditionCacheEntry).compiled, true
+}
+
+func (c *conditionCache) put(key conditionCacheKey, compiled CompiledCondition) CompiledCondition {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if elem, ok := c.entries[key]; ok {
+		c.order.MoveToFront(elem)
+		return elem.Value.(*conditionCacheEntry).compiled
+	}
+	c.entries[key] = c.order.PushFront(&conditionCacheEntry{
+		key:      key,
+		compiled: compiled,
+	})
+	for c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*conditionCacheEntry).key)
+	}
+	return compiled
+}
+
+func (c *conditionCache) len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConditionCacheReusesCompiledExpressions(t *testing.T) {
+	cache := newConditionCache(2)
+	first, err := cache.compile(govaluateEngine{}, "sub_age > 18")
+	assert.Nil(t, err)
+	second, err := cache.compile(govaluateEngine{}, "sub_age > 18")
+	assert.Nil(t, err)
+	assert.Same(t, first, second)
+	assert.Equal(t, 1, cache.len())
+}
+
+func TestConditionCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	cache := newConditionCache(2)
+	first, _ := cache.compile(govaluateEngine{}, "sub_a == 1")
+	_, _ = cache.compile(govaluateEngine{}, "sub_b == 1")
+	// touch the first expression so that the second one is evicted
+	_, _ = cache.compile(govaluateEngine{}, "sub_a == 1")
+	_, _ = cache.compile(govaluateEngine{}, "sub_c == 1")
+
+	assert.Equal(t, 2, cache.len())
+	_, ok := cache.get(conditionCacheKey{language: ConditionLanguageGovaluate, expression: "sub_b == 1"})
+	assert.False(t, ok)
+	cached, ok := cache.get(conditionCacheKey{language: ConditionLanguageGovaluate, expression: "sub_a == 1"})
+	assert.True(t, ok)
+	assert.Same(t, first, cached)
+}
+
+func TestConditionCacheConcurrentAccess(t *testing.T) {
+	cache := newConditionCache(16)
+	wg := sync.WaitGroup{}
+	for i := 0; i < 64; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, err := cache.compile(govaluateEngine{}, fmt.Sprintf("sub_attr == %d", i%32))
+			assert.Nil(t, err)
+		}(i)
+	}
+	wg.Wait()
+	assert.Equal(t, 16, cache.len())
+}
+
+func TestConditionEvalWithoutConstructor(t *testing.T) {
+	condition := Condition{expression: "sub_age > 18"}
+	sub := []Attribute{{id: AttributeId{name: "age"}, kind: Int64, value: int64(20)}}
+	assert.True(t, condition.Eval(sub, nil, nil).Value)
+}
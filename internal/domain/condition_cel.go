@@ -0,0 +1,150 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/cel-go/cel"
+	celast "github.com/google/cel-go/common/ast"
+)
+
+const (
+	CELSubVarName = "sub"
+	CELObjVarName = "obj"
+	CELEnvVarName = "env"
+)
+
+// DefaultCELCostLimit bounds the estimated runtime cost of a single CEL evaluation
+const DefaultCELCostLimit = 100000
+
+// celEngine evaluates Common Expression Language expressions,
+// attributes are exposed as the sub, obj and env maps keyed by attribute name
+type celEngine struct {
+	env       *cel.Env
+	costLimit uint64
+}
+
+type celCondition struct {
+	program cel.Program
+	// selected holds the attribute names selected from each of the variables,
+	// it is used to name the missing attribute if the evaluation fails on a missing key
+	selected map[string]map[string]bool
+}
+
+func newCELEngine() celEngine {
+	env, err := cel.NewEnv(
+		cel.Variable(CELSubVarName, cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable(CELObjVarName, cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable(CELEnvVarName, cel.MapType(cel.StringType, cel.DynType)),
+	)
+	if err != nil {
+		// the declarations are static, so this can only fail on a programming error
+		panic(err)
+	}
+	return celEngine{env: env, costLimit: DefaultCELCostLimit}
+}
+
+func (e celEngine) Language() ConditionLanguage {
+	return ConditionLanguageCEL
+}
+
+func (e celEngine) Validate(expression string) error {
+	_, err := e.check(expression)
+	return err
+}
+
+func (e celEngine) Compile(expression string) (CompiledCondition, error) {
+	checked, err := e.check(expression)
+	if err != nil {
+		return nil, err
+	}
+	program, err := e.env.Program(checked, cel.CostLimit(e.costLimit))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrParsing, err.Error())
+	}
+	return &celCondition{
+		program:  program,
+		selected: celSelectedAttributes(checked),
+	}, nil
+}
+
+func (e celEngine) check(expression string) (*cel.Ast, error) {
+	checked, issues := e.env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, fmt.Errorf("%w: %s", ErrParsing, issues.Err().Error())
+	}
+	outputType := checked.OutputType()
+	if !outputType.IsExactType(cel.BoolType) && !outputType.IsExactType(cel.DynType) {
+		return nil, fmt.Errorf("%w, got %s", ErrConditionNotBool, outputType)
+	}
+	return checked, nil
+}
+
+func (e celEngine) Eval(compiled CompiledCondition, sub, obj, env []Attribute, relations GraphRelations) ConditionEvalResult {
+	condition, ok := compiled.(*celCondition)
+	if !ok {
+		return ConditionEvalResult{Err: fmt.Errorf("%w: not a cel program", ErrConditionEval)}
+	}
+	activation := map[string]interface{}{
+		CELSubVarName: celAttributes(sub),
+		CELObjVarName: celAttributes(obj),
+		CELEnvVarName: celAttributes(env),
+	}
+	result, _, err := condition.program.Eval(activation)
+	if err != nil {
+		if missing := condition.missingAttribute(activation, err); missing != "" {
+			return ConditionEvalResult{
+				Err:              fmt.Errorf("%w: %s", ErrMissingAttribute, missing),
+				MissingAttribute: missing,
+			}
+		}
+		return ConditionEvalResult{Err: fmt.Errorf("%w: %s", ErrConditionEval, err.Error())}
+	}
+	boolResult, ok := result.Value().(bool)
+	if !ok {
+		return ConditionEvalResult{Err: fmt.Errorf("%w, got %T", ErrConditionNotBool, result.Value())}
+	}
+	return ConditionEvalResult{Value: boolResult}
+}
+
+func (c *celCondition) missingAttribute(activation map[string]interface{}, err error) string {
+	key, ok := strings.CutPrefix(err.Error(), "no such key: ")
+	if !ok {
+		return ""
+	}
+	for _, variable := range []string{CELSubVarName, CELObjVarName, CELEnvVarName} {
+		attrs := activation[variable].(map[string]interface{})
+		if _, present := attrs[key]; !present && c.selected[variable][key] {
+			return variable + "." + key
+		}
+	}
+	return key
+}
+
+func celAttributes(attrs []Attribute) map[string]interface{} {
+	values := make(map[string]interface{}, len(attrs))
+	for _, attr := range attrs {
+		values[attr.Name()] = attr.Value()
+	}
+	return values
+}
+
+func celSelectedAttributes(checked *cel.Ast) map[string]map[string]bool {
+	selected := make(map[string]map[string]bool)
+	visitor := celast.NewExprVisitor(func(expr celast.Expr) {
+		if expr.Kind() != celast.SelectKind {
+			return
+		}
+		sel := expr.AsSelect()
+		operand := sel.Operand()
+		if sel.IsTestOnly() || operand.Kind() != celast.IdentKind {
+			return
+		}
+		if selected[operand.AsIdent()] == nil {
+			selected[operand.AsIdent()] = make(map[string]bool)
+		}
+		selected[operand.AsIdent()][sel.FieldName()] = true
+	})
+	celast.PostOrderVisit(checked.NativeRep().Expr(), visitor)
+	return selected
+}
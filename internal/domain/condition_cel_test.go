@@ -0,0 +1,127 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConditionCELEval(t *testing.T) {
+	now := time.Now()
+	sub := []Attribute{
+		attr("clearance", Int64, int64(3)),
+		attr("roles", StringList, []string{"dev", "ops"}),
+		attr("since", Timestamp, now.Add(-48*time.Hour)),
+	}
+	obj := []Attribute{
+		attr("clearance", Int64, int64(2)),
+		attr("path", String, "/var/log/app"),
+	}
+	env := []Attribute{attr("session", Duration, 10*time.Minute)}
+	testCases := []struct {
+		expression       string
+		value            bool
+		err              error
+		missingAttribute string
+		description      string
+	}{
+		{
+			expression:  "sub.clearance >= obj.clearance",
+			value:       true,
+			description: "comparing attributes",
+		},
+		{
+			expression:  "'ops' in sub.roles && sub.roles.exists(r, r.startsWith('d'))",
+			value:       true,
+			description: "list membership and macros",
+		},
+		{
+			expression:  "obj.path.startsWith('/etc')",
+			value:       false,
+			description: "string functions",
+		},
+		{
+			expression:  "sub.since < timestamp('2000-01-01T00:00:00Z') || env.session > duration('1h')",
+			value:       false,
+			description: "timestamps and durations",
+		},
+		{
+			expression:  "has(obj.owner) && obj.owner == 'alice'",
+			value:       false,
+			description: "has macro on a missing attribute",
+		},
+		{
+			expression:       "obj.owner == 'alice'",
+			err:              ErrMissingAttribute,
+			missingAttribute: "obj.owner",
+			description:      "missing attribute",
+		},
+		{
+			expression:  "obj.path > 2",
+			err:         ErrConditionEval,
+			description: "type mismatch at runtime",
+		},
+	}
+	for _, testCase := range testCases {
+		c := testCase
+		t.Run(c.description, func(t *testing.T) {
+			t.Parallel()
+			condition, err := NewConditionInLanguage(c.expression, ConditionLanguageCEL)
+			if !assert.Nil(t, err) {
+				return
+			}
+			result := condition.Eval(sub, obj, env)
+			assert.Equal(t, c.value, result.Value)
+			assert.ErrorIs(t, result.Err, c.err)
+			assert.Equal(t, c.missingAttribute, result.MissingAttribute)
+		})
+	}
+}
+
+func TestConditionCELCompileErrors(t *testing.T) {
+	testCases := []struct {
+		expression  string
+		err         error
+		description string
+	}{
+		{
+			expression:  "sub.clearance >",
+			err:         ErrParsing,
+			description: "syntax error",
+		},
+		{
+			expression:  "subject.clearance > 1",
+			err:         ErrParsing,
+			description: "undeclared variable",
+		},
+		{
+			expression:  "1 + 2",
+			err:         ErrConditionNotBool,
+			description: "non bool result",
+		},
+	}
+	for _, testCase := range testCases {
+		c := testCase
+		t.Run(c.description, func(t *testing.T) {
+			t.Parallel()
+			_, err := NewConditionInLanguage(c.expression, ConditionLanguageCEL)
+			assert.ErrorIs(t, err, c.err)
+		})
+	}
+}
+
+func TestConditionCacheKeyedByLanguage(t *testing.T) {
+	cache := newConditionCache(4)
+	govaluateCompiled, err := cache.compile(govaluateEngine{}, "1 == 1")
+	assert.Nil(t, err)
+	celCompiled, err := cache.compile(newCELEngine(), "1 == 1")
+	assert.Nil(t, err)
+	assert.NotSame(t, govaluateCompiled, celCompiled)
+	assert.Equal(t, 2, cache.len())
+}
+
+func TestConditionUnknownLanguage(t *testing.T) {
+	_, err := NewConditionInLanguage("sub_a == 1", ConditionLanguage(42))
+	assert.ErrorIs(t, err, ErrUnknownConditionLanguage)
+}
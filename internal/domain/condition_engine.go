@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"errors"
+)
+
+type ConditionLanguage int
+
+const (
+	ConditionLanguageGovaluate ConditionLanguage = iota
+	ConditionLanguageCEL
+)
+
+const DefaultConditionLanguage = ConditionLanguageGovaluate
+
+func (l ConditionLanguage) String() string {
+	switch l {
+	case ConditionLanguageGovaluate:
+		return "govaluate"
+	case ConditionLanguageCEL:
+		return "cel"
+	default:
+		return "unknown"
+	}
+}
+
+var ErrUnknownConditionLanguage = errors.New("condition language unknown")
+
+// CompiledCondition is an engine specific representation of an expression,
+// it must be safe to evaluate concurrently
+type CompiledCondition interface{}
+
+// ConditionEngine validates, compiles and evaluates the expressions of one condition language
+type ConditionEngine interface {
+	Language() ConditionLanguage
+	// Validate checks the expression without compiling it for evaluation
+	Validate(expression string) error
+	// Compile validates the expression and prepares it for evaluation
+	Compile(expression string) (CompiledCondition, error)
+	Eval(compiled CompiledCondition, sub, obj, env []Attribute, relations GraphRelations) ConditionEvalResult
+}
+
+var conditionEngines = map[ConditionLanguage]ConditionEngine{
+	ConditionLanguageGovaluate: govaluateEngine{},
+	ConditionLanguageCEL:       newCELEngine(),
+}
+
+func conditionEngine(language ConditionLanguage) (ConditionEngine, error) {
+	engine, ok := conditionEngines[language]
+	if !ok {
+		return nil, ErrUnknownConditionLanguage
+	}
+	return engine, nil
+}
@@ -0,0 +1,113 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConditionEvalResult(t *testing.T) {
+	sub := []Attribute{attr("clearance", Int64, int64(3)), attr("name", String, "alice")}
+	testCases := []struct {
+		expression       string
+		value            bool
+		err              error
+		missingAttribute string
+		description      string
+	}{
+		{
+			expression:  "sub_clearance > 2",
+			value:       true,
+			description: "condition true",
+		},
+		{
+			expression:  "sub_clearance > 5",
+			value:       false,
+			description: "condition false",
+		},
+		{
+			expression:       "sub_clearance > obj_clearance",
+			err:              ErrMissingAttribute,
+			missingAttribute: "obj_clearance",
+			description:      "missing attribute",
+		},
+		{
+			expression:  "sub_name > 2",
+			err:         ErrConditionEval,
+			description: "type mismatch",
+		},
+		{
+			expression:  "sub_clearance + 1",
+			err:         ErrConditionNotBool,
+			description: "non bool result",
+		},
+	}
+	for _, testCase := range testCases {
+		c := testCase
+		t.Run(c.description, func(t *testing.T) {
+			t.Parallel()
+			condition, err := NewCondition(c.expression)
+			if !assert.Nil(t, err) {
+				return
+			}
+			result := condition.Eval(sub, nil, nil)
+			assert.Equal(t, c.value, result.Value)
+			assert.ErrorIs(t, result.Err, c.err)
+			assert.Equal(t, c.err != nil, result.Errored())
+			assert.Equal(t, c.missingAttribute, result.MissingAttribute)
+		})
+	}
+}
+
+func TestPermissionConditionErrorPolicy(t *testing.T) {
+	broken, err := NewCondition("sub_missing == 1")
+	assert.Nil(t, err)
+	empty, err := NewCondition("")
+	assert.Nil(t, err)
+	testCases := []struct {
+		policy      ConditionErrorPolicy
+		kind        PermissionKind
+		result      EvalResult
+		description string
+	}{
+		{
+			policy:      ConditionErrorSkip,
+			kind:        PermissionKindDeny,
+			result:      EvalResultAllowed,
+			description: "skipped deny doesn't override an allow",
+		},
+		{
+			policy:      ConditionErrorDeny,
+			kind:        PermissionKindDeny,
+			result:      EvalResultDenied,
+			description: "deny failing closed",
+		},
+		{
+			policy:      ConditionErrorDeny,
+			kind:        PermissionKindAllow,
+			result:      EvalResultDenied,
+			description: "allow failing closed",
+		},
+	}
+	for _, testCase := range testCases {
+		c := testCase
+		t.Run(c.description, func(t *testing.T) {
+			t.Parallel()
+			brokenPerm, err := NewPermission("p", c.kind, *broken, c.policy, nil)
+			assert.Nil(t, err)
+			allowPerm, err := NewPermission("p", PermissionKindAllow, *empty, ConditionErrorSkip, nil)
+			assert.Nil(t, err)
+			hierarchy := PermissionHierarchy{
+				0: PermissionObjHierarchy{
+					0: PermissionLevel{*brokenPerm, *allowPerm},
+				},
+			}
+			decision := hierarchy.Eval(PermissionEvalRequest{})
+			assert.Equal(t, c.result, decision.Result)
+			if assert.Len(t, decision.ConditionErrors, 1) {
+				assert.Equal(t, "sub_missing", decision.ConditionErrors[0].MissingAttribute)
+				assert.ErrorIs(t, decision.ConditionErrors[0], ErrMissingAttribute)
+			}
+		})
+	}
+}
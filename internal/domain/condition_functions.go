@@ -0,0 +1,351 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"net"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/Knetic/govaluate"
+)
+
+var (
+	ErrUnknownFunction      = errors.New("expression function unknown")
+	ErrInvalidArgumentCount = errors.New("expression function called with an invalid number of arguments")
+	ErrInvalidArgument      = errors.New("expression function argument invalid")
+)
+
+const variadic = -1
+
+type conditionFunction struct {
+	minArgs int
+	maxArgs int
+	// validateArgs checks literal arguments at validation time, it is optional
+	validateArgs func(args []ast.Expr) error
+	eval         govaluate.ExpressionFunction
+	// graph predicates take the sub and obj operands instead of attributes,
+	// all of their arguments are checked by validateArgs
+	graph bool
+}
+
+var conditionFunctions = map[string]conditionFunction{
+	"startsWith":          {minArgs: 2, maxArgs: 2, eval: startsWith},
+	"endsWith":            {minArgs: 2, maxArgs: 2, eval: endsWith},
+	"contains":            {minArgs: 2, maxArgs: 2, eval: contains},
+	"intersects":          {minArgs: 2, maxArgs: 2, eval: intersects},
+	"matches":             {minArgs: 2, maxArgs: 2, validateArgs: validateMatchesArgs, eval: matches},
+	"lower":               {minArgs: 1, maxArgs: 1, eval: lower},
+	"len":                 {minArgs: 1, maxArgs: 1, eval: length},
+	"in":                  {minArgs: 2, maxArgs: variadic, eval: in},
+	"cidrContains":        {minArgs: 2, maxArgs: 2, validateArgs: validateCidrContainsArgs, eval: cidrContains},
+	"duration":            {minArgs: 1, maxArgs: 1, validateArgs: validateDurationArgs, eval: duration},
+	"timestamp":           {minArgs: 1, maxArgs: 1, eval: timestamp},
+	"now":                 {minArgs: 0, maxArgs: 0, eval: now},
+	RelatedFunction:       {minArgs: 4, maxArgs: 4, validateArgs: validateRelatedArgs, eval: related, graph: true},
+	ShareAncestorFunction: {minArgs: 3, maxArgs: 3, validateArgs: validateShareAncestorArgs, eval: shareAncestor, graph: true},
+}
+
+var govaluateFunctions = func() map[string]govaluate.ExpressionFunction {
+	functions := make(map[string]govaluate.ExpressionFunction, len(conditionFunctions))
+	for name, function := range conditionFunctions {
+		functions[name] = function.eval
+	}
+	return functions
+}()
+
+func validateCall(call *ast.CallExpr) error {
+	ident, ok := call.Fun.(*ast.Ident)
+	if !ok {
+		return ErrInvalidNode
+	}
+	function, ok := conditionFunctions[ident.Name]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownFunction, ident.Name)
+	}
+	if call.Ellipsis.IsValid() {
+		return ErrInvalidNode
+	}
+	argCount := len(call.Args)
+	if argCount < function.minArgs || (function.maxArgs != variadic && argCount > function.maxArgs) {
+		return fmt.Errorf("%w: %s", ErrInvalidArgumentCount, ident.Name)
+	}
+	if function.validateArgs != nil {
+		return function.validateArgs(call.Args)
+	}
+	return nil
+}
+
+func validateMatchesArgs(args []ast.Expr) error {
+	pattern, ok := stringLiteral(args[1])
+	if !ok {
+		return nil
+	}
+	if _, err := regexp.Compile(pattern); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidArgument, err.Error())
+	}
+	return nil
+}
+
+func validateCidrContainsArgs(args []ast.Expr) error {
+	cidr, ok := stringLiteral(args[1])
+	if !ok {
+		return nil
+	}
+	if _, _, err := net.ParseCIDR(cidr); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidArgument, err.Error())
+	}
+	return nil
+}
+
+func validateDurationArgs(args []ast.Expr) error {
+	value, ok := stringLiteral(args[0])
+	if !ok {
+		return nil
+	}
+	if _, err := time.ParseDuration(value); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidArgument, err.Error())
+	}
+	return nil
+}
+
+func stringLiteral(expr ast.Expr) (string, bool) {
+	lit, ok := expr.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", false
+	}
+	value, err := strconv.Unquote(lit.Value)
+	if err != nil {
+		return "", false
+	}
+	return value, true
+}
+
+func startsWith(args ...interface{}) (interface{}, error) {
+	s, prefix, err := stringArgs("startsWith", args[0], args[1])
+	if err != nil {
+		return nil, err
+	}
+	return strings.HasPrefix(s, prefix), nil
+}
+
+func endsWith(args ...interface{}) (interface{}, error) {
+	s, suffix, err := stringArgs("endsWith", args[0], args[1])
+	if err != nil {
+		return nil, err
+	}
+	return strings.HasSuffix(s, suffix), nil
+}
+
+// contains checks for a substring when given a string and for an element when given a list
+func contains(args ...interface{}) (interface{}, error) {
+	if isList(args[0]) {
+		return in(args[1], args[0])
+	}
+	s, substr, err := stringArgs("contains", args[0], args[1])
+	if err != nil {
+		return nil, err
+	}
+	return strings.Contains(s, substr), nil
+}
+
+func intersects(args ...interface{}) (interface{}, error) {
+	if !isList(args[0]) || !isList(args[1]) {
+		return nil, fmt.Errorf("intersects: expected two lists, got %T and %T", args[0], args[1])
+	}
+	first := reflect.ValueOf(args[0])
+	for i := 0; i < first.Len(); i++ {
+		found, _ := in(first.Index(i).Interface(), args[1])
+		if found == true {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func matches(args ...interface{}) (interface{}, error) {
+	s, pattern, err := stringArgs("matches", args[0], args[1])
+	if err != nil {
+		return nil, err
+	}
+	regex, err := compiledPatterns.compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	return regex.MatchString(s), nil
+}
+
+func lower(args ...interface{}) (interface{}, error) {
+	s, ok := args[0].(string)
+	if !ok {
+		return nil, fmt.Errorf("lower: expected a string, got %T", args[0])
+	}
+	return strings.ToLower(s), nil
+}
+
+func length(args ...interface{}) (interface{}, error) {
+	if s, ok := args[0].(string); ok {
+		return float64(utf8.RuneCountInString(s)), nil
+	}
+	if isList(args[0]) {
+		return float64(reflect.ValueOf(args[0]).Len()), nil
+	}
+	return nil, fmt.Errorf("len: expected a string or a list, got %T", args[0])
+}
+
+// in reports whether the first argument is equal to any of the remaining ones,
+// list arguments are flattened so that both in(x, "a", "b") and in(x, list) work
+func in(args ...interface{}) (interface{}, error) {
+	needle := args[0]
+	for _, arg := range args[1:] {
+		if !isList(arg) {
+			if equal(needle, arg) {
+				return true, nil
+			}
+			continue
+		}
+		value := reflect.ValueOf(arg)
+		for i := 0; i < value.Len(); i++ {
+			if equal(needle, value.Index(i).Interface()) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+func cidrContains(args ...interface{}) (interface{}, error) {
+	ipStr, cidrStr, err := stringArgs("cidrContains", args[0], args[1])
+	if err != nil {
+		return nil, err
+	}
+	ip := net.ParseIP(ipStr)
+	if ip == nil {
+		return nil, fmt.Errorf("cidrContains: invalid ip address %q", ipStr)
+	}
+	_, cidr, err := net.ParseCIDR(cidrStr)
+	if err != nil {
+		return nil, err
+	}
+	return cidr.Contains(ip), nil
+}
+
+func isList(value interface{}) bool {
+	kind := reflect.ValueOf(value).Kind()
+	return kind == reflect.Slice || kind == reflect.Array
+}
+
+// duration converts a go duration string, such as "15m" or "1h30m", to seconds
+func duration(args ...interface{}) (interface{}, error) {
+	value, ok := args[0].(string)
+	if !ok {
+		return nil, fmt.Errorf("duration: expected a string, got %T", args[0])
+	}
+	parsed, err := time.ParseDuration(value)
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Seconds(), nil
+}
+
+// timestamp converts an RFC 3339 string to unix seconds,
+// govaluate already converts string literals that look like dates, so those are returned as they are
+func timestamp(args ...interface{}) (interface{}, error) {
+	switch value := args[0].(type) {
+	case float64:
+		return value, nil
+	case string:
+		parsed, err := time.Parse(time.RFC3339Nano, value)
+		if err != nil {
+			return nil, err
+		}
+		return unixSeconds(parsed), nil
+	default:
+		return nil, fmt.Errorf("timestamp: expected a string, got %T", args[0])
+	}
+}
+
+func now(args ...interface{}) (interface{}, error) {
+	return unixSeconds(time.Now()), nil
+}
+
+func stringArgs(function string, first, second interface{}) (string, string, error) {
+	firstStr, ok := first.(string)
+	if !ok {
+		return "", "", fmt.Errorf("%s: expected a string, got %T", function, first)
+	}
+	secondStr, ok := second.(string)
+	if !ok {
+		return "", "", fmt.Errorf("%s: expected a string, got %T", function, second)
+	}
+	return firstStr, secondStr, nil
+}
+
+// equal compares numbers by value regardless of their go type,
+// since literals are float64 while attribute values keep their own types
+func equal(a, b interface{}) bool {
+	if isList(a) || isList(b) {
+		return false
+	}
+	aNum, aOk := toFloat64(a)
+	bNum, bOk := toFloat64(b)
+	if aOk && bOk {
+		return aNum == bNum
+	}
+	return a == b
+}
+
+func toFloat64(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	default:
+		return 0, false
+	}
+}
+
+const patternCacheSize = 256
+
+// patternCache keeps compiled regular expressions used by matches,
+// once it is full it is cleared instead of tracking usage
+type patternCache struct {
+	mu       sync.RWMutex
+	patterns map[string]*regexp.Regexp
+}
+
+var compiledPatterns = &patternCache{patterns: make(map[string]*regexp.Regexp)}
+
+func (c *patternCache) compile(pattern string) (*regexp.Regexp, error) {
+	c.mu.RLock()
+	regex, ok := c.patterns[pattern]
+	c.mu.RUnlock()
+	if ok {
+		return regex, nil
+	}
+	regex, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.patterns) >= patternCacheSize {
+		c.patterns = make(map[string]*regexp.Regexp)
+	}
+	c.patterns[pattern] = regex
+	return regex, nil
+}
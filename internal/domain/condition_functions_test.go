@@ -0,0 +1,495 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type conditionFunctionTestCase struct {
+	expression  string
+	sub         []Attribute
+	obj         []Attribute
+	env         []Attribute
+	result      bool
+	description string
+}
+
+func attr(name string, kind AttributeKind, value interface{}) Attribute {
+	return Attribute{id: AttributeId{name: name}, kind: kind, value: value}
+}
+
+func runConditionFunctionTestCases(t *testing.T, testCases []conditionFunctionTestCase) {
+	for _, testCase := range testCases {
+		c := testCase
+		t.Run(c.description, func(t *testing.T) {
+			t.Parallel()
+			condition, err := NewCondition(c.expression)
+			if !assert.Nil(t, err) {
+				return
+			}
+			assert.Equal(t, c.result, condition.Eval(c.sub, c.obj, c.env).Value)
+		})
+	}
+}
+
+func TestConditionFunctionStartsWith(t *testing.T) {
+	runConditionFunctionTestCases(t, []conditionFunctionTestCase{
+		{
+			expression:  "startsWith(obj_path, \"/var/log\")",
+			obj:         []Attribute{attr("path", String, "/var/log/syslog")},
+			result:      true,
+			description: "attribute starts with the prefix",
+		},
+		{
+			expression:  "startsWith(obj_path, \"/var/log\")",
+			obj:         []Attribute{attr("path", String, "/etc/passwd")},
+			result:      false,
+			description: "attribute doesn't start with the prefix",
+		},
+		{
+			expression:  "startsWith(obj_path, sub_home)",
+			sub:         []Attribute{attr("home", String, "/home/alice")},
+			obj:         []Attribute{attr("path", String, "/home/alice/notes")},
+			result:      true,
+			description: "prefix taken from another attribute",
+		},
+		{
+			expression:  "startsWith(obj_size, \"1\")",
+			obj:         []Attribute{attr("size", Int64, int64(10))},
+			result:      false,
+			description: "non string argument",
+		},
+	})
+}
+
+func TestConditionFunctionEndsWith(t *testing.T) {
+	runConditionFunctionTestCases(t, []conditionFunctionTestCase{
+		{
+			expression:  "endsWith(sub_email, \"@c12s.io\")",
+			sub:         []Attribute{attr("email", String, "alice@c12s.io")},
+			result:      true,
+			description: "attribute ends with the suffix",
+		},
+		{
+			expression:  "endsWith(sub_email, \"@c12s.io\")",
+			sub:         []Attribute{attr("email", String, "alice@example.com")},
+			result:      false,
+			description: "attribute doesn't end with the suffix",
+		},
+		{
+			expression:  "endsWith(sub_email, \"@c12s.io\")",
+			result:      false,
+			description: "missing attribute",
+		},
+	})
+}
+
+func TestConditionFunctionContains(t *testing.T) {
+	runConditionFunctionTestCases(t, []conditionFunctionTestCase{
+		{
+			expression:  "contains(obj_name, \"prod\")",
+			obj:         []Attribute{attr("name", String, "eu-prod-1")},
+			result:      true,
+			description: "attribute contains the substring",
+		},
+		{
+			expression:  "contains(obj_name, \"prod\")",
+			obj:         []Attribute{attr("name", String, "eu-dev-1")},
+			result:      false,
+			description: "attribute doesn't contain the substring",
+		},
+		{
+			expression:  "contains(obj_name, \"\")",
+			obj:         []Attribute{attr("name", String, "eu-dev-1")},
+			result:      true,
+			description: "empty substring",
+		},
+	})
+}
+
+func TestConditionFunctionMatches(t *testing.T) {
+	runConditionFunctionTestCases(t, []conditionFunctionTestCase{
+		{
+			expression:  "matches(obj_name, \"^node-[0-9]+$\")",
+			obj:         []Attribute{attr("name", String, "node-42")},
+			result:      true,
+			description: "attribute matches the pattern",
+		},
+		{
+			expression:  "matches(obj_name, \"^node-[0-9]+$\")",
+			obj:         []Attribute{attr("name", String, "node-x")},
+			result:      false,
+			description: "attribute doesn't match the pattern",
+		},
+		{
+			expression:  "matches(obj_name, sub_pattern)",
+			sub:         []Attribute{attr("pattern", String, "(")},
+			obj:         []Attribute{attr("name", String, "node-1")},
+			result:      false,
+			description: "invalid pattern taken from an attribute",
+		},
+	})
+}
+
+func TestConditionFunctionLower(t *testing.T) {
+	runConditionFunctionTestCases(t, []conditionFunctionTestCase{
+		{
+			expression:  "lower(sub_role) == \"admin\"",
+			sub:         []Attribute{attr("role", String, "AdMiN")},
+			result:      true,
+			description: "lowercased attribute is compared",
+		},
+		{
+			expression:  "lower(sub_role) == \"admin\"",
+			sub:         []Attribute{attr("role", String, "operator")},
+			result:      false,
+			description: "lowercased attribute differs",
+		},
+		{
+			expression:  "lower(sub_level) == \"admin\"",
+			sub:         []Attribute{attr("level", Int64, int64(1))},
+			result:      false,
+			description: "non string argument",
+		},
+	})
+}
+
+func TestConditionFunctionLen(t *testing.T) {
+	runConditionFunctionTestCases(t, []conditionFunctionTestCase{
+		{
+			expression:  "len(sub_name) == 5",
+			sub:         []Attribute{attr("name", String, "alice")},
+			result:      true,
+			description: "string length",
+		},
+		{
+			expression:  "len(sub_name) == 4",
+			sub:         []Attribute{attr("name", String, "čćžš")},
+			result:      true,
+			description: "string length counts runes",
+		},
+		{
+			expression:  "len(sub_name) > 10",
+			sub:         []Attribute{attr("name", String, "alice")},
+			result:      false,
+			description: "string length comparison that isn't met",
+		},
+		{
+			expression:  "len(sub_age) > 0",
+			sub:         []Attribute{attr("age", Int64, int64(30))},
+			result:      false,
+			description: "length of a number",
+		},
+	})
+}
+
+func TestConditionFunctionIn(t *testing.T) {
+	runConditionFunctionTestCases(t, []conditionFunctionTestCase{
+		{
+			expression:  "in(env_region, \"eu\", \"us\")",
+			env:         []Attribute{attr("region", String, "us")},
+			result:      true,
+			description: "string value in the list",
+		},
+		{
+			expression:  "in(env_region, \"eu\", \"us\")",
+			env:         []Attribute{attr("region", String, "ap")},
+			result:      false,
+			description: "string value not in the list",
+		},
+		{
+			expression:  "in(sub_level, 1, 2, 3)",
+			sub:         []Attribute{attr("level", Int64, int64(2))},
+			result:      true,
+			description: "int value in a list of number literals",
+		},
+		{
+			expression:  "in(sub_level, obj_min, obj_max)",
+			sub:         []Attribute{attr("level", Int64, int64(7))},
+			obj:         []Attribute{attr("min", Int64, int64(1)), attr("max", Float64, 7.0)},
+			result:      true,
+			description: "int value compared to attributes of different numeric kinds",
+		},
+		{
+			expression:  "in(sub_level, \"2\")",
+			sub:         []Attribute{attr("level", Int64, int64(2))},
+			result:      false,
+			description: "values of different kinds aren't equal",
+		},
+	})
+}
+
+func TestConditionFunctionListOperators(t *testing.T) {
+	runConditionFunctionTestCases(t, []conditionFunctionTestCase{
+		{
+			expression:  "in(env_region, obj_allowed_regions)",
+			env:         []Attribute{attr("region", String, "eu")},
+			obj:         []Attribute{attr("allowed_regions", StringList, []string{"us", "eu"})},
+			result:      true,
+			description: "in - value in a string list",
+		},
+		{
+			expression:  "in(env_region, obj_allowed_regions)",
+			env:         []Attribute{attr("region", String, "ap")},
+			obj:         []Attribute{attr("allowed_regions", StringList, []string{"us", "eu"})},
+			result:      false,
+			description: "in - value not in a string list",
+		},
+		{
+			expression:  "in(sub_level, obj_levels)",
+			sub:         []Attribute{attr("level", Int64, int64(3))},
+			obj:         []Attribute{attr("levels", Int64List, []int64{1, 3, 5})},
+			result:      true,
+			description: "in - value in an int64 list",
+		},
+		{
+			expression:  "in(sub_groups, obj_groups)",
+			sub:         []Attribute{attr("groups", StringList, []string{"dev"})},
+			obj:         []Attribute{attr("groups", StringList, []string{"dev"})},
+			result:      false,
+			description: "in - a list is never an element",
+		},
+		{
+			expression:  "contains(sub_groups, \"ops\")",
+			sub:         []Attribute{attr("groups", StringList, []string{"dev", "ops"})},
+			result:      true,
+			description: "contains - string list contains the value",
+		},
+		{
+			expression:  "contains(sub_groups, \"ops\")",
+			sub:         []Attribute{attr("groups", StringList, []string{})},
+			result:      false,
+			description: "contains - empty string list",
+		},
+		{
+			expression:  "contains(sub_levels, 2)",
+			sub:         []Attribute{attr("levels", Int64List, []int64{1, 2})},
+			result:      true,
+			description: "contains - int64 list contains a number literal",
+		},
+		{
+			expression:  "intersects(sub_groups, obj_groups)",
+			sub:         []Attribute{attr("groups", StringList, []string{"dev", "ops"})},
+			obj:         []Attribute{attr("groups", StringList, []string{"ops", "sec"})},
+			result:      true,
+			description: "intersects - lists share an element",
+		},
+		{
+			expression:  "intersects(sub_groups, obj_groups)",
+			sub:         []Attribute{attr("groups", StringList, []string{"dev"})},
+			obj:         []Attribute{attr("groups", StringList, []string{"ops", "sec"})},
+			result:      false,
+			description: "intersects - disjoint lists",
+		},
+		{
+			expression:  "intersects(sub_ids, obj_ids)",
+			sub:         []Attribute{attr("ids", Int64List, []int64{4, 5})},
+			obj:         []Attribute{attr("ids", Int64List, []int64{5})},
+			result:      true,
+			description: "intersects - int64 lists",
+		},
+		{
+			expression:  "intersects(sub_group, obj_groups)",
+			sub:         []Attribute{attr("group", String, "ops")},
+			obj:         []Attribute{attr("groups", StringList, []string{"ops"})},
+			result:      false,
+			description: "intersects - non list argument",
+		},
+		{
+			expression:  "len(sub_groups) == 2",
+			sub:         []Attribute{attr("groups", StringList, []string{"dev", "ops"})},
+			result:      true,
+			description: "len - list length",
+		},
+	})
+}
+
+func TestConditionFunctionCidrContains(t *testing.T) {
+	runConditionFunctionTestCases(t, []conditionFunctionTestCase{
+		{
+			expression:  "cidrContains(env_ip, \"10.0.0.0/8\")",
+			env:         []Attribute{attr("ip", String, "10.1.2.3")},
+			result:      true,
+			description: "ipv4 address in the range",
+		},
+		{
+			expression:  "cidrContains(env_ip, \"10.0.0.0/8\")",
+			env:         []Attribute{attr("ip", String, "192.168.1.1")},
+			result:      false,
+			description: "ipv4 address outside of the range",
+		},
+		{
+			expression:  "cidrContains(env_ip, \"2001:db8::/32\")",
+			env:         []Attribute{attr("ip", String, "2001:db8::1")},
+			result:      true,
+			description: "ipv6 address in the range",
+		},
+		{
+			expression:  "cidrContains(env_ip, \"10.0.0.0/8\")",
+			env:         []Attribute{attr("ip", String, "not-an-ip")},
+			result:      false,
+			description: "invalid ip address",
+		},
+		{
+			expression:  "cidrContains(env_ip, obj_subnet)",
+			env:         []Attribute{attr("ip", String, "172.16.5.4")},
+			obj:         []Attribute{attr("subnet", String, "172.16.0.0/12")},
+			result:      true,
+			description: "range taken from an attribute",
+		},
+	})
+}
+
+func TestConditionTimeOperators(t *testing.T) {
+	instant := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	runConditionFunctionTestCases(t, []conditionFunctionTestCase{
+		{
+			expression:  "obj_expires_at > env_now",
+			obj:         []Attribute{attr("expires_at", Timestamp, instant.Add(time.Hour))},
+			env:         []Attribute{attr("now", Timestamp, instant)},
+			result:      true,
+			description: "timestamps compared as instants",
+		},
+		{
+			expression:  "obj_expires_at > env_now",
+			obj:         []Attribute{attr("expires_at", Timestamp, instant.Add(-time.Nanosecond*1000))},
+			env:         []Attribute{attr("now", Timestamp, instant)},
+			result:      false,
+			description: "sub second precision is kept",
+		},
+		{
+			expression:  "obj_created_at < \"2024-03-02T00:00:00Z\"",
+			obj:         []Attribute{attr("created_at", Timestamp, instant)},
+			result:      true,
+			description: "timestamp compared to a date literal",
+		},
+		{
+			expression:  "obj_created_at >= timestamp(\"2024-03-01T12:00:00Z\")",
+			obj:         []Attribute{attr("created_at", Timestamp, instant)},
+			result:      true,
+			description: "timestamp compared to an explicit timestamp literal",
+		},
+		{
+			expression:  "sub_session_age < duration(\"15m\")",
+			sub:         []Attribute{attr("session_age", Duration, 10*time.Minute)},
+			result:      true,
+			description: "duration compared to a duration literal",
+		},
+		{
+			expression:  "sub_session_age < duration(\"15m\")",
+			sub:         []Attribute{attr("session_age", Duration, time.Hour)},
+			result:      false,
+			description: "duration exceeds the limit",
+		},
+		{
+			expression:  "env_now - obj_created_at < duration(\"24h\")",
+			obj:         []Attribute{attr("created_at", Timestamp, instant)},
+			env:         []Attribute{attr("now", Timestamp, instant.Add(2*time.Hour))},
+			result:      true,
+			description: "difference of two timestamps is a duration",
+		},
+		{
+			expression:  "obj_created_at + obj_ttl > env_now",
+			obj:         []Attribute{attr("created_at", Timestamp, instant), attr("ttl", Duration, 30*time.Minute)},
+			env:         []Attribute{attr("now", Timestamp, instant.Add(time.Hour))},
+			result:      false,
+			description: "timestamp shifted by a duration",
+		},
+		{
+			expression:  "obj_expires_at > now()",
+			obj:         []Attribute{attr("expires_at", Timestamp, time.Now().Add(time.Hour))},
+			result:      true,
+			description: "timestamp compared to the current time",
+		},
+		{
+			expression:  "sub_session_age * 2 == duration(\"1h\")",
+			sub:         []Attribute{attr("session_age", Duration, 30*time.Minute)},
+			result:      true,
+			description: "scaled duration",
+		},
+	})
+}
+
+func TestConditionFunctionValidation(t *testing.T) {
+	testCases := []struct {
+		expression  string
+		err         error
+		description string
+	}{
+		{
+			expression:  "startsWith(sub_name, \"a\") && len(sub_name) < 10",
+			err:         nil,
+			description: "supported functions combined with operators",
+		},
+		{
+			expression:  "in(sub_role, \"admin\", \"operator\", \"viewer\")",
+			err:         nil,
+			description: "variadic function",
+		},
+		{
+			expression:  "lower(lower(sub_role)) == \"admin\"",
+			err:         nil,
+			description: "nested function calls",
+		},
+		{
+			expression:  "upper(sub_role) == \"ADMIN\"",
+			err:         ErrUnknownFunction,
+			description: "unknown function",
+		},
+		{
+			expression:  "fmt.Println(\"hello world\")",
+			err:         ErrInvalidNode,
+			description: "selector instead of a function name",
+		},
+		{
+			expression:  "startsWith(sub_name)",
+			err:         ErrInvalidArgumentCount,
+			description: "too few arguments",
+		},
+		{
+			expression:  "lower(sub_name, sub_role)",
+			err:         ErrInvalidArgumentCount,
+			description: "too many arguments",
+		},
+		{
+			expression:  "in(sub_role)",
+			err:         ErrInvalidArgumentCount,
+			description: "variadic function without values",
+		},
+		{
+			expression:  "startsWith(name, \"a\")",
+			err:         ErrInvalidVariableName,
+			description: "argument with an invalid variable name",
+		},
+		{
+			expression:  "matches(sub_name, \"[a-\")",
+			err:         ErrInvalidArgument,
+			description: "invalid regular expression literal",
+		},
+		{
+			expression:  "sub_age < duration(\"15 minutes\")",
+			err:         ErrInvalidArgument,
+			description: "invalid duration literal",
+		},
+		{
+			expression:  "now(sub_age)",
+			err:         ErrInvalidArgumentCount,
+			description: "function without parameters called with arguments",
+		},
+		{
+			expression:  "cidrContains(env_ip, \"10.0.0.0/33\")",
+			err:         ErrInvalidArgument,
+			description: "invalid cidr literal",
+		},
+	}
+	for _, testCase := range testCases {
+		c := testCase
+		t.Run(c.description, func(t *testing.T) {
+			t.Parallel()
+			_, err := NewCondition(c.expression)
+			assert.ErrorIs(t, err, c.err)
+		})
+	}
+}
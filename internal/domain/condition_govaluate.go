@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"fmt"
+
+	"github.com/Knetic/govaluate"
+)
+
+// govaluateEngine evaluates expressions over sub_, obj_ and env_ prefixed variables,
+// the expressions are restricted to the Go syntax accepted by validate
+type govaluateEngine struct{}
+
+func (e govaluateEngine) Language() ConditionLanguage {
+	return ConditionLanguageGovaluate
+}
+
+func (e govaluateEngine) Validate(expression string) error {
+	return validate(expression)
+}
+
+func (e govaluateEngine) Compile(expression string) (CompiledCondition, error) {
+	if err := validate(expression); err != nil {
+		return nil, err
+	}
+	compiled, err := govaluate.NewEvaluableExpressionWithFunctions(expression, govaluateFunctions)
+	if err != nil {
+		return nil, ErrParsing
+	}
+	return compiled, nil
+}
+
+func (e govaluateEngine) Eval(compiled CompiledCondition, sub, obj, env []Attribute, relations GraphRelations) ConditionEvalResult {
+	goeExpr, ok := compiled.(*govaluate.EvaluableExpression)
+	if !ok {
+		return ConditionEvalResult{Err: fmt.Errorf("%w: not a govaluate expression", ErrConditionEval)}
+	}
+
+	parameters := map[string]interface{}{
+		GraphOperandSubject: graphOperand{name: GraphOperandSubject, relations: relations},
+		GraphOperandObject:  graphOperand{name: GraphOperandObject, relations: relations},
+	}
+	for _, attr := range sub {
+		parameters[SubVarNamePrefix+attr.Name()] = parameterValue(attr)
+	}
+	for _, attr := range obj {
+		parameters[ObjVarNamePrefix+attr.Name()] = parameterValue(attr)
+	}
+	for _, attr := range env {
+		parameters[EnvVarNamePrefix+attr.Name()] = parameterValue(attr)
+	}
+	for _, variable := range goeExpr.Vars() {
+		if _, ok := parameters[variable]; !ok {
+			return ConditionEvalResult{
+				Err:              fmt.Errorf("%w: %s", ErrMissingAttribute, variable),
+				MissingAttribute: variable,
+			}
+		}
+	}
+
+	result, err := goeExpr.Evaluate(parameters)
+	if err != nil {
+		return ConditionEvalResult{Err: fmt.Errorf("%w: %s", ErrConditionEval, err.Error())}
+	}
+	boolResult, ok := result.(bool)
+	if !ok {
+		return ConditionEvalResult{Err: fmt.Errorf("%w, got %T", ErrConditionNotBool, result)}
+	}
+	return ConditionEvalResult{Value: boolResult}
+}
@@ -0,0 +1,191 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strconv"
+	"strings"
+)
+
+// Graph predicates compare the positions of the subject and the object in the resource graph,
+// their first two arguments are the sub and obj operands instead of attributes
+const (
+	// related(from, to, "RELATION", maxDepth) holds if to can be reached from from
+	// by following at most maxDepth relationships of the given type
+	RelatedFunction = "related"
+	// shareAncestor(from, to, "kind") holds if from and to inherit from a common resource of the given kind,
+	// along relations of the types that propagate permissions
+	ShareAncestorFunction = "shareAncestor"
+)
+
+const (
+	GraphOperandSubject = "sub"
+	GraphOperandObject  = "obj"
+)
+
+// MaxRelatedDepth bounds the number of relationships related may follow
+const MaxRelatedDepth = 10
+
+var ErrGraphPredicateUnresolved = errors.New("graph predicate wasn't resolved")
+
+// GraphPredicate is a graph predicate call with its arguments,
+// it is comparable, so that calls repeated across conditions are resolved once
+type GraphPredicate struct {
+	Function string
+	From     string
+	To       string
+	// Argument is the relationship type for related and the ancestor kind for shareAncestor
+	Argument string
+	// MaxDepth is only set for related
+	MaxDepth int
+}
+
+// GraphRelations holds the resolved value of every graph predicate used by the evaluated conditions
+type GraphRelations map[GraphPredicate]bool
+
+// graphOperand is the value bound to the sub and obj variables during evaluation,
+// it carries the resolved relations to the predicate functions
+type graphOperand struct {
+	name      string
+	relations GraphRelations
+}
+
+func validateRelatedArgs(args []ast.Expr) error {
+	_, err := graphPredicateFromArgs(RelatedFunction, args)
+	return err
+}
+
+func validateShareAncestorArgs(args []ast.Expr) error {
+	_, err := graphPredicateFromArgs(ShareAncestorFunction, args)
+	return err
+}
+
+// graphPredicateFromArgs requires literal arguments,
+// since predicates have to be known before evaluation to be resolved in a single query
+func graphPredicateFromArgs(function string, args []ast.Expr) (GraphPredicate, error) {
+	predicate := GraphPredicate{Function: function}
+	for i, operand := range []*string{&predicate.From, &predicate.To} {
+		ident, ok := args[i].(*ast.Ident)
+		if !ok || (ident.Name != GraphOperandSubject && ident.Name != GraphOperandObject) {
+			return predicate, fmt.Errorf("%w: %s expects %s or %s as argument %d", ErrInvalidArgument, function, GraphOperandSubject, GraphOperandObject, i+1)
+		}
+		*operand = ident.Name
+	}
+	argument, ok := stringLiteral(args[2])
+	if !ok {
+		return predicate, fmt.Errorf("%w: %s expects a string literal as argument 3", ErrInvalidArgument, function)
+	}
+	predicate.Argument = argument
+	switch function {
+	case RelatedFunction:
+		if ValidateRelationTypeName(argument) != nil {
+			return predicate, fmt.Errorf("%w: invalid relationship type %q", ErrInvalidArgument, argument)
+		}
+		lit, ok := args[3].(*ast.BasicLit)
+		if !ok || lit.Kind != token.INT {
+			return predicate, fmt.Errorf("%w: %s expects an integer literal as argument 4", ErrInvalidArgument, function)
+		}
+		depth, err := strconv.Atoi(lit.Value)
+		if err != nil || depth < 1 || depth > MaxRelatedDepth {
+			return predicate, fmt.Errorf("%w: max depth must be between 1 and %d", ErrInvalidArgument, MaxRelatedDepth)
+		}
+		predicate.MaxDepth = depth
+	case ShareAncestorFunction:
+		if argument == "" || strings.Contains(argument, "/") {
+			return predicate, fmt.Errorf("%w: invalid resource kind %q", ErrInvalidArgument, argument)
+		}
+	}
+	return predicate, nil
+}
+
+// GraphPredicates returns the distinct graph predicates used by the condition,
+// CEL conditions don't support them
+func (c Condition) GraphPredicates() []GraphPredicate {
+	if c.IsEmpty() || c.language != ConditionLanguageGovaluate {
+		return nil
+	}
+	expr, err := parser.ParseExpr(c.expression)
+	if err != nil {
+		return nil
+	}
+	predicates := make([]GraphPredicate, 0)
+	seen := make(map[GraphPredicate]bool)
+	ast.Inspect(expr, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		ident, ok := call.Fun.(*ast.Ident)
+		if !ok || !conditionFunctions[ident.Name].graph {
+			return true
+		}
+		if validateCall(call) != nil {
+			return false
+		}
+		predicate, err := graphPredicateFromArgs(ident.Name, call.Args)
+		if err == nil && !seen[predicate] {
+			seen[predicate] = true
+			predicates = append(predicates, predicate)
+		}
+		return false
+	})
+	return predicates
+}
+
+// GraphPredicates returns the distinct graph predicates used by the conditions of all permissions in the hierarchy
+func (hierarchy PermissionHierarchy) GraphPredicates() []GraphPredicate {
+	predicates := make([]GraphPredicate, 0)
+	seen := make(map[GraphPredicate]bool)
+	for _, objHierarchy := range hierarchy {
+		for _, level := range objHierarchy {
+			for _, permission := range level {
+				for _, predicate := range permission.condition.GraphPredicates() {
+					if !seen[predicate] {
+						seen[predicate] = true
+						predicates = append(predicates, predicate)
+					}
+				}
+			}
+		}
+	}
+	return predicates
+}
+
+func related(args ...interface{}) (interface{}, error) {
+	return resolvedGraphPredicate(RelatedFunction, args)
+}
+
+func shareAncestor(args ...interface{}) (interface{}, error) {
+	return resolvedGraphPredicate(ShareAncestorFunction, args)
+}
+
+func resolvedGraphPredicate(function string, args []interface{}) (interface{}, error) {
+	from, ok := args[0].(graphOperand)
+	if !ok {
+		return nil, fmt.Errorf("%s: expected an operand, got %T", function, args[0])
+	}
+	to, ok := args[1].(graphOperand)
+	if !ok {
+		return nil, fmt.Errorf("%s: expected an operand, got %T", function, args[1])
+	}
+	argument, ok := args[2].(string)
+	if !ok {
+		return nil, fmt.Errorf("%s: expected a string, got %T", function, args[2])
+	}
+	predicate := GraphPredicate{Function: function, From: from.name, To: to.name, Argument: argument}
+	if function == RelatedFunction {
+		depth, ok := args[3].(float64)
+		if !ok {
+			return nil, fmt.Errorf("%s: expected a number, got %T", function, args[3])
+		}
+		predicate.MaxDepth = int(depth)
+	}
+	value, ok := from.relations[predicate]
+	if !ok {
+		return nil, ErrGraphPredicateUnresolved
+	}
+	return value, nil
+}
@@ -0,0 +1,111 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGraphPredicateValidation(t *testing.T) {
+	testCases := []struct {
+		expression  string
+		err         error
+		description string
+	}{
+		{
+			expression:  `related(sub, obj, "INHERITS_FROM", 3)`,
+			description: "related",
+		},
+		{
+			expression:  `shareAncestor(obj, sub, "org") && sub_clearance > 2`,
+			description: "share ancestor combined with attributes",
+		},
+		{
+			expression:  `related(sub_id, obj, "INHERITS_FROM", 3)`,
+			err:         ErrInvalidArgument,
+			description: "attribute as operand",
+		},
+		{
+			expression:  `related(sub, obj, sub_relation, 3)`,
+			err:         ErrInvalidArgument,
+			description: "non literal relationship type",
+		},
+		{
+			expression:  `related(sub, obj, "inherits from", 3)`,
+			err:         ErrInvalidArgument,
+			description: "invalid relationship type",
+		},
+		{
+			expression:  `related(sub, obj, "INHERITS_FROM", 11)`,
+			err:         ErrInvalidArgument,
+			description: "depth out of range",
+		},
+		{
+			expression:  `related(sub, obj, "INHERITS_FROM")`,
+			err:         ErrInvalidArgumentCount,
+			description: "missing depth",
+		},
+		{
+			expression:  `shareAncestor(sub, obj, "org/1")`,
+			err:         ErrInvalidArgument,
+			description: "resource name as kind",
+		},
+		{
+			expression:  `sub == obj`,
+			err:         ErrInvalidVariableName,
+			description: "operands outside of a graph predicate",
+		},
+	}
+
+	for _, testCase := range testCases {
+		c := testCase
+		t.Run(c.description, func(t *testing.T) {
+			t.Parallel()
+			_, err := NewCondition(c.expression)
+			assert.ErrorIs(t, err, c.err)
+		})
+	}
+}
+
+func TestConditionGraphPredicates(t *testing.T) {
+	condition, err := NewCondition(`related(sub, obj, "INHERITS_FROM", 2) || (shareAncestor(sub, obj, "org") && related(sub, obj, "INHERITS_FROM", 2))`)
+	assert.Nil(t, err)
+	assert.Equal(t, []GraphPredicate{
+		{Function: RelatedFunction, From: GraphOperandSubject, To: GraphOperandObject, Argument: "INHERITS_FROM", MaxDepth: 2},
+		{Function: ShareAncestorFunction, From: GraphOperandSubject, To: GraphOperandObject, Argument: "org"},
+	}, condition.GraphPredicates())
+
+	cel, err := NewConditionInLanguage(`sub.clearance > 2`, ConditionLanguageCEL)
+	assert.Nil(t, err)
+	assert.Empty(t, cel.GraphPredicates())
+}
+
+func TestConditionEvalWithRelations(t *testing.T) {
+	condition, err := NewCondition(`shareAncestor(obj, sub, "org") && sub_clearance > 2`)
+	assert.Nil(t, err)
+	sub := []Attribute{attr("clearance", Int64, int64(3))}
+	predicate := GraphPredicate{Function: ShareAncestorFunction, From: GraphOperandObject, To: GraphOperandSubject, Argument: "org"}
+
+	result := condition.EvalWithRelations(sub, nil, nil, GraphRelations{predicate: true})
+	assert.Nil(t, result.Err)
+	assert.True(t, result.Value)
+
+	result = condition.EvalWithRelations(sub, nil, nil, GraphRelations{predicate: false})
+	assert.Nil(t, result.Err)
+	assert.False(t, result.Value)
+
+	result = condition.Eval(sub, nil, nil)
+	assert.ErrorIs(t, result.Err, ErrConditionEval)
+	assert.ErrorContains(t, result.Err, ErrGraphPredicateUnresolved.Error())
+}
+
+func TestGraphPredicateTypeCheck(t *testing.T) {
+	condition, err := NewCondition(`related(sub, obj, "INHERITS_FROM", 1) && obj_owner == "alice"`)
+	assert.Nil(t, err)
+	env := ConditionTypeEnv{Object: ConditionScope{Kinds: map[string]AttributeKind{"owner": String}, Strict: true}}
+	assert.Nil(t, condition.TypeCheck(env))
+
+	condition, err = NewCondition(`related(sub, obj, "INHERITS_FROM", 1) + 1 > 0`)
+	assert.Nil(t, err)
+	assert.ErrorIs(t, condition.TypeCheck(env), ErrConditionType)
+}
@@ -0,0 +1,438 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrConditionType             = errors.New("condition type error")
+	ErrUnknownConditionAttribute = errors.New("condition references an unknown attribute")
+)
+
+// ConditionScope holds the attribute kinds known for one of the condition variable prefixes
+type ConditionScope struct {
+	Kinds map[string]AttributeKind
+	// Strict scopes reject attributes that aren't in Kinds,
+	// other scopes treat them as dynamically typed
+	Strict bool
+}
+
+type ConditionTypeEnv struct {
+	Subject ConditionScope
+	Object  ConditionScope
+}
+
+type conditionType int
+
+const (
+	typeDynamic conditionType = iota
+	typeNumber
+	typeString
+	typeBool
+	typeStringList
+	typeInt64List
+	typeTimestamp
+	typeDuration
+)
+
+func (t conditionType) String() string {
+	switch t {
+	case typeNumber:
+		return "number"
+	case typeString:
+		return "string"
+	case typeBool:
+		return "bool"
+	case typeStringList:
+		return "string list"
+	case typeInt64List:
+		return "int64 list"
+	case typeTimestamp:
+		return "timestamp"
+	case typeDuration:
+		return "duration"
+	default:
+		return "dynamic"
+	}
+}
+
+func (t conditionType) isList() bool {
+	return t == typeStringList || t == typeInt64List
+}
+
+func (t conditionType) elem() conditionType {
+	switch t {
+	case typeStringList:
+		return typeString
+	case typeInt64List:
+		return typeNumber
+	default:
+		return typeDynamic
+	}
+}
+
+func typeOfKind(kind AttributeKind) conditionType {
+	switch kind {
+	case Int64, Float64:
+		return typeNumber
+	case String:
+		return typeString
+	case Bool:
+		return typeBool
+	case StringList:
+		return typeStringList
+	case Int64List:
+		return typeInt64List
+	case Timestamp:
+		return typeTimestamp
+	case Duration:
+		return typeDuration
+	default:
+		return typeDynamic
+	}
+}
+
+type conditionTypeError struct {
+	pos token.Pos
+	err error
+	msg string
+}
+
+func (e conditionTypeError) Error() string {
+	return fmt.Sprintf("%s at column %d: %s", e.err.Error(), e.pos, e.msg)
+}
+
+func (e conditionTypeError) Unwrap() error {
+	return e.err
+}
+
+// TypeCheck infers the type of every operand of the condition from the attribute kinds in env
+// and rejects impossible operations, unknown attributes of strict scopes and non bool results.
+// Errors point at the column of the offending node, counting from 1.
+// Only govaluate conditions are checked here, CEL conditions are type checked when compiled.
+func (c Condition) TypeCheck(env ConditionTypeEnv) error {
+	if c.IsEmpty() || c.language != ConditionLanguageGovaluate {
+		return nil
+	}
+	expr, err := parser.ParseExpr(c.expression)
+	if err != nil {
+		return ErrParsing
+	}
+	checker := typeChecker{env: env}
+	exprType, err := checker.check(expr)
+	if err != nil {
+		return err
+	}
+	if exprType != typeBool && exprType != typeDynamic {
+		return conditionTypeError{pos: expr.Pos(), err: ErrConditionType, msg: fmt.Sprintf("condition must evaluate to a bool, got %s", exprType)}
+	}
+	return nil
+}
+
+type typeChecker struct {
+	env ConditionTypeEnv
+}
+
+func (tc typeChecker) errorf(node ast.Node, format string, args ...interface{}) error {
+	return conditionTypeError{pos: node.Pos(), err: ErrConditionType, msg: fmt.Sprintf(format, args...)}
+}
+
+func (tc typeChecker) check(expr ast.Expr) (conditionType, error) {
+	switch x := expr.(type) {
+	case *ast.BasicLit:
+		return tc.checkLiteral(x)
+	case *ast.ParenExpr:
+		return tc.check(x.X)
+	case *ast.Ident:
+		return tc.checkIdent(x)
+	case *ast.BinaryExpr:
+		return tc.checkBinary(x)
+	case *ast.CallExpr:
+		return tc.checkCall(x)
+	default:
+		return typeDynamic, ErrInvalidNode
+	}
+}
+
+func (tc typeChecker) checkLiteral(lit *ast.BasicLit) (conditionType, error) {
+	switch lit.Kind {
+	case token.INT, token.FLOAT:
+		return typeNumber, nil
+	case token.STRING, token.CHAR:
+		value, err := strconv.Unquote(lit.Value)
+		if err != nil {
+			return typeDynamic, ErrParsing
+		}
+		// govaluate evaluates string literals that look like dates as timestamps
+		if isDateLiteral(value) {
+			return typeTimestamp, nil
+		}
+		return typeString, nil
+	default:
+		return typeDynamic, tc.errorf(lit, "unsupported literal %s", lit.Value)
+	}
+}
+
+func (tc typeChecker) checkIdent(ident *ast.Ident) (conditionType, error) {
+	var scope ConditionScope
+	var name string
+	switch {
+	case strings.HasPrefix(ident.Name, SubVarNamePrefix):
+		scope, name = tc.env.Subject, strings.TrimPrefix(ident.Name, SubVarNamePrefix)
+	case strings.HasPrefix(ident.Name, ObjVarNamePrefix):
+		scope, name = tc.env.Object, strings.TrimPrefix(ident.Name, ObjVarNamePrefix)
+	case strings.HasPrefix(ident.Name, EnvVarNamePrefix):
+		// env attributes are only known at evaluation time
+		return typeDynamic, nil
+	default:
+		return typeDynamic, ErrInvalidVariableName
+	}
+	kind, ok := scope.Kinds[name]
+	if ok {
+		return typeOfKind(kind), nil
+	}
+	// ancestors are only known at evaluation time, a policy scope can apply to resources with different ancestors
+	if strings.HasPrefix(name, ParentAttributePrefix) {
+		return typeDynamic, nil
+	}
+	if scope.Strict {
+		return typeDynamic, conditionTypeError{pos: ident.Pos(), err: ErrUnknownConditionAttribute, msg: ident.Name}
+	}
+	return typeDynamic, nil
+}
+
+func (tc typeChecker) checkBinary(expr *ast.BinaryExpr) (conditionType, error) {
+	left, err := tc.check(expr.X)
+	if err != nil {
+		return typeDynamic, err
+	}
+	right, err := tc.check(expr.Y)
+	if err != nil {
+		return typeDynamic, err
+	}
+	switch expr.Op {
+	case token.LAND, token.LOR:
+		if !oneOf(left, typeBool) || !oneOf(right, typeBool) {
+			return typeDynamic, tc.errorf(expr, "operator %s requires bool operands, got %s and %s", expr.Op, left, right)
+		}
+		return typeBool, nil
+	case token.EQL, token.NEQ:
+		if !comparable(left, right) {
+			return typeDynamic, tc.errorf(expr, "can't compare %s and %s", left, right)
+		}
+		return typeBool, nil
+	case token.LSS, token.GTR, token.LEQ, token.GEQ:
+		if !comparable(left, right) || !oneOf(left, typeNumber, typeString, typeTimestamp, typeDuration) ||
+			!oneOf(right, typeNumber, typeString, typeTimestamp, typeDuration) {
+			return typeDynamic, tc.errorf(expr, "operator %s isn't defined for %s and %s", expr.Op, left, right)
+		}
+		return typeBool, nil
+	default:
+		result, ok := arithmeticResult(expr.Op, left, right)
+		if !ok {
+			return typeDynamic, tc.errorf(expr, "operator %s isn't defined for %s and %s", expr.Op, left, right)
+		}
+		return result, nil
+	}
+}
+
+func arithmeticResult(op token.Token, left, right conditionType) (conditionType, bool) {
+	if left == typeDynamic || right == typeDynamic {
+		// the result can still be narrowed if the known operand only supports one kind of result
+		if op == token.REM {
+			return typeNumber, oneOf(left, typeNumber) && oneOf(right, typeNumber)
+		}
+		return typeDynamic, !left.isList() && !right.isList() && left != typeBool && right != typeBool
+	}
+	switch op {
+	case token.ADD:
+		switch {
+		case left == typeNumber && right == typeNumber:
+			return typeNumber, true
+		case left == typeString && right == typeString:
+			return typeString, true
+		case left == typeTimestamp && right == typeDuration, left == typeDuration && right == typeTimestamp:
+			return typeTimestamp, true
+		case left == typeDuration && right == typeDuration:
+			return typeDuration, true
+		}
+	case token.SUB:
+		switch {
+		case left == typeNumber && right == typeNumber:
+			return typeNumber, true
+		case left == typeTimestamp && right == typeTimestamp:
+			return typeDuration, true
+		case left == typeTimestamp && right == typeDuration:
+			return typeTimestamp, true
+		case left == typeDuration && right == typeDuration:
+			return typeDuration, true
+		}
+	case token.MUL:
+		switch {
+		case left == typeNumber && right == typeNumber:
+			return typeNumber, true
+		case left == typeDuration && right == typeNumber, left == typeNumber && right == typeDuration:
+			return typeDuration, true
+		}
+	case token.QUO:
+		switch {
+		case left == typeNumber && right == typeNumber:
+			return typeNumber, true
+		case left == typeDuration && right == typeNumber:
+			return typeDuration, true
+		case left == typeDuration && right == typeDuration:
+			return typeNumber, true
+		}
+	case token.REM:
+		if left == typeNumber && right == typeNumber {
+			return typeNumber, true
+		}
+	}
+	return typeDynamic, false
+}
+
+type functionSignature func(tc typeChecker, call *ast.CallExpr, args []conditionType) (conditionType, error)
+
+var functionSignatures = map[string]functionSignature{
+	"startsWith":   fixedSignature(typeBool, typeString, typeString),
+	"endsWith":     fixedSignature(typeBool, typeString, typeString),
+	"matches":      fixedSignature(typeBool, typeString, typeString),
+	"lower":        fixedSignature(typeString, typeString),
+	"cidrContains": fixedSignature(typeBool, typeString, typeString),
+	"duration":     fixedSignature(typeDuration, typeString),
+	"now":          fixedSignature(typeTimestamp),
+	"timestamp": func(tc typeChecker, call *ast.CallExpr, args []conditionType) (conditionType, error) {
+		if !oneOf(args[0], typeString, typeTimestamp) {
+			return typeDynamic, tc.errorf(call.Args[0], "timestamp expects a string, got %s", args[0])
+		}
+		return typeTimestamp, nil
+	},
+	"len": func(tc typeChecker, call *ast.CallExpr, args []conditionType) (conditionType, error) {
+		if !oneOf(args[0], typeString, typeStringList, typeInt64List) {
+			return typeDynamic, tc.errorf(call.Args[0], "len expects a string or a list, got %s", args[0])
+		}
+		return typeNumber, nil
+	},
+	"contains": func(tc typeChecker, call *ast.CallExpr, args []conditionType) (conditionType, error) {
+		if args[0].isList() {
+			if !comparable(args[0].elem(), args[1]) {
+				return typeDynamic, tc.errorf(call.Args[1], "%s can't contain %s", args[0], args[1])
+			}
+			return typeBool, nil
+		}
+		if !oneOf(args[0], typeString) || !oneOf(args[1], typeString) {
+			return typeDynamic, tc.errorf(call, "contains expects a string and a substring or a list and an element, got %s and %s", args[0], args[1])
+		}
+		return typeBool, nil
+	},
+	"intersects": func(tc typeChecker, call *ast.CallExpr, args []conditionType) (conditionType, error) {
+		for i, arg := range args {
+			if arg != typeDynamic && !arg.isList() {
+				return typeDynamic, tc.errorf(call.Args[i], "intersects expects lists, got %s", arg)
+			}
+		}
+		if args[0] != typeDynamic && args[1] != typeDynamic && args[0] != args[1] {
+			return typeDynamic, tc.errorf(call, "intersects expects lists of the same kind, got %s and %s", args[0], args[1])
+		}
+		return typeBool, nil
+	},
+	"in": func(tc typeChecker, call *ast.CallExpr, args []conditionType) (conditionType, error) {
+		if args[0].isList() {
+			return typeDynamic, tc.errorf(call.Args[0], "in expects a single value, got %s", args[0])
+		}
+		for i, arg := range args[1:] {
+			elem := arg
+			if arg.isList() {
+				elem = arg.elem()
+			}
+			if !comparable(args[0], elem) {
+				return typeDynamic, tc.errorf(call.Args[i+1], "%s can't be compared to %s", args[0], arg)
+			}
+		}
+		return typeBool, nil
+	},
+}
+
+func fixedSignature(result conditionType, params ...conditionType) functionSignature {
+	return func(tc typeChecker, call *ast.CallExpr, args []conditionType) (conditionType, error) {
+		name := call.Fun.(*ast.Ident).Name
+		for i, param := range params {
+			if !oneOf(args[i], param) {
+				return typeDynamic, tc.errorf(call.Args[i], "argument %d of %s must be %s, got %s", i+1, name, param, args[i])
+			}
+		}
+		return result, nil
+	}
+}
+
+func (tc typeChecker) checkCall(call *ast.CallExpr) (conditionType, error) {
+	if err := validateCall(call); err != nil {
+		return typeDynamic, err
+	}
+	if conditionFunctions[call.Fun.(*ast.Ident).Name].graph {
+		return typeBool, nil
+	}
+	args := make([]conditionType, len(call.Args))
+	for i, arg := range call.Args {
+		argType, err := tc.check(arg)
+		if err != nil {
+			return typeDynamic, err
+		}
+		args[i] = argType
+	}
+	signature, ok := functionSignatures[call.Fun.(*ast.Ident).Name]
+	if !ok {
+		return typeDynamic, nil
+	}
+	return signature(tc, call, args)
+}
+
+// oneOf reports whether t is one of the allowed types, dynamic types are always allowed
+func oneOf(t conditionType, allowed ...conditionType) bool {
+	if t == typeDynamic {
+		return true
+	}
+	for _, a := range allowed {
+		if t == a {
+			return true
+		}
+	}
+	return false
+}
+
+func comparable(left, right conditionType) bool {
+	return left == typeDynamic || right == typeDynamic || left == right
+}
+
+// isDateLiteral mirrors the formats that govaluate parses string literals with
+func isDateLiteral(value string) bool {
+	formats := []string{
+		time.ANSIC,
+		time.UnixDate,
+		time.RubyDate,
+		time.Kitchen,
+		time.RFC3339,
+		time.RFC3339Nano,
+		"2006-01-02",
+		"2006-01-02 15:04",
+		"2006-01-02 15:04:05",
+		"2006-01-02 15:04:05-07:00",
+		"2006-01-02T15Z0700",
+		"2006-01-02T15:04Z0700",
+		"2006-01-02T15:04:05Z0700",
+		"2006-01-02T15:04:05.999999999Z0700",
+	}
+	for _, format := range formats {
+		if _, err := time.ParseInLocation(format, value, time.Local); err == nil {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,131 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConditionTypeCheck(t *testing.T) {
+	env := ConditionTypeEnv{
+		Subject: ConditionScope{
+			Kinds: map[string]AttributeKind{
+				"clearance": Int64,
+				"name":      String,
+				"admin":     Bool,
+				"roles":     StringList,
+				"since":     Timestamp,
+				"session":   Duration,
+			},
+			Strict: true,
+		},
+		Object: ConditionScope{
+			Kinds: map[string]AttributeKind{
+				"clearance": Int64,
+				"owners":    StringList,
+				"ids":       Int64List,
+				"expires":   Timestamp,
+			},
+		},
+	}
+	testCases := []struct {
+		expression  string
+		err         error
+		description string
+	}{
+		{
+			expression:  "sub_clearance >= obj_clearance",
+			err:         nil,
+			description: "comparing numbers",
+		},
+		{
+			expression:  "sub_admin || contains(obj_owners, sub_name)",
+			err:         nil,
+			description: "bool attribute and list membership",
+		},
+		{
+			expression:  "obj_expires - sub_since > duration(\"24h\")",
+			err:         nil,
+			description: "timestamp difference compared to a duration",
+		},
+		{
+			expression:  "sub_since + sub_session > now() && obj_expires > \"2024-01-01\"",
+			err:         nil,
+			description: "timestamp arithmetic and date literals",
+		},
+		{
+			expression:  "in(obj_clearance, obj_ids, 7)",
+			err:         nil,
+			description: "in with a list and a single value",
+		},
+		{
+			expression:  "obj_unknown == sub_name && env_ip == 1",
+			err:         nil,
+			description: "unknown attributes of a non strict scope and env attributes are dynamic",
+		},
+		{
+			expression:  "sub_clearence > 3",
+			err:         ErrUnknownConditionAttribute,
+			description: "misspelled attribute of a strict scope",
+		},
+		{
+			expression:  "sub_name > 3",
+			err:         ErrConditionType,
+			description: "comparing a string to a number",
+		},
+		{
+			expression:  "sub_roles + 1 > 2",
+			err:         ErrConditionType,
+			description: "arithmetic on a list",
+		},
+		{
+			expression:  "sub_since + sub_since > now()",
+			err:         ErrConditionType,
+			description: "adding timestamps",
+		},
+		{
+			expression:  "sub_clearance && sub_admin",
+			err:         ErrConditionType,
+			description: "logical operator on a number",
+		},
+		{
+			expression:  "contains(obj_ids, sub_name)",
+			err:         ErrConditionType,
+			description: "int64 list can't contain a string",
+		},
+		{
+			expression:  "intersects(obj_owners, obj_ids)",
+			err:         ErrConditionType,
+			description: "intersecting lists of different kinds",
+		},
+		{
+			expression:  "startsWith(sub_clearance, \"a\")",
+			err:         ErrConditionType,
+			description: "string function applied to a number",
+		},
+		{
+			expression:  "sub_clearance + 1",
+			err:         ErrConditionType,
+			description: "condition that doesn't evaluate to a bool",
+		},
+	}
+	for _, testCase := range testCases {
+		c := testCase
+		t.Run(c.description, func(t *testing.T) {
+			t.Parallel()
+			condition, err := NewCondition(c.expression)
+			assert.Nil(t, err)
+			assert.ErrorIs(t, condition.TypeCheck(env), c.err)
+		})
+	}
+}
+
+func TestConditionTypeCheckPosition(t *testing.T) {
+	condition, err := NewCondition("sub_admin && sub_name > 3")
+	assert.Nil(t, err)
+	err = condition.TypeCheck(ConditionTypeEnv{
+		Subject: ConditionScope{Kinds: map[string]AttributeKind{"admin": Bool, "name": String}},
+	})
+	assert.ErrorIs(t, err, ErrConditionType)
+	assert.Contains(t, err.Error(), "column 14")
+}
@@ -0,0 +1,76 @@
+package domain
+
+import "errors"
+
+var ErrImplicationCycle = errors.New("permission implication would create a cycle")
+
+// PermissionImplication states that a policy granting Permission also grants ImpliedPermission.
+// Only allow policies are propagated, a deny on the implying permission doesn't deny the implied one.
+// Implications are followed transitively through the names they are declared on,
+// an implication of a wildcard name isn't chained with implications of the names it matches.
+type PermissionImplication struct {
+	permission        string
+	impliedPermission string
+}
+
+func NewPermissionImplication(permission, impliedPermission string) (*PermissionImplication, error) {
+	if err := ValidatePermissionName(permission); err != nil {
+		return nil, err
+	}
+	if err := ValidatePermissionName(impliedPermission); err != nil {
+		return nil, err
+	}
+	if permission == impliedPermission {
+		return nil, ErrImplicationCycle
+	}
+	return &PermissionImplication{
+		permission:        permission,
+		impliedPermission: impliedPermission,
+	}, nil
+}
+
+func (i PermissionImplication) Permission() string {
+	return i.permission
+}
+
+func (i PermissionImplication) ImpliedPermission() string {
+	return i.impliedPermission
+}
+
+// ImplyingPermissions returns the names of the permissions that transitively imply any of the names,
+// repos that don't traverse implications natively use it to expand a requested permission
+func ImplyingPermissions(implications []PermissionImplication, names []string) []string {
+	implyingByImplied := make(map[string][]string)
+	for _, implication := range implications {
+		implyingByImplied[implication.impliedPermission] = append(implyingByImplied[implication.impliedPermission], implication.permission)
+	}
+	visited := make(map[string]bool)
+	queue := append(make([]string, 0, len(names)), names...)
+	implying := make([]string, 0)
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+		for _, permission := range implyingByImplied[name] {
+			if visited[permission] {
+				continue
+			}
+			visited[permission] = true
+			implying = append(implying, permission)
+			queue = append(queue, permission)
+		}
+	}
+	return implying
+}
+
+// CreatesImplicationCycle reports whether adding the implication to the existing ones would create a cycle
+func CreatesImplicationCycle(implications []PermissionImplication, implication PermissionImplication) bool {
+	if implication.permission == implication.impliedPermission {
+		return true
+	}
+	for _, name := range ImplyingPermissions(implications, []string{implication.permission}) {
+		if name == implication.impliedPermission {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestImplications(t *testing.T, pairs ...[2]string) []PermissionImplication {
+	implications := make([]PermissionImplication, len(pairs))
+	for i, pair := range pairs {
+		implication, err := NewPermissionImplication(pair[0], pair[1])
+		assert.Nil(t, err)
+		implications[i] = *implication
+	}
+	return implications
+}
+
+func TestImplyingPermissions(t *testing.T) {
+	implications := newTestImplications(t,
+		[2]string{"config.write", "config.read"},
+		[2]string{"config.admin", "config.write"},
+		[2]string{"cluster.admin", "cluster.*"},
+	)
+	testCases := []struct {
+		names       []string
+		implying    []string
+		description string
+	}{
+		{
+			names:       []string{"config.read"},
+			implying:    []string{"config.write", "config.admin"},
+			description: "transitive implication",
+		},
+		{
+			names:       PermissionNameCandidates("cluster.list"),
+			implying:    []string{"cluster.admin"},
+			description: "implication of a wildcard name",
+		},
+		{
+			names:       []string{"config.admin"},
+			implying:    []string{},
+			description: "nothing implies the permission",
+		},
+	}
+	for _, testCase := range testCases {
+		c := testCase
+		t.Run(c.description, func(t *testing.T) {
+			t.Parallel()
+			assert.ElementsMatch(t, c.implying, ImplyingPermissions(implications, c.names))
+		})
+	}
+}
+
+func TestCreatesImplicationCycle(t *testing.T) {
+	implications := newTestImplications(t,
+		[2]string{"a", "b"},
+		[2]string{"b", "c"},
+	)
+	closing := newTestImplications(t, [2]string{"c", "a"})[0]
+	assert.True(t, CreatesImplicationCycle(implications, closing))
+	reverse := newTestImplications(t, [2]string{"b", "a"})[0]
+	assert.True(t, CreatesImplicationCycle(implications, reverse))
+	shortcut := newTestImplications(t, [2]string{"a", "c"})[0]
+	assert.False(t, CreatesImplicationCycle(implications, shortcut))
+
+	_, err := NewPermissionImplication("a", "a")
+	assert.ErrorIs(t, err, ErrImplicationCycle)
+}
@@ -0,0 +1,18 @@
+package domain
+
+import "errors"
+
+// DefaultMaxInheritanceDepth bounds the longest INHERITS_FROM chain,
+// the implicit relationship of every resource to the root is counted too
+const DefaultMaxInheritanceDepth = 100
+
+var (
+	ErrInheritanceCycle = errors.New("inheritance relationship would create a cycle")
+	ErrInheritanceDepth = errors.New("inheritance relationship would exceed the maximum depth")
+)
+
+// InheritanceCycle lists the resources of a cycle in inheritance order,
+// each resource inherits from the next one and the last one inherits from the first one
+type InheritanceCycle struct {
+	Resources []Resource
+}
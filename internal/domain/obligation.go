@@ -0,0 +1,44 @@
+package domain
+
+import "errors"
+
+var ErrObligationNameEmpty = errors.New("obligation name empty")
+
+// Obligation is a named directive returned together with a decision,
+// services must fulfil obligations, while advice can be ignored
+type Obligation struct {
+	name   string
+	params map[string]string
+	advice bool
+}
+
+func NewObligation(name string, params map[string]string, advice bool) (*Obligation, error) {
+	if name == "" {
+		return nil, ErrObligationNameEmpty
+	}
+	if params == nil {
+		params = make(map[string]string)
+	}
+	return &Obligation{
+		name:   name,
+		params: params,
+		advice: advice,
+	}, nil
+}
+
+func (o Obligation) Name() string {
+	return o.name
+}
+
+func (o Obligation) Params() map[string]string {
+	return o.params
+}
+
+func (o Obligation) Param(key string) (string, bool) {
+	value, ok := o.params[key]
+	return value, ok
+}
+
+func (o Obligation) Advice() bool {
+	return o.advice
+}
@@ -0,0 +1,99 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestObligation(t *testing.T, name string, advice bool) Obligation {
+	obligation, err := NewObligation(name, map[string]string{"key": name}, advice)
+	assert.Nil(t, err)
+	return *obligation
+}
+
+func newTestPermissionWithObligations(t *testing.T, kind PermissionKind, expression string, policy ConditionErrorPolicy, obligations ...Obligation) Permission {
+	condition, err := NewCondition(expression)
+	assert.Nil(t, err)
+	permission, err := NewPermission("p", kind, *condition, policy, obligations)
+	assert.Nil(t, err)
+	return *permission
+}
+
+func TestDecisionObligations(t *testing.T) {
+	audit := newTestObligation(t, "audit", false)
+	mask := newTestObligation(t, "mask", false)
+	message := newTestObligation(t, "message", true)
+	unused := newTestObligation(t, "unused", false)
+
+	testCases := []struct {
+		level       PermissionLevel
+		algorithm   CombiningAlgorithm
+		result      EvalResult
+		obligations []Obligation
+		description string
+	}{
+		{
+			level: PermissionLevel{
+				newTestPermissionWithObligations(t, PermissionKindAllow, "", ConditionErrorSkip, audit),
+				newTestPermissionWithObligations(t, PermissionKindAllow, "", ConditionErrorSkip, mask),
+				newTestPermissionWithObligations(t, PermissionKindDeny, "sub_clearance > 5", ConditionErrorSkip, unused),
+			},
+			result:      EvalResultAllowed,
+			obligations: []Obligation{audit, mask},
+			description: "obligations of all allowing permissions in the deciding level",
+		},
+		{
+			level: PermissionLevel{
+				newTestPermissionWithObligations(t, PermissionKindAllow, "", ConditionErrorSkip, audit),
+				newTestPermissionWithObligations(t, PermissionKindDeny, "", ConditionErrorSkip, message),
+			},
+			result:      EvalResultDenied,
+			obligations: []Obligation{message},
+			description: "overriding deny with advice",
+		},
+		{
+			level: PermissionLevel{
+				newTestPermissionWithObligations(t, PermissionKindAllow, "", ConditionErrorSkip, audit),
+				newTestPermissionWithObligations(t, PermissionKindDeny, "", ConditionErrorSkip, message),
+			},
+			algorithm:   CombiningAlgorithmFirstApplicable,
+			result:      EvalResultDenied,
+			obligations: []Obligation{message},
+			description: "first applicable permission only, denies come first",
+		},
+		{
+			level: PermissionLevel{
+				newTestPermissionWithObligations(t, PermissionKindAllow, "sub_missing > 1", ConditionErrorDeny, audit),
+			},
+			result:      EvalResultDenied,
+			obligations: nil,
+			description: "permission failing closed doesn't contribute its obligations",
+		},
+	}
+	for _, testCase := range testCases {
+		c := testCase
+		t.Run(c.description, func(t *testing.T) {
+			t.Parallel()
+			hierarchy := PermissionHierarchy{0: PermissionObjHierarchy{0: c.level}}
+			decision := hierarchy.Eval(PermissionEvalRequest{
+				Subject:   []Attribute{attr("clearance", Int64, int64(3))},
+				Algorithm: c.algorithm,
+			})
+			assert.Equal(t, c.result, decision.Result)
+			assert.Equal(t, c.obligations, decision.Obligations)
+		})
+	}
+}
+
+func TestNewObligation(t *testing.T) {
+	_, err := NewObligation("", nil, false)
+	assert.ErrorIs(t, err, ErrObligationNameEmpty)
+
+	obligation, err := NewObligation("log", nil, true)
+	assert.Nil(t, err)
+	assert.NotNil(t, obligation.Params())
+	assert.True(t, obligation.Advice())
+	_, ok := obligation.Param("sink")
+	assert.False(t, ok)
+}
@@ -1,7 +1,7 @@
 package domain
 
 import (
-	"log"
+	"fmt"
 	"sort"
 )
 
@@ -22,23 +22,93 @@ const (
 
 const DefaultEvalResult = EvalResultDenied
 
+// ConditionErrorPolicy decides how a permission whose condition errored is evaluated
+type ConditionErrorPolicy int
+
+const (
+	// ConditionErrorSkip treats the permission as non evaluative
+	ConditionErrorSkip ConditionErrorPolicy = iota
+	// ConditionErrorDeny fails closed, the permission denies regardless of its kind
+	ConditionErrorDeny
+)
+
+// ConditionError records a condition that couldn't be evaluated during authorization
+type ConditionError struct {
+	Permission       string
+	MissingAttribute string
+	Err              error
+}
+
+func (e ConditionError) Error() string {
+	return fmt.Sprintf("permission %s: %s", e.Permission, e.Err.Error())
+}
+
+func (e ConditionError) Unwrap() error {
+	return e.Err
+}
+
+// Decision is the outcome of evaluating a permission hierarchy
+type Decision struct {
+	Result EvalResult
+	// ConditionErrors holds the errors of all conditions evaluated before the decision was reached
+	ConditionErrors []ConditionError
+	// Obligations holds the obligations and advice of the permissions that decided the result
+	Obligations []Obligation
+	// Explanation is only set if the evaluation was requested with Explain
+	Explanation *Explanation
+}
+
+// EvaluatedPermission is a permission together with the inheritance distances it was found at
+type EvaluatedPermission struct {
+	Permission      Permission
+	SubjectPriority PermissionPriority
+	ObjectPriority  PermissionPriority
+}
+
+// Explanation describes how a decision was reached
+type Explanation struct {
+	// Deciding is the permission that produced the result, nil if the default result was used
+	Deciding *EvaluatedPermission
+	// NonApplicable holds the permissions that were evaluated, but didn't apply
+	NonApplicable []EvaluatedPermission
+}
+
+func (e *Explanation) DefaultResult() bool {
+	return e.Deciding == nil
+}
+
 type PermissionEvalRequest struct {
 	Subject []Attribute
 	Object  []Attribute
 	Env     []Attribute
+	// Relations holds the resolved graph predicates of the conditions in the hierarchy
+	Relations GraphRelations
+	Explain   bool
+	// Algorithm combines the results of the permissions in the hierarchy
+	Algorithm CombiningAlgorithm
 }
 
 type Permission struct {
-	name      string
-	kind      PermissionKind
-	condition Condition
+	name             string
+	kind             PermissionKind
+	condition        Condition
+	onConditionError ConditionErrorPolicy
+	obligations      []Obligation
 }
 
-func NewPermission(name string, kind PermissionKind, condition Condition) (*Permission, error) {
+func NewPermission(name string, kind PermissionKind, condition Condition, onConditionError ConditionErrorPolicy, obligations []Obligation) (*Permission, error) {
+	if err := ValidatePermissionName(name); err != nil {
+		return nil, err
+	}
+	if obligations == nil {
+		obligations = make([]Obligation, 0)
+	}
 	return &Permission{
-		name:      name,
-		kind:      kind,
-		condition: condition,
+		name:             name,
+		kind:             kind,
+		condition:        condition,
+		onConditionError: onConditionError,
+		obligations:      obligations,
 	}, nil
 }
 
@@ -54,49 +124,140 @@ func (p Permission) Condition() Condition {
 	return p.condition
 }
 
-func (p Permission) eval(req PermissionEvalRequest) EvalResult {
-	log.Println("perm eval")
-	if !p.condition.Eval(req.Subject, req.Object, req.Env) {
-		return EvalResultNonEvaluative
+func (p Permission) OnConditionError() ConditionErrorPolicy {
+	return p.onConditionError
+}
+
+// Obligations are returned with a decision if the permission decided it with its own kind
+func (p Permission) Obligations() []Obligation {
+	return p.obligations
+}
+
+// effect is the result the permission produces when its condition holds
+func (p Permission) effect() EvalResult {
+	if p.kind == PermissionKindDeny {
+		return EvalResultDenied
+	}
+	return EvalResultAllowed
+}
+
+func (p Permission) eval(req PermissionEvalRequest) (EvalResult, *ConditionError) {
+	condResult := p.condition.EvalWithRelations(req.Subject, req.Object, req.Env, req.Relations)
+	if condResult.Errored() {
+		condErr := &ConditionError{
+			Permission:       p.name,
+			MissingAttribute: condResult.MissingAttribute,
+			Err:              condResult.Err,
+		}
+		if p.onConditionError == ConditionErrorDeny {
+			return EvalResultDenied, condErr
+		}
+		return EvalResultNonEvaluative, condErr
+	}
+	if !condResult.Value {
+		return EvalResultNonEvaluative, nil
 	}
 	if p.kind == PermissionKindAllow {
-		return EvalResultAllowed
+		return EvalResultAllowed, nil
 	}
 	if p.kind == PermissionKindDeny {
-		return EvalResultDenied
+		return EvalResultDenied, nil
 	}
-	return EvalResultNonEvaluative
+	return EvalResultNonEvaluative, nil
 }
 
 type PermissionLevel []Permission
 
-func (level PermissionLevel) eval(req PermissionEvalRequest) EvalResult {
-	res := EvalResultNonEvaluative
+// eval evaluates the permissions with the most specific name first,
+// permissions on less specific wildcard names are only considered if none of them applied
+func (level PermissionLevel) eval(req PermissionEvalRequest, subPriority, objPriority PermissionPriority, decision *Decision) EvalResult {
+	for _, group := range level.groupBySpecificityDesc() {
+		if res := group.evalGroup(req, subPriority, objPriority, decision); res != EvalResultNonEvaluative {
+			return res
+		}
+	}
+	return EvalResultNonEvaluative
+}
+
+func (level PermissionLevel) groupBySpecificityDesc() []PermissionLevel {
+	groups := make(map[int]PermissionLevel)
+	keys := make([]int, 0, 1)
 	for _, permission := range level {
-		curr := permission.eval(req)
-		if curr == EvalResultDenied {
-			return EvalResultDenied
+		specificity := PermissionNameSpecificity(permission.name)
+		if _, ok := groups[specificity]; !ok {
+			keys = append(keys, specificity)
+		}
+		groups[specificity] = append(groups[specificity], permission)
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(keys)))
+	levels := make([]PermissionLevel, len(keys))
+	for i, key := range keys {
+		levels[i] = groups[key].sorted()
+	}
+	return levels
+}
+
+// sorted orders the permissions by name, denies before allows and then by condition,
+// so the first applicable permission doesn't depend on the order the repo returned them in
+func (level PermissionLevel) sorted() PermissionLevel {
+	sorted := append(make(PermissionLevel, 0, len(level)), level...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].name != sorted[j].name {
+			return sorted[i].name < sorted[j].name
+		}
+		if sorted[i].kind != sorted[j].kind {
+			return sorted[i].kind == PermissionKindDeny
+		}
+		return sorted[i].condition.Expression() < sorted[j].condition.Expression()
+	})
+	return sorted
+}
+
+func (level PermissionLevel) evalGroup(req PermissionEvalRequest, subPriority, objPriority PermissionPriority, decision *Decision) EvalResult {
+	overriding := req.Algorithm.overriding()
+	res := EvalResultNonEvaluative
+	var deciding *Permission
+	applicable := make([]Permission, 0, len(level))
+	for i, permission := range level {
+		curr, condErr := permission.eval(req)
+		if condErr != nil {
+			decision.ConditionErrors = append(decision.ConditionErrors, *condErr)
+		}
+		if curr == EvalResultNonEvaluative {
+			decision.explainNonApplicable(permission, subPriority, objPriority)
+			continue
+		}
+		if curr == overriding || req.Algorithm == CombiningAlgorithmFirstApplicable {
+			decision.explainDeciding(permission, subPriority, objPriority)
+			decision.addObligations(curr, permission)
+			return curr
 		}
-		if curr != EvalResultNonEvaluative {
+		applicable = append(applicable, permission)
+		if deciding == nil {
+			deciding = &level[i]
 			res = curr
 		}
 	}
+	if deciding != nil {
+		decision.explainDeciding(*deciding, subPriority, objPriority)
+		decision.addObligations(res, applicable...)
+	}
 	return res
 }
 
 type PermissionPriority int
 type PermissionObjHierarchy map[PermissionPriority]PermissionLevel
 
-func (hierarchy PermissionObjHierarchy) eval(req PermissionEvalRequest) EvalResult {
-	for _, level := range hierarchy.sortByPriorityDesc() {
-		if res := level.eval(req); res != EvalResultNonEvaluative {
+func (hierarchy PermissionObjHierarchy) eval(req PermissionEvalRequest, subPriority PermissionPriority, decision *Decision) EvalResult {
+	for _, objPriority := range hierarchy.prioritiesDesc() {
+		if res := hierarchy[objPriority].eval(req, subPriority, objPriority, decision); res != EvalResultNonEvaluative {
 			return res
 		}
 	}
-	return DefaultEvalResult
+	return EvalResultNonEvaluative
 }
 
-func (hierarchy PermissionObjHierarchy) sortByPriorityDesc() []PermissionLevel {
+func (hierarchy PermissionObjHierarchy) prioritiesDesc() []PermissionPriority {
 	keys := make([]PermissionPriority, 0, len(hierarchy))
 	for k := range hierarchy {
 		keys = append(keys, k)
@@ -104,25 +265,59 @@ func (hierarchy PermissionObjHierarchy) sortByPriorityDesc() []PermissionLevel {
 	sort.Slice(keys, func(i, j int) bool {
 		return keys[i] > keys[j]
 	})
-	levels := make([]PermissionLevel, 0, len(hierarchy))
-	for _, key := range keys {
-		levels = append(levels, hierarchy[key])
-	}
-	return levels
+	return keys
 }
 
 type PermissionHierarchy map[PermissionPriority]PermissionObjHierarchy
 
-func (hierarchy PermissionHierarchy) Eval(req PermissionEvalRequest) EvalResult {
-	for _, objHierarchy := range hierarchy.sortByPriorityDesc() {
-		if res := objHierarchy.eval(req); res != EvalResultNonEvaluative {
-			return res
+func (hierarchy PermissionHierarchy) Eval(req PermissionEvalRequest) Decision {
+	decision := Decision{Result: DefaultEvalResult}
+	if req.Explain {
+		decision.Explanation = &Explanation{NonApplicable: make([]EvaluatedPermission, 0)}
+	}
+	if req.Algorithm == CombiningAlgorithmDenyUnlessPermit || req.Algorithm == CombiningAlgorithmPermitUnlessDeny {
+		decision.Result = hierarchy.evalUnless(req, &decision)
+		return decision
+	}
+	for _, subPriority := range hierarchy.prioritiesDesc() {
+		if res := hierarchy[subPriority].eval(req, subPriority, &decision); res != EvalResultNonEvaluative {
+			decision.Result = res
+			return decision
+		}
+	}
+	return decision
+}
+
+// evalUnless looks for the overriding result of the algorithm in the whole hierarchy,
+// without considering how near the permissions are, and returns the opposite result if none is found
+func (hierarchy PermissionHierarchy) evalUnless(req PermissionEvalRequest, decision *Decision) EvalResult {
+	overriding := req.Algorithm.overriding()
+	for _, subPriority := range hierarchy.prioritiesDesc() {
+		objHierarchy := hierarchy[subPriority]
+		for _, objPriority := range objHierarchy.prioritiesDesc() {
+			for _, permission := range objHierarchy[objPriority].sorted() {
+				curr, condErr := permission.eval(req)
+				if condErr != nil {
+					decision.ConditionErrors = append(decision.ConditionErrors, *condErr)
+				}
+				if curr == overriding {
+					decision.explainDeciding(permission, subPriority, objPriority)
+					decision.addObligations(overriding, permission)
+					return overriding
+				}
+				if curr == EvalResultNonEvaluative {
+					decision.explainNonApplicable(permission, subPriority, objPriority)
+				}
+			}
 		}
 	}
-	return DefaultEvalResult
+	if overriding == EvalResultAllowed {
+		return EvalResultDenied
+	}
+	return EvalResultAllowed
 }
 
-func (hierarchy PermissionHierarchy) sortByPriorityDesc() []PermissionObjHierarchy {
+func (hierarchy PermissionHierarchy) prioritiesDesc() []PermissionPriority {
 	keys := make([]PermissionPriority, 0, len(hierarchy))
 	for k := range hierarchy {
 		keys = append(keys, k)
@@ -130,9 +325,37 @@ func (hierarchy PermissionHierarchy) sortByPriorityDesc() []PermissionObjHierarc
 	sort.Slice(keys, func(i, j int) bool {
 		return keys[i] > keys[j]
 	})
-	levels := make([]PermissionObjHierarchy, 0, len(hierarchy))
-	for _, key := range keys {
-		levels = append(levels, hierarchy[key])
+	return keys
+}
+
+func (d *Decision) explainDeciding(permission Permission, subPriority, objPriority PermissionPriority) {
+	if d.Explanation == nil {
+		return
+	}
+	d.Explanation.Deciding = &EvaluatedPermission{
+		Permission:      permission,
+		SubjectPriority: subPriority,
+		ObjectPriority:  objPriority,
+	}
+}
+
+func (d *Decision) explainNonApplicable(permission Permission, subPriority, objPriority PermissionPriority) {
+	if d.Explanation == nil {
+		return
+	}
+	d.Explanation.NonApplicable = append(d.Explanation.NonApplicable, EvaluatedPermission{
+		Permission:      permission,
+		SubjectPriority: subPriority,
+		ObjectPriority:  objPriority,
+	})
+}
+
+// addObligations collects the obligations of the permissions whose kind matches the decided result,
+// permissions that only denied because their condition errored don't contribute
+func (d *Decision) addObligations(result EvalResult, permissions ...Permission) {
+	for _, permission := range permissions {
+		if permission.effect() == result {
+			d.Obligations = append(d.Obligations, permission.obligations...)
+		}
 	}
-	return levels
 }
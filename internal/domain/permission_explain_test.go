@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestPermission(t *testing.T, kind PermissionKind, expression string) Permission {
+	condition, err := NewCondition(expression)
+	assert.Nil(t, err)
+	permission, err := NewPermission("p", kind, *condition, ConditionErrorSkip, nil)
+	assert.Nil(t, err)
+	return *permission
+}
+
+func TestPermissionHierarchyExplain(t *testing.T) {
+	notApplicable := newTestPermission(t, PermissionKindAllow, "sub_clearance > 5")
+	deny := newTestPermission(t, PermissionKindDeny, "sub_clearance < 5")
+	allow := newTestPermission(t, PermissionKindAllow, "")
+	sub := []Attribute{attr("clearance", Int64, int64(3))}
+
+	testCases := []struct {
+		hierarchy     PermissionHierarchy
+		result        EvalResult
+		deciding      *EvaluatedPermission
+		nonApplicable []EvaluatedPermission
+		description   string
+	}{
+		{
+			hierarchy: PermissionHierarchy{
+				0: PermissionObjHierarchy{
+					0:  PermissionLevel{notApplicable},
+					-1: PermissionLevel{deny, allow},
+				},
+			},
+			result:   EvalResultDenied,
+			deciding: &EvaluatedPermission{Permission: deny, SubjectPriority: 0, ObjectPriority: -1},
+			nonApplicable: []EvaluatedPermission{
+				{Permission: notApplicable, SubjectPriority: 0, ObjectPriority: 0},
+			},
+			description: "deny at a farther object level",
+		},
+		{
+			hierarchy: PermissionHierarchy{
+				-2: PermissionObjHierarchy{
+					0: PermissionLevel{notApplicable, allow},
+				},
+			},
+			result:   EvalResultAllowed,
+			deciding: &EvaluatedPermission{Permission: allow, SubjectPriority: -2, ObjectPriority: 0},
+			nonApplicable: []EvaluatedPermission{
+				{Permission: notApplicable, SubjectPriority: -2, ObjectPriority: 0},
+			},
+			description: "allow inherited by the subject",
+		},
+		{
+			hierarchy: PermissionHierarchy{
+				0: PermissionObjHierarchy{
+					0: PermissionLevel{notApplicable},
+				},
+			},
+			result:   DefaultEvalResult,
+			deciding: nil,
+			nonApplicable: []EvaluatedPermission{
+				{Permission: notApplicable, SubjectPriority: 0, ObjectPriority: 0},
+			},
+			description: "default result",
+		},
+	}
+	for _, testCase := range testCases {
+		c := testCase
+		t.Run(c.description, func(t *testing.T) {
+			t.Parallel()
+			decision := c.hierarchy.Eval(PermissionEvalRequest{Subject: sub, Explain: true})
+			assert.Equal(t, c.result, decision.Result)
+			if !assert.NotNil(t, decision.Explanation) {
+				return
+			}
+			assert.Equal(t, c.deciding, decision.Explanation.Deciding)
+			assert.Equal(t, c.deciding == nil, decision.Explanation.DefaultResult())
+			assert.Equal(t, c.nonApplicable, decision.Explanation.NonApplicable)
+		})
+	}
+}
+
+func TestPermissionHierarchyWithoutExplain(t *testing.T) {
+	hierarchy := PermissionHierarchy{
+		0: PermissionObjHierarchy{
+			0: PermissionLevel{newTestPermission(t, PermissionKindAllow, "")},
+		},
+	}
+	decision := hierarchy.Eval(PermissionEvalRequest{})
+	assert.Equal(t, EvalResultAllowed, decision.Result)
+	assert.Nil(t, decision.Explanation)
+}
@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Permission names are dotted namespaces, a policy on a name ending with a wildcard segment
+// applies to every matching name.
+// "cluster.*" matches names with exactly one segment after "cluster", e.g. "cluster.list",
+// while "cluster.**" matches names with one or more segments after it, e.g. "cluster.node.delete".
+const (
+	PermissionNameSeparator      = "."
+	PermissionNameSingleWildcard = "*"
+	PermissionNameMultiWildcard  = "**"
+)
+
+var ErrInvalidPermissionName = errors.New("permission name invalid, wildcards are only allowed as the last segment")
+
+func ValidatePermissionName(name string) error {
+	// repos use NUL to separate the parts of their keys
+	if strings.ContainsRune(name, 0) {
+		return fmt.Errorf("%w: NUL characters aren't allowed", ErrInvalidPermissionName)
+	}
+	segments := strings.Split(name, PermissionNameSeparator)
+	for i, segment := range segments {
+		last := i == len(segments)-1
+		if last && (segment == PermissionNameSingleWildcard || segment == PermissionNameMultiWildcard) {
+			continue
+		}
+		if strings.Contains(segment, PermissionNameSingleWildcard) {
+			return ErrInvalidPermissionName
+		}
+	}
+	return nil
+}
+
+// PermissionNameCandidates returns the name itself and all the wildcard names that match it,
+// ordered from the most to the least specific one.
+// Break glass permissions are only matched by wildcards within BreakGlassPermissionPrefix,
+// so that e.g. a policy on "**" doesn't permit breaking glass.
+func PermissionNameCandidates(name string) []string {
+	segments := strings.Split(name, PermissionNameSeparator)
+	breakGlass := IsBreakGlassPermissionName(name)
+	candidates := make([]string, 0, 2*len(segments)+1)
+	candidates = append(candidates, name)
+	for i := len(segments) - 1; i >= 0; i-- {
+		prefix := segments[:i]
+		if i == len(segments)-1 {
+			candidates = append(candidates, joinPermissionName(prefix, PermissionNameSingleWildcard))
+		}
+		candidate := joinPermissionName(prefix, PermissionNameMultiWildcard)
+		if breakGlass && !IsBreakGlassPermissionName(candidate) {
+			break
+		}
+		candidates = append(candidates, candidate)
+	}
+	return candidates
+}
+
+func joinPermissionName(prefix []string, wildcard string) string {
+	return strings.Join(append(append(make([]string, 0, len(prefix)+1), prefix...), wildcard), PermissionNameSeparator)
+}
+
+// PermissionNameSpecificity ranks names so that a name is more specific than any wildcard matching it,
+// a single segment wildcard is more specific than a multi segment one with the same prefix
+// and a longer prefix is more specific than a shorter one
+func PermissionNameSpecificity(name string) int {
+	segments := strings.Split(name, PermissionNameSeparator)
+	last := segments[len(segments)-1]
+	switch last {
+	case PermissionNameMultiWildcard:
+		return 2 * (len(segments) - 1)
+	case PermissionNameSingleWildcard:
+		return 2*(len(segments)-1) + 1
+	default:
+		return 2 * len(segments)
+	}
+}
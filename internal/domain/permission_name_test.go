@@ -0,0 +1,118 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPermissionNameCandidates(t *testing.T) {
+	testCases := []struct {
+		name        string
+		candidates  []string
+		description string
+	}{
+		{
+			name:        "cluster.node.delete",
+			candidates:  []string{"cluster.node.delete", "cluster.node.*", "cluster.node.**", "cluster.**", "**"},
+			description: "nested name",
+		},
+		{
+			name:        "cluster",
+			candidates:  []string{"cluster", "*", "**"},
+			description: "single segment name",
+		},
+		{
+			name:        "oort.breakglass.db.write",
+			candidates:  []string{"oort.breakglass.db.write", "oort.breakglass.db.*", "oort.breakglass.db.**", "oort.breakglass.**"},
+			description: "break glass name",
+		},
+		{
+			name:        "oort.breakglass.write",
+			candidates:  []string{"oort.breakglass.write", "oort.breakglass.*", "oort.breakglass.**"},
+			description: "break glass name of a single segment permission",
+		},
+	}
+	for _, testCase := range testCases {
+		c := testCase
+		t.Run(c.description, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, c.candidates, PermissionNameCandidates(c.name))
+		})
+	}
+}
+
+func TestPermissionNameSpecificity(t *testing.T) {
+	ranked := []string{"cluster.node.delete", "cluster.node.*", "cluster.node.**", "cluster.**", "**"}
+	for i := 1; i < len(ranked); i++ {
+		assert.Greater(t, PermissionNameSpecificity(ranked[i-1]), PermissionNameSpecificity(ranked[i]), ranked[i])
+	}
+	assert.Greater(t, PermissionNameSpecificity("cluster"), PermissionNameSpecificity("*"))
+}
+
+func TestValidatePermissionName(t *testing.T) {
+	assert.Nil(t, ValidatePermissionName("cluster.list"))
+	assert.Nil(t, ValidatePermissionName("cluster.*"))
+	assert.Nil(t, ValidatePermissionName("cluster.**"))
+	assert.ErrorIs(t, ValidatePermissionName("cluster.*.list"), ErrInvalidPermissionName)
+	assert.ErrorIs(t, ValidatePermissionName("cluster.li*"), ErrInvalidPermissionName)
+	assert.ErrorIs(t, ValidatePermissionName("cluster.***"), ErrInvalidPermissionName)
+	assert.ErrorIs(t, ValidatePermissionName("cluster\x00list"), ErrInvalidPermissionName)
+}
+
+func TestPermissionLevelSpecificity(t *testing.T) {
+	namedPermission := func(name string, kind PermissionKind, expression string) Permission {
+		condition, err := NewCondition(expression)
+		assert.Nil(t, err)
+		permission, err := NewPermission(name, kind, *condition, ConditionErrorSkip, nil)
+		assert.Nil(t, err)
+		return *permission
+	}
+	sub := []Attribute{attr("clearance", Int64, int64(3))}
+
+	testCases := []struct {
+		level       PermissionLevel
+		result      EvalResult
+		description string
+	}{
+		{
+			level: PermissionLevel{
+				namedPermission("cluster.*", PermissionKindDeny, ""),
+				namedPermission("cluster.list", PermissionKindAllow, ""),
+			},
+			result:      EvalResultAllowed,
+			description: "specific allow over a wildcard deny",
+		},
+		{
+			level: PermissionLevel{
+				namedPermission("cluster.**", PermissionKindAllow, ""),
+				namedPermission("cluster.*", PermissionKindDeny, ""),
+			},
+			result:      EvalResultDenied,
+			description: "single segment wildcard over a multi segment one",
+		},
+		{
+			level: PermissionLevel{
+				namedPermission("cluster.list", PermissionKindAllow, "sub_clearance > 5"),
+				namedPermission("**", PermissionKindDeny, ""),
+			},
+			result:      EvalResultDenied,
+			description: "wildcard applies if the specific permission doesn't",
+		},
+	}
+	for _, testCase := range testCases {
+		c := testCase
+		t.Run(c.description, func(t *testing.T) {
+			t.Parallel()
+			hierarchy := PermissionHierarchy{0: PermissionObjHierarchy{0: c.level}}
+			assert.Equal(t, c.result, hierarchy.Eval(PermissionEvalRequest{Subject: sub}).Result)
+		})
+	}
+}
+
+func TestNewPermissionInvalidName(t *testing.T) {
+	condition, err := NewCondition("")
+	assert.Nil(t, err)
+	_, err = NewPermission("cluster.*.list", PermissionKindAllow, *condition, ConditionErrorSkip, nil)
+	assert.ErrorIs(t, err, ErrInvalidPermissionName)
+}
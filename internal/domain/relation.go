@@ -0,0 +1,79 @@
+package domain
+
+import (
+	"errors"
+	"regexp"
+)
+
+// InheritsFrom is the built in relation type, every resource is related to the root through it,
+// it propagates both permissions and attributes and can't be redefined
+const InheritsFrom = "INHERITS_FROM"
+
+var (
+	ErrInvalidRelationTypeName = errors.New("relation type name must consist of upper case letters, digits and underscores")
+	ErrReservedRelationType    = errors.New("relation type name reserved")
+	ErrUnknownRelationType     = errors.New("relation type unknown")
+	ErrRelationTypeInUse       = errors.New("relation type is used by relations")
+)
+
+var relationTypeRegex = regexp.MustCompile(`^[A-Z][A-Z0-9_]*$`)
+
+// reservedRelationTypes connect resources to the other nodes of the graph
+var reservedRelationTypes = map[string]bool{
+	InheritsFrom: true,
+	"HAS":        true,
+	"ON":         true,
+	"IMPLIES":    true,
+	"DEFINES":    true,
+}
+
+// RelationType names a kind of relation between resources
+// and decides what the related resource gets from the resource it relates to
+type RelationType struct {
+	name                  string
+	propagatesPermissions bool
+	propagatesAttributes  bool
+}
+
+var InheritsFromRelationType = RelationType{
+	name:                  InheritsFrom,
+	propagatesPermissions: true,
+	propagatesAttributes:  true,
+}
+
+func NewRelationType(name string, propagatesPermissions, propagatesAttributes bool) (*RelationType, error) {
+	if err := ValidateRelationTypeName(name); err != nil {
+		return nil, err
+	}
+	if reservedRelationTypes[name] {
+		return nil, ErrReservedRelationType
+	}
+	return &RelationType{
+		name:                  name,
+		propagatesPermissions: propagatesPermissions,
+		propagatesAttributes:  propagatesAttributes,
+	}, nil
+}
+
+// ValidateRelationTypeName checks the format only, relation type names end up in cypher statements,
+// since relationship types can't be passed as parameters
+func ValidateRelationTypeName(name string) error {
+	if !relationTypeRegex.MatchString(name) {
+		return ErrInvalidRelationTypeName
+	}
+	return nil
+}
+
+func (t RelationType) Name() string {
+	return t.name
+}
+
+// PropagatesPermissions reports whether policies of a resource apply to the resources related to it
+func (t RelationType) PropagatesPermissions() bool {
+	return t.propagatesPermissions
+}
+
+// PropagatesAttributes reports whether the attributes of a resource are resolved for the resources related to it
+func (t RelationType) PropagatesAttributes() bool {
+	return t.propagatesAttributes
+}
@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRelationType(t *testing.T) {
+	testCases := []struct {
+		name        string
+		err         error
+		description string
+	}{
+		{
+			name:        "MEMBER_OF",
+			description: "valid",
+		},
+		{
+			name:        "DEPLOYED_IN_2",
+			description: "valid with digits",
+		},
+		{
+			name:        "",
+			err:         ErrInvalidRelationTypeName,
+			description: "empty",
+		},
+		{
+			name:        "member_of",
+			err:         ErrInvalidRelationTypeName,
+			description: "lower case",
+		},
+		{
+			name:        "OWNED BY",
+			err:         ErrInvalidRelationTypeName,
+			description: "space",
+		},
+		{
+			name:        "MEMBER_OF]->(r) DETACH DELETE r//",
+			err:         ErrInvalidRelationTypeName,
+			description: "cypher",
+		},
+		{
+			name:        InheritsFrom,
+			err:         ErrReservedRelationType,
+			description: "built in",
+		},
+		{
+			name:        "HAS",
+			err:         ErrReservedRelationType,
+			description: "reserved",
+		},
+	}
+
+	for _, testCase := range testCases {
+		c := testCase
+		t.Run(c.description, func(t *testing.T) {
+			t.Parallel()
+			relationType, err := NewRelationType(c.name, true, false)
+			assert.ErrorIs(t, err, c.err)
+			if c.err == nil {
+				assert.Equal(t, c.name, relationType.Name())
+				assert.True(t, relationType.PropagatesPermissions())
+				assert.False(t, relationType.PropagatesAttributes())
+			}
+		})
+	}
+}
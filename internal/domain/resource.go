@@ -10,6 +10,9 @@ var (
 	RootResource = Resource{resourceId{"", "root"}, nil}
 )
 
+// ErrInvalidResourceName is returned for names with NUL characters, repos use NUL to separate the parts of their keys
+var ErrInvalidResourceName = errors.New("resource name invalid, NUL characters aren't allowed")
+
 type resourceId struct {
 	id   string
 	kind string
@@ -21,6 +24,9 @@ type Resource struct {
 }
 
 func NewResource(id, kind string) (*Resource, error) {
+	if strings.ContainsRune(id, 0) || strings.ContainsRune(kind, 0) {
+		return nil, ErrInvalidResourceName
+	}
 	return &Resource{
 		id: resourceId{
 			id:   id,
@@ -30,6 +36,9 @@ func NewResource(id, kind string) (*Resource, error) {
 }
 
 func NewResourceFromName(name string) (*Resource, error) {
+	if strings.ContainsRune(name, 0) {
+		return nil, ErrInvalidResourceName
+	}
 	split := strings.Split(name, "/")
 	if len(split) < 2 {
 		return nil, errors.New("invalid resource name format")
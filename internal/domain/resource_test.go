@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewResourceInvalidName(t *testing.T) {
+	testCases := []struct {
+		id,
+		kind string
+		err         error
+		description string
+	}{
+		{
+			id:          "alice",
+			kind:        "user",
+			description: "valid name",
+		},
+		{
+			id:          "al\x00ice",
+			kind:        "user",
+			err:         ErrInvalidResourceName,
+			description: "NUL in the id",
+		},
+		{
+			id:          "alice",
+			kind:        "us\x00er",
+			err:         ErrInvalidResourceName,
+			description: "NUL in the kind",
+		},
+	}
+	for _, testCase := range testCases {
+		c := testCase
+		t.Run(c.description, func(t *testing.T) {
+			t.Parallel()
+			_, err := NewResource(c.id, c.kind)
+			assert.ErrorIs(t, err, c.err)
+			_, err = NewResourceFromName(c.kind + "/" + c.id)
+			assert.ErrorIs(t, err, c.err)
+		})
+	}
+}
@@ -1,6 +1,9 @@
 package domain
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 type RHABACRepo interface {
 	CreateResource(ctx context.Context, req CreateResourceReq) AdministrationResp
@@ -8,14 +11,39 @@ type RHABACRepo interface {
 	GetResource(ctx context.Context, req GetResourceReq) GetResourceResp
 	PutAttribute(ctx context.Context, req PutAttributeReq) AdministrationResp
 	DeleteAttribute(ctx context.Context, req DeleteAttributeReq) AdministrationResp
-	CreateInheritanceRel(ctx context.Context, req CreateInheritanceRelReq) AdministrationResp
-	DeleteInheritanceRel(ctx context.Context, req DeleteInheritanceRelReq) AdministrationResp
+	CreateRelation(ctx context.Context, req CreateRelationReq) AdministrationResp
+	DeleteRelation(ctx context.Context, req DeleteRelationReq) AdministrationResp
 	CreatePolicy(ctx context.Context, req CreatePolicyReq) AdministrationResp
 	DeletePolicy(ctx context.Context, req DeletePolicyReq) AdministrationResp
 	GetPermissionHierarchy(ctx context.Context, req GetPermissionHierarchyReq) GetPermissionHierarchyResp
+	GetAuthorizationData(ctx context.Context, req GetAuthorizationDataReq) GetAuthorizationDataResp
 	GetApplicablePolicies(ctx context.Context, req GetApplicablePoliciesReq) GetApplicablePoliciesResp
+	PutAttributeSchema(ctx context.Context, req PutAttributeSchemaReq) AdministrationResp
+	DeleteAttributeSchema(ctx context.Context, req DeleteAttributeSchemaReq) AdministrationResp
+	GetAttributeSchema(ctx context.Context, req GetAttributeSchemaReq) GetAttributeSchemaResp
+	SetCombiningAlgorithm(ctx context.Context, req SetCombiningAlgorithmReq) AdministrationResp
+	GetCombiningAlgorithm(ctx context.Context, req GetCombiningAlgorithmReq) GetCombiningAlgorithmResp
+	CreatePermissionImplication(ctx context.Context, req CreatePermissionImplicationReq) AdministrationResp
+	DeletePermissionImplication(ctx context.Context, req DeletePermissionImplicationReq) AdministrationResp
+	DeleteExpiredPolicies(ctx context.Context, req DeleteExpiredPoliciesReq) DeleteExpiredPoliciesResp
+	CreateBreakGlass(ctx context.Context, req CreateBreakGlassReq) AdministrationResp
+	GetActiveBreakGlass(ctx context.Context, req GetActiveBreakGlassReq) GetActiveBreakGlassResp
+	CreateBreakGlassUse(ctx context.Context, req CreateBreakGlassUseReq) AdministrationResp
+	GetBreakGlassUses(ctx context.Context, req GetBreakGlassUsesReq) GetBreakGlassUsesResp
+	CreateSoDConstraint(ctx context.Context, req CreateSoDConstraintReq) AdministrationResp
+	DeleteSoDConstraint(ctx context.Context, req DeleteSoDConstraintReq) AdministrationResp
+	GetSoDConstraints(ctx context.Context, req GetSoDConstraintsReq) GetSoDConstraintsResp
+	ExercisePermission(ctx context.Context, req ExercisePermissionReq) AdministrationResp
+	GetInheritanceCycles(ctx context.Context, req GetInheritanceCyclesReq) GetInheritanceCyclesResp
+	GetAncestorAttributes(ctx context.Context, req GetAncestorAttributesReq) GetAncestorAttributesResp
+	ResolveGraphPredicates(ctx context.Context, req ResolveGraphPredicatesReq) ResolveGraphPredicatesResp
+	PutRelationType(ctx context.Context, req PutRelationTypeReq) AdministrationResp
+	DeleteRelationType(ctx context.Context, req DeleteRelationTypeReq) AdministrationResp
+	GetRelationTypes(ctx context.Context, req GetRelationTypesReq) GetRelationTypesResp
+	Batch(ctx context.Context, req BatchReq) BatchResp
 }
 
+// CreateResourceReq creates the resource with its Attributes
 type CreateResourceReq struct {
 	Resource Resource
 }
@@ -42,20 +70,31 @@ type GetAttributeReq struct {
 	Resource Resource
 }
 
-type CreateInheritanceRelReq struct {
+// CreateRelationReq relates To to From, an INHERITS_FROM relation makes To inherit from From
+type CreateRelationReq struct {
 	From Resource
 	To   Resource
+	// Type names the relation type, the service defaults it to INHERITS_FROM
+	Type string
+	// MaxDepth bounds the longest chain of relations through the new relationship
+	MaxDepth int
+	// SoDConstraints are the static constraints the new relationship must not violate
+	SoDConstraints []SoDConstraint
 }
 
-type DeleteInheritanceRelReq struct {
+type DeleteRelationReq struct {
 	From Resource
 	To   Resource
+	Type string
 }
 
 type CreatePolicyReq struct {
 	SubjectScope,
 	ObjectScope Resource
 	Permission Permission
+	Validity   Validity
+	// SoDConstraints are the static constraints the new policy must not violate
+	SoDConstraints []SoDConstraint
 }
 
 type DeletePolicyReq struct {
@@ -64,6 +103,18 @@ type DeletePolicyReq struct {
 	Permission Permission
 }
 
+type PutAttributeSchemaReq struct {
+	Schema AttributeSchema
+}
+
+type DeleteAttributeSchemaReq struct {
+	ResourceKind string
+}
+
+type GetAttributeSchemaReq struct {
+	ResourceKind string
+}
+
 type GetPermissionHierarchyReq struct {
 	Subject,
 	Object Resource
@@ -84,20 +135,215 @@ type GetResourceResp struct {
 	Error    error
 }
 
+type SetCombiningAlgorithmReq struct {
+	PermissionName string
+	Algorithm      CombiningAlgorithm
+}
+
+type GetCombiningAlgorithmReq struct {
+	PermissionName string
+}
+
+// GetCombiningAlgorithmResp holds the DefaultCombiningAlgorithm if none was set for the permission name
+type GetCombiningAlgorithmResp struct {
+	Algorithm CombiningAlgorithm
+	Error     error
+}
+
+type CreatePermissionImplicationReq struct {
+	Implication PermissionImplication
+}
+
+type DeletePermissionImplicationReq struct {
+	Implication PermissionImplication
+}
+
+type DeleteExpiredPoliciesReq struct {
+	Now time.Time
+}
+
+type DeleteExpiredPoliciesResp struct {
+	Policies []Policy
+	Error    error
+}
+
+type CreateBreakGlassReq struct {
+	BreakGlass BreakGlass
+}
+
+type GetActiveBreakGlassReq struct {
+	Subject,
+	Object Resource
+	PermissionName string
+	Now            time.Time
+}
+
+// GetActiveBreakGlassResp holds a nil BreakGlass if no break glass is active,
+// if several are active the one expiring last is returned
+type GetActiveBreakGlassResp struct {
+	BreakGlass *BreakGlass
+	Error      error
+}
+
+type CreateBreakGlassUseReq struct {
+	Use BreakGlassUse
+}
+
+type GetBreakGlassUsesReq struct {
+	BreakGlassId string
+}
+
+// GetBreakGlassUsesResp holds the uses of the break glass ordered by the time they were made
+type GetBreakGlassUsesResp struct {
+	Uses  []BreakGlassUse
+	Error error
+}
+
+type CreateSoDConstraintReq struct {
+	Constraint SoDConstraint
+}
+
+type DeleteSoDConstraintReq struct {
+	Name string
+}
+
+type GetSoDConstraintsReq struct {
+	Kind SoDKind
+	// PermissionName limits the constraints to the ones containing it, all are returned if it is empty
+	PermissionName string
+}
+
+type GetSoDConstraintsResp struct {
+	Constraints []SoDConstraint
+	Error       error
+}
+
+// ExercisePermissionReq records that the subject exercised the permission on the object,
+// the record is rejected with a SoDViolation if the subject exercised a conflicting permission before
+type ExercisePermissionReq struct {
+	Subject,
+	Object Resource
+	PermissionName string
+	SoDConstraints []SoDConstraint
+	// DryRun only checks the constraints, the exercise isn't recorded
+	DryRun bool
+}
+
+type GetInheritanceCyclesReq struct {
+}
+
+type GetInheritanceCyclesResp struct {
+	Cycles []InheritanceCycle
+	Error  error
+}
+
+type GetAncestorAttributesReq struct {
+	Resource Resource
+}
+
+type GetAncestorAttributesResp struct {
+	Ancestors []AncestorAttributes
+	Error     error
+}
+
+type ResolveGraphPredicatesReq struct {
+	Subject,
+	Object Resource
+	Predicates []GraphPredicate
+}
+
+type ResolveGraphPredicatesResp struct {
+	Relations GraphRelations
+	Error     error
+}
+
+type PutRelationTypeReq struct {
+	RelationType RelationType
+}
+
+type DeleteRelationTypeReq struct {
+	Name string
+}
+
+type GetRelationTypesReq struct {
+}
+
+// GetRelationTypesResp holds the defined relation types, INHERITS_FROM included
+type GetRelationTypesResp struct {
+	RelationTypes []RelationType
+	Error         error
+}
+
+// GetAttributeSchemaResp holds a nil Schema if no schema is defined for the resource kind
+type GetAttributeSchemaResp struct {
+	Schema *AttributeSchema
+	Error  error
+}
+
 type GetPermissionHierarchyResp struct {
 	Hierarchy PermissionHierarchy
 	Error     error
 }
 
+// GetAuthorizationDataReq reads everything a check of the permission needs from the repo at once,
+// Now is the time the active break glass is looked up at
+type GetAuthorizationDataReq struct {
+	Subject,
+	Object Resource
+	PermissionName string
+	Now            time.Time
+}
+
+// GetAuthorizationDataResp holds an error if the subject or the object doesn't exist.
+// SoDConstraints are the dynamic constraints containing the permission,
+// BreakGlass is nil if no break glass is active, like in GetActiveBreakGlassResp
+type GetAuthorizationDataResp struct {
+	Hierarchy PermissionHierarchy
+	Algorithm CombiningAlgorithm
+	Subject,
+	Object ResourceAttributes
+	SoDConstraints []SoDConstraint
+	BreakGlass     *BreakGlass
+	Error          error
+}
+
 type AuthorizationReq struct {
 	Subject,
 	Object Resource
 	PermissionName string
 	Env            []Attribute
+	Explain        bool
+	// Exercise records the allowed permission for dynamic separation of duty constraints,
+	// other checks only verify the constraints. Explain doesn't change the decision or whether it is recorded
+	Exercise bool
 }
 
 type AuthorizationResp struct {
-	Authorized bool
+	Authorized      bool
+	ConditionErrors []ConditionError
+	Obligations     []Obligation
+	// Explanation is only set if the request asked for it
+	Explanation *Explanation
+	// BreakGlass is set if the policies denied and an active break glass allowed instead,
+	// every such use is recorded. A separation of duty violation is never overridden
+	BreakGlass *BreakGlass
+	// SoDViolation is set if the policies allowed but a dynamic separation of duty constraint denied
+	SoDViolation *SoDViolation
+	Error        error
+}
+
+type BreakGlassReq struct {
+	Subject,
+	Object Resource
+	PermissionName string
+	Justification  string
+	// Duration defaults to DefaultBreakGlassDuration if zero
+	Duration time.Duration
+	Env      []Attribute
+}
+
+type BreakGlassResp struct {
+	BreakGlass *BreakGlass
 	Error      error
 }
 
@@ -129,4 +375,5 @@ type GetGrantedPermissionsResp struct {
 type GrantedPermission struct {
 	PermissionName string
 	Object         Resource
+	Obligations    []Obligation
 }
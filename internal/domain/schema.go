@@ -0,0 +1,173 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrUnknownAttribute      = errors.New("attribute not defined in the schema")
+	ErrAttributeKindMismatch = errors.New("attribute kind doesn't match the schema")
+	ErrRequiredAttribute     = errors.New("attribute is required by the schema")
+	ErrDuplicateDefinition   = errors.New("attribute defined more than once")
+)
+
+type AttributeDefinition struct {
+	name         string
+	kind         AttributeKind
+	required     bool
+	defaultValue interface{}
+}
+
+// NewAttributeDefinition creates a definition of a schema attribute,
+// defaultValue is optional and if set, it must match the kind
+func NewAttributeDefinition(name string, kind AttributeKind, required bool, defaultValue interface{}) (*AttributeDefinition, error) {
+	if name == "" {
+		return nil, errors.New("attribute definition name is empty")
+	}
+	if defaultValue != nil && !kind.validValue(defaultValue) {
+		return nil, fmt.Errorf("%w: default value of %q", ErrAttributeValueInvalid, name)
+	}
+	return &AttributeDefinition{
+		name:         name,
+		kind:         kind,
+		required:     required,
+		defaultValue: defaultValue,
+	}, nil
+}
+
+func (d AttributeDefinition) Name() string {
+	return d.name
+}
+
+func (d AttributeDefinition) Kind() AttributeKind {
+	return d.kind
+}
+
+func (d AttributeDefinition) Required() bool {
+	return d.required
+}
+
+func (d AttributeDefinition) Default() (*Attribute, bool) {
+	if d.defaultValue == nil {
+		return nil, false
+	}
+	return &Attribute{
+		id:    AttributeId{name: d.name},
+		kind:  d.kind,
+		value: d.defaultValue,
+	}, true
+}
+
+// AttributeSchema declares the attributes that resources of a kind may have
+type AttributeSchema struct {
+	resourceKind string
+	definitions  []AttributeDefinition
+}
+
+func NewAttributeSchema(resourceKind string, definitions []AttributeDefinition) (*AttributeSchema, error) {
+	if resourceKind == "" {
+		return nil, errors.New("attribute schema resource kind is empty")
+	}
+	names := make(map[string]bool, len(definitions))
+	for _, definition := range definitions {
+		if names[definition.name] {
+			return nil, fmt.Errorf("%w: %q", ErrDuplicateDefinition, definition.name)
+		}
+		names[definition.name] = true
+	}
+	return &AttributeSchema{
+		resourceKind: resourceKind,
+		definitions:  definitions,
+	}, nil
+}
+
+func (s AttributeSchema) ResourceKind() string {
+	return s.resourceKind
+}
+
+func (s AttributeSchema) Definitions() []AttributeDefinition {
+	return s.definitions
+}
+
+func (s AttributeSchema) Definition(name string) (AttributeDefinition, bool) {
+	for _, definition := range s.definitions {
+		if definition.name == name {
+			return definition, true
+		}
+	}
+	return AttributeDefinition{}, false
+}
+
+// ValidatePut checks whether the attribute can be stored on a resource of the schema's kind
+func (s AttributeSchema) ValidatePut(attr Attribute) error {
+	definition, ok := s.Definition(attr.Name())
+	if !ok {
+		return fmt.Errorf("%w: %q is not defined for resource kind %q", ErrUnknownAttribute, attr.Name(), s.resourceKind)
+	}
+	if definition.kind != attr.Kind() {
+		return fmt.Errorf("%w: %q must be %s, got %s", ErrAttributeKindMismatch, attr.Name(), definition.kind, attr.Kind())
+	}
+	return nil
+}
+
+// ValidateCreate checks the attributes a resource of the schema's kind is created with,
+// stored are the attributes the resource already has if it exists.
+// Every required attribute without a default value has to be among the attributes or the stored ones.
+func (s AttributeSchema) ValidateCreate(attrs, stored []Attribute) error {
+	present := make(map[string]bool, len(attrs)+len(stored))
+	for _, attr := range attrs {
+		if err := s.ValidatePut(attr); err != nil {
+			return err
+		}
+		present[attr.Name()] = true
+	}
+	for _, attr := range stored {
+		present[attr.Name()] = true
+	}
+	missing := make([]string, 0)
+	for _, definition := range s.definitions {
+		if !definition.required || present[definition.name] {
+			continue
+		}
+		if _, hasDefault := definition.Default(); !hasDefault {
+			missing = append(missing, strconv.Quote(definition.name))
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("%w: %s missing for resource kind %q", ErrRequiredAttribute, strings.Join(missing, ", "), s.resourceKind)
+	}
+	return nil
+}
+
+// ValidateDelete checks whether the attribute can be removed from a resource of the schema's kind,
+// required attributes can only be removed if there is a default value to fall back to
+func (s AttributeSchema) ValidateDelete(id AttributeId) error {
+	definition, ok := s.Definition(id.Name())
+	if !ok || !definition.required {
+		return nil
+	}
+	if _, hasDefault := definition.Default(); hasDefault {
+		return nil
+	}
+	return fmt.Errorf("%w: %q can't be removed from resource kind %q", ErrRequiredAttribute, id.Name(), s.resourceKind)
+}
+
+// ApplyDefaults adds default values of the defined attributes that are missing from attrs
+func (s AttributeSchema) ApplyDefaults(attrs []Attribute) []Attribute {
+	present := make(map[string]bool, len(attrs))
+	for _, attr := range attrs {
+		present[attr.Name()] = true
+	}
+	for _, definition := range s.definitions {
+		if present[definition.name] {
+			continue
+		}
+		if attr, ok := definition.Default(); ok {
+			attrs = append(attrs, *attr)
+		}
+	}
+	return attrs
+}
@@ -0,0 +1,126 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestSchema(t *testing.T) AttributeSchema {
+	clearance, err := NewAttributeDefinition("clearance", Int64, true, nil)
+	assert.Nil(t, err)
+	tier, err := NewAttributeDefinition("tier", String, true, "basic")
+	assert.Nil(t, err)
+	tags, err := NewAttributeDefinition("tags", StringList, false, nil)
+	assert.Nil(t, err)
+	schema, err := NewAttributeSchema("user", []AttributeDefinition{*clearance, *tier, *tags})
+	assert.Nil(t, err)
+	return *schema
+}
+
+func TestAttributeSchemaValidatePut(t *testing.T) {
+	schema := newTestSchema(t)
+	testCases := []struct {
+		attribute   Attribute
+		err         error
+		description string
+	}{
+		{
+			attribute:   attr("clearance", Int64, int64(3)),
+			err:         nil,
+			description: "defined attribute with a matching kind",
+		},
+		{
+			attribute:   attr("tags", StringList, []string{"a"}),
+			err:         nil,
+			description: "optional list attribute",
+		},
+		{
+			attribute:   attr("clearence", Int64, int64(3)),
+			err:         ErrUnknownAttribute,
+			description: "misspelled attribute name",
+		},
+		{
+			attribute:   attr("clearance", String, "3"),
+			err:         ErrAttributeKindMismatch,
+			description: "attribute kind different from the definition",
+		},
+	}
+	for _, testCase := range testCases {
+		c := testCase
+		t.Run(c.description, func(t *testing.T) {
+			t.Parallel()
+			assert.ErrorIs(t, schema.ValidatePut(c.attribute), c.err)
+		})
+	}
+}
+
+func TestAttributeSchemaValidateDelete(t *testing.T) {
+	schema := newTestSchema(t)
+	assert.ErrorIs(t, schema.ValidateDelete(AttributeId{name: "clearance"}), ErrRequiredAttribute)
+	assert.Nil(t, schema.ValidateDelete(AttributeId{name: "tier"}), "required attribute with a default value")
+	assert.Nil(t, schema.ValidateDelete(AttributeId{name: "tags"}), "optional attribute")
+	assert.Nil(t, schema.ValidateDelete(AttributeId{name: "unknown"}), "undefined attribute")
+}
+
+func TestAttributeSchemaValidateCreate(t *testing.T) {
+	schema := newTestSchema(t)
+	testCases := []struct {
+		attributes  []Attribute
+		stored      []Attribute
+		err         error
+		description string
+	}{
+		{
+			attributes:  []Attribute{attr("clearance", Int64, int64(3))},
+			err:         nil,
+			description: "required attribute supplied, the other one has a default value",
+		},
+		{
+			attributes:  []Attribute{attr("tags", StringList, []string{"a"})},
+			err:         ErrRequiredAttribute,
+			description: "required attribute without a default value missing",
+		},
+		{
+			attributes:  nil,
+			stored:      []Attribute{attr("clearance", Int64, int64(3))},
+			err:         nil,
+			description: "required attribute stored on an existing resource",
+		},
+		{
+			attributes:  []Attribute{attr("clearance", String, "3")},
+			err:         ErrAttributeKindMismatch,
+			description: "supplied attribute doesn't match the schema",
+		},
+	}
+	for _, testCase := range testCases {
+		c := testCase
+		t.Run(c.description, func(t *testing.T) {
+			t.Parallel()
+			assert.ErrorIs(t, schema.ValidateCreate(c.attributes, c.stored), c.err)
+		})
+	}
+}
+
+func TestAttributeSchemaApplyDefaults(t *testing.T) {
+	schema := newTestSchema(t)
+
+	attrs := schema.ApplyDefaults([]Attribute{attr("clearance", Int64, int64(3))})
+	assert.Len(t, attrs, 2)
+	assert.Equal(t, "tier", attrs[1].Name())
+	assert.Equal(t, "basic", attrs[1].Value())
+
+	attrs = schema.ApplyDefaults([]Attribute{attr("tier", String, "gold")})
+	assert.Len(t, attrs, 1)
+	assert.Equal(t, "gold", attrs[0].Value(), "stored value takes precedence over the default")
+}
+
+func TestAttributeSchemaInvalidDefinitions(t *testing.T) {
+	_, err := NewAttributeDefinition("clearance", Int64, false, "high")
+	assert.ErrorIs(t, err, ErrAttributeValueInvalid)
+
+	first, _ := NewAttributeDefinition("clearance", Int64, false, nil)
+	second, _ := NewAttributeDefinition("clearance", String, false, nil)
+	_, err = NewAttributeSchema("user", []AttributeDefinition{*first, *second})
+	assert.ErrorIs(t, err, ErrDuplicateDefinition)
+}
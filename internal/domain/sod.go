@@ -0,0 +1,144 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// SoDKind decides when a separation of duty constraint is enforced
+type SoDKind int
+
+const (
+	// SoDStatic forbids a subject to hold more than one of the permissions on the same object,
+	// it is enforced when policies and inheritance relationships are created
+	SoDStatic SoDKind = iota
+	// SoDDynamic forbids a subject to exercise more than one of the permissions on the same object,
+	// it is enforced when the subject is authorized
+	SoDDynamic
+)
+
+var (
+	ErrSoDConstraintNameEmpty   = errors.New("separation of duty constraint name empty")
+	ErrSoDConstraintPermissions = errors.New("separation of duty constraint needs at least two distinct permissions without wildcards")
+	ErrUnknownSoDKind           = errors.New("separation of duty kind unknown")
+	ErrSoDViolation             = errors.New("separation of duty violated")
+)
+
+func (k SoDKind) Validate() error {
+	if k != SoDStatic && k != SoDDynamic {
+		return ErrUnknownSoDKind
+	}
+	return nil
+}
+
+// SoDConstraint makes its permissions mutually exclusive for any subject on any object
+type SoDConstraint struct {
+	name        string
+	kind        SoDKind
+	permissions []string
+}
+
+func NewSoDConstraint(name string, kind SoDKind, permissions []string) (*SoDConstraint, error) {
+	if name == "" {
+		return nil, ErrSoDConstraintNameEmpty
+	}
+	if err := kind.Validate(); err != nil {
+		return nil, err
+	}
+	distinct := make([]string, 0, len(permissions))
+	seen := make(map[string]bool)
+	for _, permission := range permissions {
+		if permission == "" || strings.Contains(permission, PermissionNameSingleWildcard) {
+			return nil, ErrSoDConstraintPermissions
+		}
+		if !seen[permission] {
+			seen[permission] = true
+			distinct = append(distinct, permission)
+		}
+	}
+	if len(distinct) < 2 {
+		return nil, ErrSoDConstraintPermissions
+	}
+	return &SoDConstraint{
+		name:        name,
+		kind:        kind,
+		permissions: distinct,
+	}, nil
+}
+
+func (c SoDConstraint) Name() string {
+	return c.name
+}
+
+func (c SoDConstraint) Kind() SoDKind {
+	return c.kind
+}
+
+func (c SoDConstraint) Permissions() []string {
+	return c.permissions
+}
+
+func (c SoDConstraint) Contains(permission string) bool {
+	for _, p := range c.permissions {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// ConflictingPermissions returns the permissions that may not be combined with the given one
+func (c SoDConstraint) ConflictingPermissions(permission string) []string {
+	conflicting := make([]string, 0, len(c.permissions))
+	for _, p := range c.permissions {
+		if p != permission {
+			conflicting = append(conflicting, p)
+		}
+	}
+	return conflicting
+}
+
+// GrantingNames returns for each permission of the constraint the policy names that grant it,
+// which are the permission itself and the wildcard names matching it
+func (c SoDConstraint) GrantingNames() [][]string {
+	granting := make([][]string, len(c.permissions))
+	for i, permission := range c.permissions {
+		granting[i] = PermissionNameCandidates(permission)
+	}
+	return granting
+}
+
+// Violated reports whether allow policies with the given names,
+// all held by one subject on one object, grant more than one of the permissions
+func (c SoDConstraint) Violated(held []string) bool {
+	heldNames := make(map[string]bool, len(held))
+	for _, name := range held {
+		heldNames[name] = true
+	}
+	granted := 0
+	for _, names := range c.GrantingNames() {
+		for _, name := range names {
+			if heldNames[name] {
+				granted++
+				break
+			}
+		}
+	}
+	return granted > 1
+}
+
+// SoDViolation names the constraint a mutation or an authorization would violate
+type SoDViolation struct {
+	Constraint string
+	Subject,
+	Object Resource
+}
+
+func (v SoDViolation) Error() string {
+	return fmt.Sprintf("%s: constraint %s for subject %s on object %s", ErrSoDViolation, v.Constraint, v.Subject.Name(), v.Object.Name())
+}
+
+func (v SoDViolation) Unwrap() error {
+	return ErrSoDViolation
+}
@@ -0,0 +1,114 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSoDConstraint(t *testing.T) {
+	testCases := []struct {
+		name        string
+		kind        SoDKind
+		permissions []string
+		err         error
+		description string
+	}{
+		{
+			name:        "payments",
+			kind:        SoDStatic,
+			permissions: []string{"payment.create", "payment.approve"},
+			description: "valid",
+		},
+		{
+			kind:        SoDStatic,
+			permissions: []string{"payment.create", "payment.approve"},
+			err:         ErrSoDConstraintNameEmpty,
+			description: "empty name",
+		},
+		{
+			name:        "payments",
+			kind:        SoDKind(5),
+			permissions: []string{"payment.create", "payment.approve"},
+			err:         ErrUnknownSoDKind,
+			description: "unknown kind",
+		},
+		{
+			name:        "payments",
+			kind:        SoDDynamic,
+			permissions: []string{"payment.create", "payment.create"},
+			err:         ErrSoDConstraintPermissions,
+			description: "duplicate permissions",
+		},
+		{
+			name:        "payments",
+			kind:        SoDDynamic,
+			permissions: []string{"payment.create", "payment.*"},
+			err:         ErrSoDConstraintPermissions,
+			description: "wildcard permission",
+		},
+	}
+
+	for _, testCase := range testCases {
+		c := testCase
+		t.Run(c.description, func(t *testing.T) {
+			t.Parallel()
+			_, err := NewSoDConstraint(c.name, c.kind, c.permissions)
+			assert.ErrorIs(t, err, c.err)
+		})
+	}
+}
+
+func TestSoDConstraintViolated(t *testing.T) {
+	constraint, err := NewSoDConstraint("payments", SoDStatic, []string{"payment.create", "payment.approve"})
+	assert.Nil(t, err)
+
+	testCases := []struct {
+		held        []string
+		violated    bool
+		description string
+	}{
+		{
+			held:        []string{"payment.create", "payment.read"},
+			violated:    false,
+			description: "one permission",
+		},
+		{
+			held:        []string{"payment.create", "payment.approve"},
+			violated:    true,
+			description: "both permissions",
+		},
+		{
+			held:        []string{"payment.*"},
+			violated:    true,
+			description: "wildcard grants both",
+		},
+		{
+			held:        []string{"payment.create", "**"},
+			violated:    true,
+			description: "direct and wildcard",
+		},
+		{
+			held:        []string{"payment.create.*"},
+			violated:    false,
+			description: "wildcard matching neither",
+		},
+	}
+
+	for _, testCase := range testCases {
+		c := testCase
+		t.Run(c.description, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, c.violated, constraint.Violated(c.held))
+		})
+	}
+}
+
+func TestSoDViolationError(t *testing.T) {
+	subject, err := NewResource("alice", "user")
+	assert.Nil(t, err)
+	var violationErr error = SoDViolation{Constraint: "payments", Subject: *subject, Object: RootResource}
+	assert.True(t, errors.Is(violationErr, ErrSoDViolation))
+	assert.Contains(t, violationErr.Error(), "payments")
+}
@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+var ErrInvalidValidity = errors.New("policy validity must end after it begins")
+
+// Validity bounds the time window in which a policy is active,
+// a zero bound leaves the window open in that direction
+type Validity struct {
+	NotBefore time.Time
+	NotAfter  time.Time
+}
+
+func (v Validity) IsBounded() bool {
+	return !v.NotBefore.IsZero() || !v.NotAfter.IsZero()
+}
+
+func (v Validity) Validate() error {
+	if !v.NotBefore.IsZero() && !v.NotAfter.IsZero() && !v.NotAfter.After(v.NotBefore) {
+		return ErrInvalidValidity
+	}
+	return nil
+}
+
+// Active reports whether the policy applies at the given time,
+// NotBefore is inclusive and NotAfter exclusive
+func (v Validity) Active(at time.Time) bool {
+	if !v.NotBefore.IsZero() && at.Before(v.NotBefore) {
+		return false
+	}
+	return !v.Expired(at)
+}
+
+// Expired reports whether the policy will never apply again after the given time
+func (v Validity) Expired(at time.Time) bool {
+	return !v.NotAfter.IsZero() && !at.Before(v.NotAfter)
+}
@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidity(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(24 * time.Hour)
+
+	testCases := []struct {
+		validity    Validity
+		at          time.Time
+		err         error
+		active      bool
+		expired     bool
+		description string
+	}{
+		{
+			validity:    Validity{},
+			at:          start,
+			active:      true,
+			description: "unbounded",
+		},
+		{
+			validity:    Validity{NotBefore: start, NotAfter: end},
+			at:          start,
+			active:      true,
+			description: "not before is inclusive",
+		},
+		{
+			validity:    Validity{NotBefore: start, NotAfter: end},
+			at:          start.Add(-time.Second),
+			description: "not yet active",
+		},
+		{
+			validity:    Validity{NotBefore: start, NotAfter: end},
+			at:          end,
+			expired:     true,
+			description: "not after is exclusive",
+		},
+		{
+			validity:    Validity{NotAfter: end},
+			at:          end.Add(-time.Second),
+			active:      true,
+			description: "only upper bound",
+		},
+		{
+			validity:    Validity{NotBefore: end, NotAfter: start},
+			at:          start,
+			err:         ErrInvalidValidity,
+			expired:     true,
+			description: "ends before it begins",
+		},
+		{
+			validity:    Validity{NotBefore: start, NotAfter: start},
+			at:          start,
+			err:         ErrInvalidValidity,
+			expired:     true,
+			description: "empty window",
+		},
+	}
+
+	for _, testCase := range testCases {
+		c := testCase
+		t.Run(c.description, func(t *testing.T) {
+			t.Parallel()
+			assert.ErrorIs(t, c.validity.Validate(), c.err)
+			assert.Equal(t, c.active, c.validity.Active(c.at))
+			assert.Equal(t, c.expired, c.validity.Expired(c.at))
+		})
+	}
+}
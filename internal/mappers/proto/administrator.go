@@ -1,6 +1,8 @@
 package proto
 
 import (
+	"fmt"
+
 	"github.com/c12s/oort/internal/domain"
 	"github.com/c12s/oort/pkg/api"
 )
@@ -10,6 +12,13 @@ func CreateResourceReqToDomain(req *api.CreateResourceReq) (*domain.CreateResour
 	if err != nil {
 		return nil, err
 	}
+	for _, attr := range req.Attributes {
+		domainAttr, err := AttributeToDomain(attr)
+		if err != nil {
+			return nil, err
+		}
+		resource.Attributes = append(resource.Attributes, *domainAttr)
+	}
 	return &domain.CreateResourceReq{
 		Resource: *resource,
 	}, nil
@@ -55,7 +64,7 @@ func DeleteAttributeReqToDomain(req *api.DeleteAttributeReq) (*domain.DeleteAttr
 	}, nil
 }
 
-func CreateInheritanceRelReqToDomain(req *api.CreateInheritanceRelReq) (*domain.CreateInheritanceRelReq, error) {
+func CreateInheritanceRelReqToDomain(req *api.CreateInheritanceRelReq) (*domain.CreateRelationReq, error) {
 	from, err := ResourceToDomain(req.From)
 	if err != nil {
 		return nil, err
@@ -64,13 +73,14 @@ func CreateInheritanceRelReqToDomain(req *api.CreateInheritanceRelReq) (*domain.
 	if err != nil {
 		return nil, err
 	}
-	return &domain.CreateInheritanceRelReq{
+	return &domain.CreateRelationReq{
 		From: *from,
 		To:   *to,
+		Type: req.RelationType,
 	}, nil
 }
 
-func DeleteInheritanceRelReqToDomain(req *api.DeleteInheritanceRelReq) (*domain.DeleteInheritanceRelReq, error) {
+func DeleteInheritanceRelReqToDomain(req *api.DeleteInheritanceRelReq) (*domain.DeleteRelationReq, error) {
 	from, err := ResourceToDomain(req.From)
 	if err != nil {
 		return nil, err
@@ -79,9 +89,10 @@ func DeleteInheritanceRelReqToDomain(req *api.DeleteInheritanceRelReq) (*domain.
 	if err != nil {
 		return nil, err
 	}
-	return &domain.DeleteInheritanceRelReq{
+	return &domain.DeleteRelationReq{
 		From: *from,
 		To:   *to,
+		Type: req.RelationType,
 	}, nil
 }
 
@@ -98,10 +109,19 @@ func CreatePolicyReqToDomain(req *api.CreatePolicyReq) (*domain.CreatePolicyReq,
 	if err != nil {
 		return nil, err
 	}
+	validity := domain.Validity{}
+	// unset timestamps would otherwise be mapped to the unix epoch
+	if req.NotBefore != nil {
+		validity.NotBefore = req.NotBefore.AsTime()
+	}
+	if req.NotAfter != nil {
+		validity.NotAfter = req.NotAfter.AsTime()
+	}
 	return &domain.CreatePolicyReq{
 		SubjectScope: *subScope,
 		ObjectScope:  *objScope,
 		Permission:   *permission,
+		Validity:     validity,
 	}, nil
 }
 
@@ -125,6 +145,208 @@ func DeletePolicyReqToDomain(req *api.DeletePolicyReq) (*domain.DeletePolicyReq,
 	}, nil
 }
 
+func PutAttributeSchemaReqToDomain(req *api.PutAttributeSchemaReq) (*domain.PutAttributeSchemaReq, error) {
+	schema, err := AttributeSchemaToDomain(req.Schema)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.PutAttributeSchemaReq{
+		Schema: *schema,
+	}, nil
+}
+
+func DeleteAttributeSchemaReqToDomain(req *api.DeleteAttributeSchemaReq) (*domain.DeleteAttributeSchemaReq, error) {
+	return &domain.DeleteAttributeSchemaReq{
+		ResourceKind: req.ResourceKind,
+	}, nil
+}
+
+func GetAttributeSchemaReqToDomain(req *api.GetAttributeSchemaReq) (*domain.GetAttributeSchemaReq, error) {
+	return &domain.GetAttributeSchemaReq{
+		ResourceKind: req.ResourceKind,
+	}, nil
+}
+
+func SetCombiningAlgorithmReqToDomain(req *api.SetCombiningAlgorithmReq) (*domain.SetCombiningAlgorithmReq, error) {
+	return &domain.SetCombiningAlgorithmReq{
+		PermissionName: req.PermissionName,
+		Algorithm:      domain.CombiningAlgorithm(req.Algorithm),
+	}, nil
+}
+
+func CreatePermissionImplicationReqToDomain(req *api.CreatePermissionImplicationReq) (*domain.CreatePermissionImplicationReq, error) {
+	implication, err := domain.NewPermissionImplication(req.PermissionName, req.ImpliedPermissionName)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.CreatePermissionImplicationReq{
+		Implication: *implication,
+	}, nil
+}
+
+func DeletePermissionImplicationReqToDomain(req *api.DeletePermissionImplicationReq) (*domain.DeletePermissionImplicationReq, error) {
+	implication, err := domain.NewPermissionImplication(req.PermissionName, req.ImpliedPermissionName)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.DeletePermissionImplicationReq{
+		Implication: *implication,
+	}, nil
+}
+
+func CreateSoDConstraintReqToDomain(req *api.CreateSoDConstraintReq) (*domain.CreateSoDConstraintReq, error) {
+	constraint, err := SoDConstraintToDomain(req.Constraint)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.CreateSoDConstraintReq{
+		Constraint: *constraint,
+	}, nil
+}
+
+func DeleteSoDConstraintReqToDomain(req *api.DeleteSoDConstraintReq) (*domain.DeleteSoDConstraintReq, error) {
+	return &domain.DeleteSoDConstraintReq{
+		Name: req.Name,
+	}, nil
+}
+
+func GetInheritanceCyclesReqToDomain(req *api.GetInheritanceCyclesReq) (*domain.GetInheritanceCyclesReq, error) {
+	return &domain.GetInheritanceCyclesReq{}, nil
+}
+
+func GetInheritanceCyclesRespFromDomain(resp *domain.GetInheritanceCyclesResp) (*api.GetInheritanceCyclesResp, error) {
+	cycles := make([]*api.InheritanceCycle, len(resp.Cycles))
+	for i, cycle := range resp.Cycles {
+		resources := make([]*api.Resource, len(cycle.Resources))
+		for j := range cycle.Resources {
+			resource, err := ResourceFromDomain(&cycle.Resources[j])
+			if err != nil {
+				return nil, err
+			}
+			resources[j] = resource
+		}
+		cycles[i] = &api.InheritanceCycle{Resources: resources}
+	}
+	return &api.GetInheritanceCyclesResp{
+		Cycles: cycles,
+	}, nil
+}
+
+func PutRelationTypeReqToDomain(req *api.PutRelationTypeReq) (*domain.PutRelationTypeReq, error) {
+	relationType, err := RelationTypeToDomain(req.RelationType)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.PutRelationTypeReq{
+		RelationType: *relationType,
+	}, nil
+}
+
+func DeleteRelationTypeReqToDomain(req *api.DeleteRelationTypeReq) (*domain.DeleteRelationTypeReq, error) {
+	return &domain.DeleteRelationTypeReq{
+		Name: req.Name,
+	}, nil
+}
+
+func GetRelationTypesReqToDomain(req *api.GetRelationTypesReq) (*domain.GetRelationTypesReq, error) {
+	return &domain.GetRelationTypesReq{}, nil
+}
+
+func GetRelationTypesRespFromDomain(resp *domain.GetRelationTypesResp) (*api.GetRelationTypesResp, error) {
+	relationTypes := make([]*api.RelationType, len(resp.RelationTypes))
+	for i, relationType := range resp.RelationTypes {
+		relationTypes[i] = RelationTypeFromDomain(relationType)
+	}
+	return &api.GetRelationTypesResp{
+		RelationTypes: relationTypes,
+	}, nil
+}
+
+func GetAttributeSchemaRespFromDomain(resp *domain.GetAttributeSchemaResp) (*api.GetAttributeSchemaResp, error) {
+	schema, err := AttributeSchemaFromDomain(resp.Schema)
+	if err != nil {
+		return nil, err
+	}
+	return &api.GetAttributeSchemaResp{
+		Schema: schema,
+	}, nil
+}
+
+func BatchReqToDomain(req *api.BatchReq) (*domain.BatchReq, error) {
+	ops := make([]domain.BatchOp, len(req.Ops))
+	for i, op := range req.Ops {
+		domainOp, err := BatchOpToDomain(op)
+		if err != nil {
+			return nil, fmt.Errorf("batch operation %d: %w", i, err)
+		}
+		ops[i] = domainOp
+	}
+	return &domain.BatchReq{
+		Ops: ops,
+	}, nil
+}
+
+func BatchOpToDomain(op *api.BatchOp) (domain.BatchOp, error) {
+	switch req := op.GetOp().(type) {
+	case *api.BatchOp_CreateResource:
+		return batchOp(CreateResourceReqToDomain(req.CreateResource))
+	case *api.BatchOp_DeleteResource:
+		return batchOp(DeleteResourceReqToDomain(req.DeleteResource))
+	case *api.BatchOp_PutAttribute:
+		return batchOp(PutAttributeReqToDomain(req.PutAttribute))
+	case *api.BatchOp_DeleteAttribute:
+		return batchOp(DeleteAttributeReqToDomain(req.DeleteAttribute))
+	case *api.BatchOp_CreateInheritanceRel:
+		return batchOp(CreateInheritanceRelReqToDomain(req.CreateInheritanceRel))
+	case *api.BatchOp_DeleteInheritanceRel:
+		return batchOp(DeleteInheritanceRelReqToDomain(req.DeleteInheritanceRel))
+	case *api.BatchOp_CreatePolicy:
+		return batchOp(CreatePolicyReqToDomain(req.CreatePolicy))
+	case *api.BatchOp_DeletePolicy:
+		return batchOp(DeletePolicyReqToDomain(req.DeletePolicy))
+	case *api.BatchOp_PutAttributeSchema:
+		return batchOp(PutAttributeSchemaReqToDomain(req.PutAttributeSchema))
+	case *api.BatchOp_DeleteAttributeSchema:
+		return batchOp(DeleteAttributeSchemaReqToDomain(req.DeleteAttributeSchema))
+	case *api.BatchOp_SetCombiningAlgorithm:
+		return batchOp(SetCombiningAlgorithmReqToDomain(req.SetCombiningAlgorithm))
+	case *api.BatchOp_CreatePermissionImplication:
+		return batchOp(CreatePermissionImplicationReqToDomain(req.CreatePermissionImplication))
+	case *api.BatchOp_DeletePermissionImplication:
+		return batchOp(DeletePermissionImplicationReqToDomain(req.DeletePermissionImplication))
+	case *api.BatchOp_CreateSoDConstraint:
+		return batchOp(CreateSoDConstraintReqToDomain(req.CreateSoDConstraint))
+	case *api.BatchOp_DeleteSoDConstraint:
+		return batchOp(DeleteSoDConstraintReqToDomain(req.DeleteSoDConstraint))
+	case *api.BatchOp_PutRelationType:
+		return batchOp(PutRelationTypeReqToDomain(req.PutRelationType))
+	case *api.BatchOp_DeleteRelationType:
+		return batchOp(DeleteRelationTypeReqToDomain(req.DeleteRelationType))
+	default:
+		return nil, domain.ErrUnknownBatchOp
+	}
+}
+
+// batchOp dereferences a mapped request, so that the op holds the request like the service methods take it
+func batchOp[T domain.BatchOp](req *T, err error) (domain.BatchOp, error) {
+	if err != nil {
+		return nil, err
+	}
+	return *req, nil
+}
+
+// BatchOpResultsFromDomain maps the errors of the results, the caller sets their codes
+func BatchOpResultsFromDomain(results []domain.AdministrationResp) []*api.BatchOpResult {
+	mapped := make([]*api.BatchOpResult, len(results))
+	for i, result := range results {
+		mapped[i] = &api.BatchOpResult{}
+		if result.Error != nil {
+			mapped[i].Error = result.Error.Error()
+		}
+	}
+	return mapped
+}
+
 func AdministrationAsyncRespFromDomain(resp domain.AdministrationResp) (*api.AdministrationAsyncResp, error) {
 	err := ""
 	if resp.Error != nil {
@@ -134,3 +356,25 @@ func AdministrationAsyncRespFromDomain(resp domain.AdministrationResp) (*api.Adm
 		Error: err,
 	}, nil
 }
+
+func PoliciesExpiredNotificationFromDomain(policies []domain.Policy) (*api.PoliciesExpiredNotification, error) {
+	expired := make([]*api.ExpiredPolicy, 0, len(policies))
+	for _, policy := range policies {
+		subScope, err := ResourceFromDomain(&policy.Subject)
+		if err != nil {
+			return nil, err
+		}
+		objScope, err := ResourceFromDomain(&policy.Object)
+		if err != nil {
+			return nil, err
+		}
+		expired = append(expired, &api.ExpiredPolicy{
+			PermissionName: policy.PermissionName,
+			SubjectScope:   subScope,
+			ObjectScope:    objScope,
+		})
+	}
+	return &api.PoliciesExpiredNotification{
+		Policies: expired,
+	}, nil
+}
@@ -2,9 +2,11 @@ package proto
 
 import (
 	"log"
+	"time"
 
 	"github.com/c12s/oort/internal/domain"
 	"github.com/c12s/oort/pkg/api"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func AuthorizationReqToDomain(req *api.AuthorizationReq) (*domain.AuthorizationReq, error) {
@@ -30,9 +32,70 @@ func AuthorizationReqToDomain(req *api.AuthorizationReq) (*domain.AuthorizationR
 		Object:         *obj,
 		PermissionName: req.PermissionName,
 		Env:            envAttributes,
+		Explain:        req.Explain,
+		Exercise:       req.Exercise,
 	}, nil
 }
 
+func AuthorizationRespFromDomain(resp *domain.AuthorizationResp) (*api.AuthorizationResp, error) {
+	condErrs := make([]*api.ConditionError, len(resp.ConditionErrors))
+	for i, condErr := range resp.ConditionErrors {
+		condErrs[i] = ConditionErrorFromDomain(condErr)
+	}
+	var explanation *api.Explanation
+	if resp.Explanation != nil {
+		explanation = ExplanationFromDomain(resp.Explanation)
+	}
+	var breakGlass *api.BreakGlass
+	if resp.BreakGlass != nil {
+		var err error
+		breakGlass, err = BreakGlassFromDomain(*resp.BreakGlass)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var sodViolation *api.SoDViolation
+	if resp.SoDViolation != nil {
+		var err error
+		sodViolation, err = SoDViolationFromDomain(*resp.SoDViolation)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &api.AuthorizationResp{
+		Authorized:      resp.Authorized,
+		ConditionErrors: condErrs,
+		Explanation:     explanation,
+		Obligations:     ObligationsFromDomain(resp.Obligations),
+		BreakGlass:      breakGlass,
+		SodViolation:    sodViolation,
+	}, nil
+}
+
+func ExplanationFromDomain(explanation *domain.Explanation) *api.Explanation {
+	nonApplicable := make([]*api.EvaluatedPermission, len(explanation.NonApplicable))
+	for i, perm := range explanation.NonApplicable {
+		nonApplicable[i] = EvaluatedPermissionFromDomain(perm)
+	}
+	var deciding *api.EvaluatedPermission
+	if explanation.Deciding != nil {
+		deciding = EvaluatedPermissionFromDomain(*explanation.Deciding)
+	}
+	return &api.Explanation{
+		DecidingPermission: deciding,
+		DefaultResult:      explanation.DefaultResult(),
+		NonApplicable:      nonApplicable,
+	}
+}
+
+func EvaluatedPermissionFromDomain(perm domain.EvaluatedPermission) *api.EvaluatedPermission {
+	return &api.EvaluatedPermission{
+		Permission:      PermissionFromDomain(perm.Permission),
+		SubjectPriority: int64(perm.SubjectPriority),
+		ObjectPriority:  int64(perm.ObjectPriority),
+	}
+}
+
 func GetGrantedPermissionsReqToDomain(req *api.GetGrantedPermissionsReq) (*domain.GetGrantedPermissionsReq, error) {
 	envAttributes := make([]domain.Attribute, len(req.EnvAttributes))
 	for i, attr := range req.EnvAttributes {
@@ -67,3 +130,56 @@ func GetGrantedPermissionsRespFromDomain(resp *domain.GetGrantedPermissionsResp)
 		Permissions: perms,
 	}, nil
 }
+
+func BreakGlassReqToDomain(req *api.BreakGlassReq) (*domain.BreakGlassReq, error) {
+	envAttributes := make([]domain.Attribute, 0, len(req.EnvAttributes))
+	for _, attr := range req.EnvAttributes {
+		domainAttr, err := AttributeToDomain(attr)
+		if err != nil {
+			return nil, err
+		}
+		envAttributes = append(envAttributes, *domainAttr)
+	}
+	sub, err := ResourceToDomain(req.Subject)
+	if err != nil {
+		return nil, err
+	}
+	obj, err := ResourceToDomain(req.Object)
+	if err != nil {
+		return nil, err
+	}
+	var duration time.Duration
+	if req.Duration != nil {
+		duration = req.Duration.AsDuration()
+	}
+	return &domain.BreakGlassReq{
+		Subject:        *sub,
+		Object:         *obj,
+		PermissionName: req.PermissionName,
+		Justification:  req.Justification,
+		Duration:       duration,
+		Env:            envAttributes,
+	}, nil
+}
+
+func BreakGlassFromDomain(breakGlass domain.BreakGlass) (*api.BreakGlass, error) {
+	sub := breakGlass.Subject()
+	subject, err := ResourceFromDomain(&sub)
+	if err != nil {
+		return nil, err
+	}
+	obj := breakGlass.Object()
+	object, err := ResourceFromDomain(&obj)
+	if err != nil {
+		return nil, err
+	}
+	return &api.BreakGlass{
+		Id:             breakGlass.Id(),
+		Subject:        subject,
+		Object:         object,
+		PermissionName: breakGlass.PermissionName(),
+		Justification:  breakGlass.Justification(),
+		GrantedAt:      timestamppb.New(breakGlass.GrantedAt()),
+		ExpiresAt:      timestamppb.New(breakGlass.ExpiresAt()),
+	}, nil
+}
@@ -2,10 +2,13 @@ package proto
 
 import (
 	"errors"
+	"time"
 
 	"github.com/c12s/oort/internal/domain"
 	"github.com/c12s/oort/pkg/api"
 	"github.com/golang/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func AttributeIdToDomain(id *api.AttributeId) (*domain.AttributeId, error) {
@@ -21,7 +24,12 @@ func AttributeToDomain(attr *api.Attribute) (*domain.Attribute, error) {
 	if err != nil {
 		return nil, err
 	}
-	return domain.NewAttribute(*id, domain.AttributeKind(attr.Kind), value)
+	attribute, err := domain.NewAttribute(*id, domain.AttributeKind(attr.Kind), value)
+	if err != nil {
+		return nil, err
+	}
+	attribute.SetInheritable(attr.Inheritable)
+	return attribute, nil
 }
 
 func AttributeValueToDomain(attr *api.Attribute) (interface{}, error) {
@@ -54,11 +62,187 @@ func AttributeValueToDomain(attr *api.Attribute) (interface{}, error) {
 			return nil, err
 		}
 		return value.Value, nil
+	case api.Attribute_STRING_LIST:
+		var value api.StringListAttribute
+		err := proto.Unmarshal(attr.Value, &value)
+		if err != nil {
+			return nil, err
+		}
+		if value.Value == nil {
+			return []string{}, nil
+		}
+		return value.Value, nil
+	case api.Attribute_INT64_LIST:
+		var value api.Int64ListAttribute
+		err := proto.Unmarshal(attr.Value, &value)
+		if err != nil {
+			return nil, err
+		}
+		if value.Value == nil {
+			return []int64{}, nil
+		}
+		return value.Value, nil
+	case api.Attribute_TIMESTAMP:
+		var value api.TimestampAttribute
+		err := proto.Unmarshal(attr.Value, &value)
+		if err != nil {
+			return nil, err
+		}
+		if err := value.Value.CheckValid(); err != nil {
+			return nil, err
+		}
+		return value.Value.AsTime(), nil
+	case api.Attribute_DURATION:
+		var value api.DurationAttribute
+		err := proto.Unmarshal(attr.Value, &value)
+		if err != nil {
+			return nil, err
+		}
+		if err := value.Value.CheckValid(); err != nil {
+			return nil, err
+		}
+		return value.Value.AsDuration(), nil
 	default:
 		return nil, errors.New("unknown kind")
 	}
 }
 
+func AttributeIdFromDomain(id domain.AttributeId) (*api.AttributeId, error) {
+	return &api.AttributeId{
+		Name: id.Name(),
+	}, nil
+}
+
+func AttributeFromDomain(attr domain.Attribute) (*api.Attribute, error) {
+	value, err := AttributeValueFromDomain(attr)
+	if err != nil {
+		return nil, err
+	}
+	return &api.Attribute{
+		Id:          &api.AttributeId{Name: attr.Name()},
+		Kind:        api.Attribute_AttributeKind(attr.Kind()),
+		Value:       value,
+		Inheritable: attr.Inheritable(),
+	}, nil
+}
+
+func AttributeValueFromDomain(attr domain.Attribute) ([]byte, error) {
+	switch attr.Kind() {
+	case domain.Int64:
+		value, ok := attr.Value().(int64)
+		if !ok {
+			return nil, errors.New("invalid int64 attribute value")
+		}
+		return proto.Marshal(&api.Int64Attribute{Value: value})
+	case domain.Float64:
+		value, ok := attr.Value().(float64)
+		if !ok {
+			return nil, errors.New("invalid float64 attribute value")
+		}
+		return proto.Marshal(&api.Float64Attribute{Value: value})
+	case domain.String:
+		value, ok := attr.Value().(string)
+		if !ok {
+			return nil, errors.New("invalid string attribute value")
+		}
+		return proto.Marshal(&api.StringAttribute{Value: value})
+	case domain.Bool:
+		value, ok := attr.Value().(bool)
+		if !ok {
+			return nil, errors.New("invalid bool attribute value")
+		}
+		return proto.Marshal(&api.BoolAttribute{Value: value})
+	case domain.StringList:
+		value, ok := attr.Value().([]string)
+		if !ok {
+			return nil, errors.New("invalid string list attribute value")
+		}
+		return proto.Marshal(&api.StringListAttribute{Value: value})
+	case domain.Int64List:
+		value, ok := attr.Value().([]int64)
+		if !ok {
+			return nil, errors.New("invalid int64 list attribute value")
+		}
+		return proto.Marshal(&api.Int64ListAttribute{Value: value})
+	case domain.Timestamp:
+		value, ok := attr.Value().(time.Time)
+		if !ok {
+			return nil, errors.New("invalid timestamp attribute value")
+		}
+		return proto.Marshal(&api.TimestampAttribute{Value: timestamppb.New(value)})
+	case domain.Duration:
+		value, ok := attr.Value().(time.Duration)
+		if !ok {
+			return nil, errors.New("invalid duration attribute value")
+		}
+		return proto.Marshal(&api.DurationAttribute{Value: durationpb.New(value)})
+	default:
+		return nil, errors.New("unknown kind")
+	}
+}
+
+func AttributeDefinitionToDomain(def *api.AttributeDefinition) (*domain.AttributeDefinition, error) {
+	var defaultValue interface{}
+	if def.Default != nil {
+		if def.Default.Kind != def.Kind {
+			return nil, errors.New("attribute definition default kind doesn't match the definition kind")
+		}
+		value, err := AttributeValueToDomain(def.Default)
+		if err != nil {
+			return nil, err
+		}
+		defaultValue = value
+	}
+	return domain.NewAttributeDefinition(def.Name, domain.AttributeKind(def.Kind), def.Required, defaultValue)
+}
+
+func AttributeDefinitionFromDomain(def domain.AttributeDefinition) (*api.AttributeDefinition, error) {
+	var defaultValue *api.Attribute
+	if attr, ok := def.Default(); ok {
+		value, err := AttributeFromDomain(*attr)
+		if err != nil {
+			return nil, err
+		}
+		defaultValue = value
+	}
+	return &api.AttributeDefinition{
+		Name:     def.Name(),
+		Kind:     api.Attribute_AttributeKind(def.Kind()),
+		Required: def.Required(),
+		Default:  defaultValue,
+	}, nil
+}
+
+func AttributeSchemaToDomain(schema *api.AttributeSchema) (*domain.AttributeSchema, error) {
+	if schema == nil {
+		return nil, errors.New("attribute schema is nil")
+	}
+	definitions := make([]domain.AttributeDefinition, 0, len(schema.Attributes))
+	for _, def := range schema.Attributes {
+		definition, err := AttributeDefinitionToDomain(def)
+		if err != nil {
+			return nil, err
+		}
+		definitions = append(definitions, *definition)
+	}
+	return domain.NewAttributeSchema(schema.ResourceKind, definitions)
+}
+
+func AttributeSchemaFromDomain(schema *domain.AttributeSchema) (*api.AttributeSchema, error) {
+	definitions := make([]*api.AttributeDefinition, 0, len(schema.Definitions()))
+	for _, def := range schema.Definitions() {
+		definition, err := AttributeDefinitionFromDomain(def)
+		if err != nil {
+			return nil, err
+		}
+		definitions = append(definitions, definition)
+	}
+	return &api.AttributeSchema{
+		ResourceKind: schema.ResourceKind(),
+		Attributes:   definitions,
+	}, nil
+}
+
 func ResourceToDomain(res *api.Resource) (*domain.Resource, error) {
 	return domain.NewResource(res.Id, res.Kind)
 }
@@ -71,13 +255,60 @@ func ResourceFromDomain(res *domain.Resource) (*api.Resource, error) {
 }
 
 func PermissionToDomain(perm *api.Permission) (*domain.Permission, error) {
-	condition, err := domain.NewCondition(perm.Condition.Expression)
+	condition, err := domain.NewConditionInLanguage(perm.Condition.Expression, domain.ConditionLanguage(perm.Condition.Language))
 	if err != nil {
 		return nil, err
 	}
+	obligations := make([]domain.Obligation, len(perm.Obligations))
+	for i, obligation := range perm.Obligations {
+		domainObligation, err := ObligationToDomain(obligation)
+		if err != nil {
+			return nil, err
+		}
+		obligations[i] = *domainObligation
+	}
 	return domain.NewPermission(perm.Name,
 		domain.PermissionKind(perm.Kind),
-		*condition)
+		*condition,
+		domain.ConditionErrorPolicy(perm.OnConditionError),
+		obligations)
+}
+
+func PermissionFromDomain(perm domain.Permission) *api.Permission {
+	return &api.Permission{
+		Name: perm.Name(),
+		Kind: api.Permission_PermissionKind(perm.Kind()),
+		Condition: &api.Condition{
+			Expression: perm.Condition().Expression(),
+			Language:   api.Condition_ConditionLanguage(perm.Condition().Language()),
+		},
+		OnConditionError: api.Permission_ConditionErrorPolicy(perm.OnConditionError()),
+		Obligations:      ObligationsFromDomain(perm.Obligations()),
+	}
+}
+
+func ObligationToDomain(obligation *api.Obligation) (*domain.Obligation, error) {
+	return domain.NewObligation(obligation.Name, obligation.Params, obligation.Advice)
+}
+
+func ObligationsFromDomain(obligations []domain.Obligation) []*api.Obligation {
+	protoObligations := make([]*api.Obligation, len(obligations))
+	for i, obligation := range obligations {
+		protoObligations[i] = &api.Obligation{
+			Name:   obligation.Name(),
+			Params: obligation.Params(),
+			Advice: obligation.Advice(),
+		}
+	}
+	return protoObligations
+}
+
+func ConditionErrorFromDomain(condErr domain.ConditionError) *api.ConditionError {
+	return &api.ConditionError{
+		PermissionName:   condErr.Permission,
+		MissingAttribute: condErr.MissingAttribute,
+		Message:          condErr.Err.Error(),
+	}
 }
 
 func GrantedPermissionFromDomain(perm *domain.GrantedPermission) (*api.GrantedPermission, error) {
@@ -86,7 +317,43 @@ func GrantedPermissionFromDomain(perm *domain.GrantedPermission) (*api.GrantedPe
 		return nil, err
 	}
 	return &api.GrantedPermission{
-		Name:   perm.PermissionName,
-		Object: object,
+		Name:        perm.PermissionName,
+		Object:      object,
+		Obligations: ObligationsFromDomain(perm.Obligations),
 	}, nil
 }
+
+func SoDConstraintToDomain(constraint *api.SoDConstraint) (*domain.SoDConstraint, error) {
+	return domain.NewSoDConstraint(constraint.Name, domain.SoDKind(constraint.Kind), constraint.Permissions)
+}
+
+func SoDViolationFromDomain(violation domain.SoDViolation) (*api.SoDViolation, error) {
+	subject, err := ResourceFromDomain(&violation.Subject)
+	if err != nil {
+		return nil, err
+	}
+	object, err := ResourceFromDomain(&violation.Object)
+	if err != nil {
+		return nil, err
+	}
+	return &api.SoDViolation{
+		Constraint: violation.Constraint,
+		Subject:    subject,
+		Object:     object,
+	}, nil
+}
+
+func RelationTypeToDomain(relationType *api.RelationType) (*domain.RelationType, error) {
+	if relationType == nil {
+		return nil, errors.New("relation type missing")
+	}
+	return domain.NewRelationType(relationType.Name, relationType.PropagatesPermissions, relationType.PropagatesAttributes)
+}
+
+func RelationTypeFromDomain(relationType domain.RelationType) *api.RelationType {
+	return &api.RelationType{
+		Name:                  relationType.Name(),
+		PropagatesPermissions: relationType.PropagatesPermissions(),
+		PropagatesAttributes:  relationType.PropagatesAttributes(),
+	}
+}
@@ -0,0 +1,405 @@
+package bolt
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/c12s/oort/internal/domain"
+	"go.etcd.io/bbolt"
+)
+
+// unbounded lets a traversal follow any number of relations
+const unbounded = -1
+
+// relTypes returns the names of the relation types that pass the filter, INHERITS_FROM is always included
+func relTypes(tx *bbolt.Tx, filter func(domain.RelationType) bool) (map[string]bool, error) {
+	relTypes := map[string]bool{domain.InheritsFrom: true}
+	err := tx.Bucket(relationTypesBucket).ForEach(func(k, v []byte) error {
+		relationType, err := relationTypeFromRecord(v)
+		if err != nil {
+			return err
+		}
+		if filter == nil || filter(*relationType) {
+			relTypes[relationType.Name()] = true
+		}
+		return nil
+	})
+	return relTypes, err
+}
+
+func propagatesPermissions(relationType domain.RelationType) bool {
+	return relationType.PropagatesPermissions()
+}
+
+func propagatesAttributes(relationType domain.RelationType) bool {
+	return relationType.PropagatesAttributes()
+}
+
+// neighbours returns the resources related through the index of the resource, either its parents or its children,
+// only following relations of the given types
+func neighbours(tx *bbolt.Tx, name string, index []byte, relTypes map[string]bool) ([]string, error) {
+	r := resource(tx, name)
+	if r == nil {
+		return nil, nil
+	}
+	neighbours := make([]string, 0)
+	err := r.Bucket(index).ForEach(func(k, v []byte) error {
+		parts := splitKey(k)
+		if len(parts) != 2 {
+			return errors.New("invalid key format - relation")
+		}
+		if relTypes[parts[0]] {
+			neighbours = append(neighbours, parts[1])
+		}
+		return nil
+	})
+	return neighbours, err
+}
+
+// distances returns the length of the shortest path from the resource to every resource it reaches,
+// following at most maxDepth relations of the given types.
+// The resource itself is included at distance 0 if paths without relations are counted.
+func distances(tx *bbolt.Tx, name string, relTypes map[string]bool, maxDepth int, withSelf bool) (map[string]int, error) {
+	distances := make(map[string]int)
+	if resource(tx, name) == nil {
+		return distances, nil
+	}
+	type visit struct {
+		name     string
+		distance int
+	}
+	queue := []visit{{name: name}}
+	if withSelf {
+		distances[name] = 0
+	}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		if maxDepth != unbounded && current.distance >= maxDepth {
+			continue
+		}
+		parents, err := neighbours(tx, current.name, parentsBucket, relTypes)
+		if err != nil {
+			return nil, err
+		}
+		for _, parent := range parents {
+			if _, visited := distances[parent]; visited {
+				continue
+			}
+			distances[parent] = current.distance + 1
+			queue = append(queue, visit{name: parent, distance: current.distance + 1})
+		}
+	}
+	return distances, nil
+}
+
+func reaches(tx *bbolt.Tx, from, to string, relTypes map[string]bool) (bool, error) {
+	distances, err := distances(tx, from, relTypes, unbounded, true)
+	if err != nil {
+		return false, err
+	}
+	_, ok := distances[to]
+	return ok, nil
+}
+
+// descendants returns the resource and all resources that reach it through relations of the given types
+func descendants(tx *bbolt.Tx, name string, relTypes map[string]bool) ([]string, error) {
+	if resource(tx, name) == nil {
+		return nil, nil
+	}
+	visited := map[string]bool{name: true}
+	descendants := []string{name}
+	for i := 0; i < len(descendants); i++ {
+		children, err := neighbours(tx, descendants[i], childrenBucket, relTypes)
+		if err != nil {
+			return nil, err
+		}
+		for _, child := range children {
+			if !visited[child] {
+				visited[child] = true
+				descendants = append(descendants, child)
+			}
+		}
+	}
+	return descendants, nil
+}
+
+// longestPath returns the length of the longest path leaving the resource through the index,
+// resources already on the path aren't visited again, so cycles can't make it loop
+func longestPath(tx *bbolt.Tx, name string, index []byte, relTypes map[string]bool, memo map[string]int, onPath map[string]bool) (int, error) {
+	if length, ok := memo[name]; ok {
+		return length, nil
+	}
+	related, err := neighbours(tx, name, index, relTypes)
+	if err != nil {
+		return 0, err
+	}
+	onPath[name] = true
+	longest := 0
+	for _, neighbour := range related {
+		if onPath[neighbour] {
+			continue
+		}
+		length, err := longestPath(tx, neighbour, index, relTypes, memo, onPath)
+		if err != nil {
+			return 0, err
+		}
+		if length+1 > longest {
+			longest = length + 1
+		}
+	}
+	delete(onPath, name)
+	memo[name] = longest
+	return longest, nil
+}
+
+// depthThrough returns the length of the longest chain of relations of the given types
+// that would pass through a new relation of from to to
+func depthThrough(tx *bbolt.Tx, from, to string, relTypes map[string]bool) (int, error) {
+	below, err := longestPath(tx, to, childrenBucket, relTypes, make(map[string]int), make(map[string]bool))
+	if err != nil {
+		return 0, err
+	}
+	above, err := longestPath(tx, from, parentsBucket, relTypes, make(map[string]int), make(map[string]bool))
+	if err != nil {
+		return 0, err
+	}
+	return below + 1 + above, nil
+}
+
+// cycles returns every cycle of relations of the given types once, starting from its resource with the lowest name,
+// a cycle is reported once per distinct sequence of relations, like ncGetInheritanceCyclesCypher does
+func cycles(tx *bbolt.Tx, relTypes map[string]bool) ([]domain.InheritanceCycle, error) {
+	cycles := make([]domain.InheritanceCycle, 0)
+	// bbolt iterates over the keys in byte order
+	for _, k := range keys(tx.Bucket(resourcesBucket)) {
+		start := string(k)
+		var visit func(path []string) error
+		visit = func(path []string) error {
+			parents, err := neighbours(tx, path[len(path)-1], parentsBucket, relTypes)
+			if err != nil {
+				return err
+			}
+			for _, parent := range parents {
+				if parent < start {
+					continue
+				}
+				if parent == start {
+					resources, err := resourcesFromNames(path)
+					if err != nil {
+						return err
+					}
+					cycles = append(cycles, domain.InheritanceCycle{Resources: resources})
+					continue
+				}
+				if contains(path, parent) {
+					continue
+				}
+				if err := visit(append(path[:len(path):len(path)], parent)); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		if err := visit([]string{start}); err != nil {
+			return nil, err
+		}
+	}
+	return cycles, nil
+}
+
+// related reports whether to can be reached from from by following between one and maxDepth relations of the type
+func related(tx *bbolt.Tx, from, to, relType string, maxDepth int) (bool, error) {
+	distances, err := distances(tx, from, map[string]bool{relType: true}, maxDepth, false)
+	if err != nil {
+		return false, err
+	}
+	_, ok := distances[to]
+	return ok, nil
+}
+
+// shareAncestor reports whether both resources are related to a resource of the kind
+// through relations that propagate permissions
+func shareAncestor(tx *bbolt.Tx, from, to, kind string) (bool, error) {
+	relTypes, err := relTypes(tx, propagatesPermissions)
+	if err != nil {
+		return false, err
+	}
+	fromAncestors, err := distances(tx, from, relTypes, unbounded, false)
+	if err != nil {
+		return false, err
+	}
+	toAncestors, err := distances(tx, to, relTypes, unbounded, false)
+	if err != nil {
+		return false, err
+	}
+	for ancestor := range fromAncestors {
+		if _, ok := toAncestors[ancestor]; ok && strings.HasPrefix(ancestor, kind+"/") {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+type sodScope struct {
+	subject,
+	object string
+}
+
+type subjectObject struct {
+	subject,
+	object string
+}
+
+// sodViolation follows ncVerifySoDCypher, it returns the first subject that holds allow policies
+// granting more than one permission of a constraint on the same object,
+// considering only subjects and objects that descend from one of the scopes
+func sodViolation(tx *bbolt.Tx, constraints []domain.SoDConstraint, scopes []sodScope, now time.Time) error {
+	permissionRelTypes, err := relTypes(tx, propagatesPermissions)
+	if err != nil {
+		return err
+	}
+	allRelTypes, err := relTypes(tx, nil)
+	if err != nil {
+		return err
+	}
+	implications, err := implications(tx)
+	if err != nil {
+		return err
+	}
+	policies, err := allPolicies(tx)
+	if err != nil {
+		return err
+	}
+	inScopes := func(held subjectObject) (bool, error) {
+		for _, scope := range scopes {
+			subInScope, err := reaches(tx, held.subject, scope.subject, allRelTypes)
+			if err != nil {
+				return false, err
+			}
+			objInScope, err := reaches(tx, held.object, scope.object, allRelTypes)
+			if err != nil {
+				return false, err
+			}
+			if subInScope && objInScope {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+	for _, constraint := range constraints {
+		granted := make(map[subjectObject]map[int]bool)
+		for i, names := range constraint.GrantingNames() {
+			grantingNames := toSet(append(names, domain.ImplyingPermissions(implications, names)...))
+			for _, p := range policies {
+				if p.Kind != domain.PermissionKindAllow || !grantingNames[p.Name] || p.validity().Expired(now) {
+					continue
+				}
+				subs, err := descendants(tx, p.Subject, permissionRelTypes)
+				if err != nil {
+					return err
+				}
+				objs, err := descendants(tx, p.Object, permissionRelTypes)
+				if err != nil {
+					return err
+				}
+				for _, sub := range subs {
+					for _, obj := range objs {
+						held := subjectObject{subject: sub, object: obj}
+						if granted[held] == nil {
+							ok, err := inScopes(held)
+							if err != nil {
+								return err
+							}
+							if !ok {
+								continue
+							}
+							granted[held] = make(map[int]bool)
+						}
+						granted[held][i] = true
+					}
+				}
+			}
+		}
+		violations := make([]subjectObject, 0)
+		for held, permissions := range granted {
+			if len(permissions) > 1 {
+				violations = append(violations, held)
+			}
+		}
+		if len(violations) == 0 {
+			continue
+		}
+		sort.Slice(violations, func(i, j int) bool {
+			if violations[i].subject != violations[j].subject {
+				return violations[i].subject < violations[j].subject
+			}
+			return violations[i].object < violations[j].object
+		})
+		return sodViolationError(constraint.Name(), violations[0].subject, violations[0].object)
+	}
+	return nil
+}
+
+func sodViolationError(constraint, subName, objName string) error {
+	subject, err := domain.NewResourceFromName(subName)
+	if err != nil {
+		return err
+	}
+	object, err := domain.NewResourceFromName(objName)
+	if err != nil {
+		return err
+	}
+	return domain.SoDViolation{Constraint: constraint, Subject: *subject, Object: *object}
+}
+
+// impliedPermissions returns the names the permission transitively implies
+func impliedPermissions(implications []domain.PermissionImplication, name string) []string {
+	visited := map[string]bool{name: true}
+	implied := make([]string, 0)
+	queue := []string{name}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, implication := range implications {
+			if implication.Permission() != current || visited[implication.ImpliedPermission()] {
+				continue
+			}
+			visited[implication.ImpliedPermission()] = true
+			implied = append(implied, implication.ImpliedPermission())
+			queue = append(queue, implication.ImpliedPermission())
+		}
+	}
+	return implied
+}
+
+func resourcesFromNames(names []string) ([]domain.Resource, error) {
+	resources := make([]domain.Resource, len(names))
+	for i, name := range names {
+		resource, err := domain.NewResourceFromName(name)
+		if err != nil {
+			return nil, err
+		}
+		resources[i] = *resource
+	}
+	return resources, nil
+}
+
+func toSet(values []string) map[string]bool {
+	set := make(map[string]bool, len(values))
+	for _, value := range values {
+		set[value] = true
+	}
+	return set
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,332 @@
+package bolt
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/c12s/oort/internal/domain"
+)
+
+// records are stored as JSON, attribute values are decoded according to the kind stored next to them
+
+type storedAttribute struct {
+	Name        string               `json:"name"`
+	Kind        domain.AttributeKind `json:"kind"`
+	Value       json.RawMessage      `json:"value"`
+	Inheritable bool                 `json:"inheritable,omitempty"`
+}
+
+type storedObligation struct {
+	Name   string            `json:"name"`
+	Params map[string]string `json:"params,omitempty"`
+	Advice bool              `json:"advice,omitempty"`
+}
+
+// storedPolicy is the permission edge between a subject and an object,
+// Seq keeps the order the policies were first created in
+type storedPolicy struct {
+	Seq               uint64                      `json:"seq"`
+	Subject           string                      `json:"subject"`
+	Object            string                      `json:"object"`
+	Name              string                      `json:"name"`
+	Kind              domain.PermissionKind       `json:"kind"`
+	Condition         string                      `json:"condition"`
+	ConditionLanguage domain.ConditionLanguage    `json:"conditionLanguage"`
+	OnConditionError  domain.ConditionErrorPolicy `json:"onConditionError"`
+	Obligations       []storedObligation          `json:"obligations"`
+	NotBefore         time.Time                   `json:"notBefore"`
+	NotAfter          time.Time                   `json:"notAfter"`
+}
+
+type storedDefinition struct {
+	Name     string               `json:"name"`
+	Kind     domain.AttributeKind `json:"kind"`
+	Required bool                 `json:"required"`
+	Default  json.RawMessage      `json:"default,omitempty"`
+}
+
+type storedSchema struct {
+	ResourceKind string             `json:"resourceKind"`
+	Definitions  []storedDefinition `json:"definitions"`
+}
+
+type storedBreakGlass struct {
+	Id             string    `json:"id"`
+	Subject        string    `json:"subject"`
+	Object         string    `json:"object"`
+	PermissionName string    `json:"permissionName"`
+	Justification  string    `json:"justification"`
+	GrantedAt      time.Time `json:"grantedAt"`
+	ExpiresAt      time.Time `json:"expiresAt"`
+}
+
+type storedBreakGlassUse struct {
+	BreakGlassId string    `json:"breakGlassId"`
+	UsedAt       time.Time `json:"usedAt"`
+}
+
+type storedSoDConstraint struct {
+	Name        string         `json:"name"`
+	Kind        domain.SoDKind `json:"kind"`
+	Permissions []string       `json:"permissions"`
+}
+
+type storedRelationType struct {
+	Name        string `json:"name"`
+	Permissions bool   `json:"permissions"`
+	Attributes  bool   `json:"attributes"`
+}
+
+func attributeToRecord(attr domain.Attribute) ([]byte, error) {
+	value, err := json.Marshal(attr.Value())
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(storedAttribute{
+		Name:        attr.Name(),
+		Kind:        attr.Kind(),
+		Value:       value,
+		Inheritable: attr.Inheritable(),
+	})
+}
+
+func attributeFromRecord(record []byte) (*domain.Attribute, error) {
+	stored := storedAttribute{}
+	if err := json.Unmarshal(record, &stored); err != nil {
+		return nil, errors.New("invalid record format - attribute")
+	}
+	value, err := attributeValue(stored.Kind, stored.Value)
+	if err != nil {
+		return nil, err
+	}
+	attrId, err := domain.NewAttributeId(stored.Name)
+	if err != nil {
+		return nil, err
+	}
+	attribute, err := domain.NewAttribute(*attrId, stored.Kind, value)
+	if err != nil {
+		return nil, err
+	}
+	attribute.SetInheritable(stored.Inheritable)
+	return attribute, nil
+}
+
+// attributeValue decodes the value into the type that matches the attribute kind,
+// timestamps are stored in RFC 3339 and durations in nanoseconds
+func attributeValue(kind domain.AttributeKind, raw json.RawMessage) (interface{}, error) {
+	var err error
+	var value interface{}
+	switch kind {
+	case domain.Int64:
+		var v int64
+		err = json.Unmarshal(raw, &v)
+		value = v
+	case domain.Float64:
+		var v float64
+		err = json.Unmarshal(raw, &v)
+		value = v
+	case domain.String:
+		var v string
+		err = json.Unmarshal(raw, &v)
+		value = v
+	case domain.Bool:
+		var v bool
+		err = json.Unmarshal(raw, &v)
+		value = v
+	case domain.StringList:
+		v := make([]string, 0)
+		err = json.Unmarshal(raw, &v)
+		value = v
+	case domain.Int64List:
+		v := make([]int64, 0)
+		err = json.Unmarshal(raw, &v)
+		value = v
+	case domain.Timestamp:
+		var v time.Time
+		err = json.Unmarshal(raw, &v)
+		value = v
+	case domain.Duration:
+		var v time.Duration
+		err = json.Unmarshal(raw, &v)
+		value = v
+	default:
+		return nil, errors.New("invalid attribute value - unknown kind")
+	}
+	if err != nil {
+		return nil, errors.New("invalid attribute value - " + kind.String())
+	}
+	return value, nil
+}
+
+func policyToRecord(seq uint64, subject, object string, permission domain.Permission, validity domain.Validity) ([]byte, error) {
+	obligations := make([]storedObligation, len(permission.Obligations()))
+	for i, obligation := range permission.Obligations() {
+		obligations[i] = storedObligation{
+			Name:   obligation.Name(),
+			Params: obligation.Params(),
+			Advice: obligation.Advice(),
+		}
+	}
+	return json.Marshal(storedPolicy{
+		Seq:               seq,
+		Subject:           subject,
+		Object:            object,
+		Name:              permission.Name(),
+		Kind:              permission.Kind(),
+		Condition:         permission.Condition().Expression(),
+		ConditionLanguage: permission.Condition().Language(),
+		OnConditionError:  permission.OnConditionError(),
+		Obligations:       obligations,
+		NotBefore:         validity.NotBefore,
+		NotAfter:          validity.NotAfter,
+	})
+}
+
+func policyFromRecord(record []byte) (*storedPolicy, error) {
+	stored := &storedPolicy{}
+	if err := json.Unmarshal(record, stored); err != nil {
+		return nil, errors.New("invalid record format - policy")
+	}
+	return stored, nil
+}
+
+func (p storedPolicy) validity() domain.Validity {
+	return domain.Validity{NotBefore: p.NotBefore, NotAfter: p.NotAfter}
+}
+
+func (p storedPolicy) permission() (*domain.Permission, error) {
+	cond, err := domain.NewConditionInLanguage(p.Condition, p.ConditionLanguage)
+	if err != nil {
+		return nil, errors.New("invalid condition")
+	}
+	obligations := make([]domain.Obligation, len(p.Obligations))
+	for i, stored := range p.Obligations {
+		obligation, err := domain.NewObligation(stored.Name, stored.Params, stored.Advice)
+		if err != nil {
+			return nil, err
+		}
+		obligations[i] = *obligation
+	}
+	return domain.NewPermission(p.Name, p.Kind, *cond, p.OnConditionError, obligations)
+}
+
+func schemaToRecord(schema domain.AttributeSchema) ([]byte, error) {
+	definitions := make([]storedDefinition, len(schema.Definitions()))
+	for i, definition := range schema.Definitions() {
+		definitions[i] = storedDefinition{
+			Name:     definition.Name(),
+			Kind:     definition.Kind(),
+			Required: definition.Required(),
+		}
+		if attr, ok := definition.Default(); ok {
+			value, err := json.Marshal(attr.Value())
+			if err != nil {
+				return nil, err
+			}
+			definitions[i].Default = value
+		}
+	}
+	return json.Marshal(storedSchema{ResourceKind: schema.ResourceKind(), Definitions: definitions})
+}
+
+func schemaFromRecord(record []byte) (*domain.AttributeSchema, error) {
+	stored := storedSchema{}
+	if err := json.Unmarshal(record, &stored); err != nil {
+		return nil, errors.New("invalid record format - schema")
+	}
+	definitions := make([]domain.AttributeDefinition, 0, len(stored.Definitions))
+	for _, d := range stored.Definitions {
+		var defaultValue interface{}
+		if len(d.Default) > 0 {
+			value, err := attributeValue(d.Kind, d.Default)
+			if err != nil {
+				return nil, err
+			}
+			defaultValue = value
+		}
+		definition, err := domain.NewAttributeDefinition(d.Name, d.Kind, d.Required, defaultValue)
+		if err != nil {
+			return nil, err
+		}
+		definitions = append(definitions, *definition)
+	}
+	return domain.NewAttributeSchema(stored.ResourceKind, definitions)
+}
+
+func breakGlassToRecord(breakGlass domain.BreakGlass) ([]byte, error) {
+	return json.Marshal(storedBreakGlass{
+		Id:             breakGlass.Id(),
+		Subject:        breakGlass.Subject().Name(),
+		Object:         breakGlass.Object().Name(),
+		PermissionName: breakGlass.PermissionName(),
+		Justification:  breakGlass.Justification(),
+		GrantedAt:      breakGlass.GrantedAt(),
+		ExpiresAt:      breakGlass.ExpiresAt(),
+	})
+}
+
+func breakGlassFromRecord(record []byte) (*domain.BreakGlass, error) {
+	stored := storedBreakGlass{}
+	if err := json.Unmarshal(record, &stored); err != nil {
+		return nil, errors.New("invalid record format - break glass")
+	}
+	subject, err := domain.NewResourceFromName(stored.Subject)
+	if err != nil {
+		return nil, err
+	}
+	object, err := domain.NewResourceFromName(stored.Object)
+	if err != nil {
+		return nil, err
+	}
+	breakGlass := domain.RestoreBreakGlass(stored.Id, *subject, *object, stored.PermissionName, stored.Justification, stored.GrantedAt, stored.ExpiresAt)
+	return &breakGlass, nil
+}
+
+func breakGlassUseToRecord(use domain.BreakGlassUse) ([]byte, error) {
+	return json.Marshal(storedBreakGlassUse{
+		BreakGlassId: use.BreakGlassId(),
+		UsedAt:       use.UsedAt(),
+	})
+}
+
+func breakGlassUseFromRecord(record []byte) (*domain.BreakGlassUse, error) {
+	stored := storedBreakGlassUse{}
+	if err := json.Unmarshal(record, &stored); err != nil {
+		return nil, errors.New("invalid record format - break glass use")
+	}
+	use := domain.NewBreakGlassUse(stored.BreakGlassId, stored.UsedAt)
+	return &use, nil
+}
+
+func sodConstraintToRecord(constraint domain.SoDConstraint) ([]byte, error) {
+	return json.Marshal(storedSoDConstraint{
+		Name:        constraint.Name(),
+		Kind:        constraint.Kind(),
+		Permissions: constraint.Permissions(),
+	})
+}
+
+func sodConstraintFromRecord(record []byte) (*domain.SoDConstraint, error) {
+	stored := storedSoDConstraint{}
+	if err := json.Unmarshal(record, &stored); err != nil {
+		return nil, errors.New("invalid record format - sod constraint")
+	}
+	return domain.NewSoDConstraint(stored.Name, stored.Kind, stored.Permissions)
+}
+
+func relationTypeToRecord(relationType domain.RelationType) ([]byte, error) {
+	return json.Marshal(storedRelationType{
+		Name:        relationType.Name(),
+		Permissions: relationType.PropagatesPermissions(),
+		Attributes:  relationType.PropagatesAttributes(),
+	})
+}
+
+func relationTypeFromRecord(record []byte) (*domain.RelationType, error) {
+	stored := storedRelationType{}
+	if err := json.Unmarshal(record, &stored); err != nil {
+		return nil, errors.New("invalid record format - relation type")
+	}
+	return domain.NewRelationType(stored.Name, stored.Permissions, stored.Attributes)
+}
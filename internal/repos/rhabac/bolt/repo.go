@@ -0,0 +1,894 @@
+package bolt
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/c12s/oort/internal/domain"
+	"go.etcd.io/bbolt"
+	"go.opentelemetry.io/otel"
+)
+
+// RHABACRepo stores the resource graph in an embedded bbolt database,
+// it answers every query the way the neo4j repo's cyphers do by traversing the indexes in process.
+// A mutation runs in one bbolt transaction, which is rolled back if a verification fails.
+type RHABACRepo struct {
+	db *bbolt.DB
+	// tx is the transaction of the batch the repo applies ops of, nil outside of batches
+	tx *bbolt.Tx
+}
+
+func NewRHABACRepo(db *bbolt.DB) domain.RHABACRepo {
+	return RHABACRepo{
+		db: db,
+	}
+}
+
+func (store RHABACRepo) update(fn func(tx *bbolt.Tx) error) error {
+	if store.tx != nil {
+		return fn(store.tx)
+	}
+	return store.db.Update(fn)
+}
+
+func (store RHABACRepo) view(fn func(tx *bbolt.Tx) error) error {
+	if store.tx != nil {
+		return fn(store.tx)
+	}
+	return store.db.View(fn)
+}
+
+func (store RHABACRepo) CreateResource(ctx context.Context, req domain.CreateResourceReq) domain.AdministrationResp {
+	tracer := otel.Tracer("oort.bolt.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.CreateResource")
+	defer span.End()
+	err := store.update(func(tx *bbolt.Tx) error {
+		name := req.Resource.Name()
+		if err := mergeRooted(tx, name); err != nil {
+			return err
+		}
+		for _, attr := range req.Resource.Attributes {
+			if err := putAttribute(tx, name, attr); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return domain.AdministrationResp{Error: err}
+}
+
+// DeleteResource removes the resource, its attributes, its relations and the policies it is the subject or the object of
+func (store RHABACRepo) DeleteResource(ctx context.Context, req domain.DeleteResourceReq) domain.AdministrationResp {
+	tracer := otel.Tracer("oort.bolt.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.DeleteResource")
+	defer span.End()
+	err := store.update(func(tx *bbolt.Tx) error {
+		name := req.Resource.Name()
+		r := resource(tx, name)
+		if r == nil {
+			return nil
+		}
+		for _, index := range [][]byte{subjectOfBucket, objectOfBucket} {
+			for _, k := range keys(r.Bucket(index)) {
+				if err := deletePolicy(tx, k); err != nil {
+					return err
+				}
+			}
+		}
+		for _, k := range keys(r.Bucket(parentsBucket)) {
+			parts := splitKey(k)
+			if err := deleteRelation(tx, name, parts[1], parts[0]); err != nil {
+				return err
+			}
+		}
+		for _, k := range keys(r.Bucket(childrenBucket)) {
+			parts := splitKey(k)
+			if err := deleteRelation(tx, parts[1], name, parts[0]); err != nil {
+				return err
+			}
+		}
+		return tx.Bucket(resourcesBucket).DeleteBucket([]byte(name))
+	})
+	return domain.AdministrationResp{Error: err}
+}
+
+func (store RHABACRepo) GetResource(ctx context.Context, req domain.GetResourceReq) domain.GetResourceResp {
+	tracer := otel.Tracer("oort.bolt.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.GetResource")
+	defer span.End()
+	var resp domain.GetResourceResp
+	err := store.view(func(tx *bbolt.Tx) error {
+		r := resource(tx, req.Resource.Name())
+		if r == nil {
+			return errors.New("resource not found")
+		}
+		// like the neo4j mapper, only the attributes of the resource are returned
+		res, err := domain.NewResource("", "")
+		if err != nil {
+			return err
+		}
+		res.Attributes, err = attributes(r)
+		if err != nil {
+			return err
+		}
+		resp.Resource = res
+		return nil
+	})
+	if err != nil {
+		return domain.GetResourceResp{Error: err}
+	}
+	return resp
+}
+
+func (store RHABACRepo) PutAttribute(ctx context.Context, req domain.PutAttributeReq) domain.AdministrationResp {
+	tracer := otel.Tracer("oort.bolt.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.PutAttribute")
+	defer span.End()
+	err := store.update(func(tx *bbolt.Tx) error {
+		name := req.Resource.Name()
+		if err := mergeRooted(tx, name); err != nil {
+			return err
+		}
+		return putAttribute(tx, name, req.Attribute)
+	})
+	return domain.AdministrationResp{Error: err}
+}
+
+func (store RHABACRepo) DeleteAttribute(ctx context.Context, req domain.DeleteAttributeReq) domain.AdministrationResp {
+	tracer := otel.Tracer("oort.bolt.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.DeleteAttribute")
+	defer span.End()
+	err := store.update(func(tx *bbolt.Tx) error {
+		r := resource(tx, req.Resource.Name())
+		if r == nil {
+			return nil
+		}
+		return r.Bucket(attributesBucket).Delete([]byte(req.AttributeId.Name()))
+	})
+	return domain.AdministrationResp{Error: err}
+}
+
+// CreateRelation makes the same checks as ncCreateRelationCypher
+func (store RHABACRepo) CreateRelation(ctx context.Context, req domain.CreateRelationReq) domain.AdministrationResp {
+	tracer := otel.Tracer("oort.bolt.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.CreateRelation")
+	defer span.End()
+	// relation types are parts of keys
+	if err := domain.ValidateRelationTypeName(req.Type); err != nil {
+		return domain.AdministrationResp{Error: err}
+	}
+	err := store.update(func(tx *bbolt.Tx) error {
+		fromName, toName := req.From.Name(), req.To.Name()
+		if err := mergeRooted(tx, fromName); err != nil {
+			return err
+		}
+		if err := mergeRooted(tx, toName); err != nil {
+			return err
+		}
+		relTypes, err := relTypes(tx, nil)
+		if err != nil {
+			return err
+		}
+		if !relTypes[req.Type] {
+			return domain.ErrUnknownRelationType
+		}
+		cycle, err := reaches(tx, fromName, toName, relTypes)
+		if err != nil {
+			return err
+		}
+		if cycle {
+			return domain.ErrInheritanceCycle
+		}
+		depth, err := depthThrough(tx, fromName, toName, relTypes)
+		if err != nil {
+			return err
+		}
+		if depth > req.MaxDepth {
+			return fmt.Errorf("%w: %d > %d", domain.ErrInheritanceDepth, depth, req.MaxDepth)
+		}
+		if err := mergeRelation(tx, toName, fromName, req.Type); err != nil {
+			return err
+		}
+		if len(req.SoDConstraints) == 0 {
+			return nil
+		}
+		// the new relationship changes what descendants of to hold, both as subjects and as objects
+		rootName := domain.RootResource.Name()
+		scopes := []sodScope{
+			{subject: toName, object: rootName},
+			{subject: rootName, object: toName},
+		}
+		return sodViolation(tx, req.SoDConstraints, scopes, time.Now())
+	})
+	return domain.AdministrationResp{Error: err}
+}
+
+func (store RHABACRepo) DeleteRelation(ctx context.Context, req domain.DeleteRelationReq) domain.AdministrationResp {
+	tracer := otel.Tracer("oort.bolt.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.DeleteRelation")
+	defer span.End()
+	err := store.update(func(tx *bbolt.Tx) error {
+		return deleteRelation(tx, req.To.Name(), req.From.Name(), req.Type)
+	})
+	return domain.AdministrationResp{Error: err}
+}
+
+func (store RHABACRepo) CreatePolicy(ctx context.Context, req domain.CreatePolicyReq) domain.AdministrationResp {
+	tracer := otel.Tracer("oort.bolt.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.CreatePolicy")
+	defer span.End()
+	err := store.update(func(tx *bbolt.Tx) error {
+		subName, objName := req.SubjectScope.Name(), req.ObjectScope.Name()
+		if err := mergeRooted(tx, subName); err != nil {
+			return err
+		}
+		if err := mergeRooted(tx, objName); err != nil {
+			return err
+		}
+		if err := putPolicy(tx, req); err != nil {
+			return err
+		}
+		if len(req.SoDConstraints) == 0 {
+			return nil
+		}
+		scopes := []sodScope{{subject: subName, object: objName}}
+		return sodViolation(tx, req.SoDConstraints, scopes, time.Now())
+	})
+	return domain.AdministrationResp{Error: err}
+}
+
+func (store RHABACRepo) DeletePolicy(ctx context.Context, req domain.DeletePolicyReq) domain.AdministrationResp {
+	tracer := otel.Tracer("oort.bolt.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.DeletePolicy")
+	defer span.End()
+	err := store.update(func(tx *bbolt.Tx) error {
+		return deletePolicy(tx, policyKey(req.SubjectScope.Name(), req.ObjectScope.Name(), req.Permission.Name(), req.Permission.Kind()))
+	})
+	return domain.AdministrationResp{Error: err}
+}
+
+// GetPermissionHierarchy ranks the policies like ncGetPermissionsCypher,
+// by the shortest paths of relations that propagate permissions from the subject and the object to the policy's resources.
+// A policy reached through several paths is added once, in the order the policies were created.
+func (store RHABACRepo) GetPermissionHierarchy(ctx context.Context, req domain.GetPermissionHierarchyReq) domain.GetPermissionHierarchyResp {
+	tracer := otel.Tracer("oort.bolt.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.GetPermissionHierarchy")
+	defer span.End()
+	hierarchy := make(domain.PermissionHierarchy)
+	err := store.view(func(tx *bbolt.Tx) error {
+		relTypes, err := relTypes(tx, propagatesPermissions)
+		if err != nil {
+			return err
+		}
+		subDistances, err := distances(tx, req.Subject.Name(), relTypes, unbounded, true)
+		if err != nil {
+			return err
+		}
+		objDistances, err := distances(tx, req.Object.Name(), relTypes, unbounded, true)
+		if err != nil {
+			return err
+		}
+		implications, err := implications(tx)
+		if err != nil {
+			return err
+		}
+		names := domain.PermissionNameCandidates(req.PermissionName)
+		permNames := toSet(names)
+		implyingNames := toSet(domain.ImplyingPermissions(implications, names))
+
+		// the policies of every resource the subject reaches are candidates
+		candidates := make([][]byte, 0)
+		for subParent := range subDistances {
+			candidates = append(candidates, keys(resource(tx, subParent).Bucket(subjectOfBucket))...)
+		}
+		candidatePolicies, err := policies(tx, candidates)
+		if err != nil {
+			return err
+		}
+		now := time.Now()
+		for _, p := range candidatePolicies {
+			if !permNames[p.Name] && !(p.Kind == domain.PermissionKindAllow && implyingNames[p.Name]) {
+				continue
+			}
+			if !p.validity().Active(now) {
+				continue
+			}
+			objDistance, ok := objDistances[p.Object]
+			if !ok {
+				continue
+			}
+			permission, err := p.permission()
+			if err != nil {
+				return err
+			}
+			subPriority := domain.PermissionPriority(-subDistances[p.Subject])
+			objPriority := domain.PermissionPriority(-objDistance)
+			if _, ok := hierarchy[subPriority]; !ok {
+				hierarchy[subPriority] = make(domain.PermissionObjHierarchy)
+			}
+			hierarchy[subPriority][objPriority] = append(hierarchy[subPriority][objPriority], *permission)
+		}
+		return nil
+	})
+	if err != nil {
+		return domain.GetPermissionHierarchyResp{Error: err}
+	}
+	return domain.GetPermissionHierarchyResp{Hierarchy: hierarchy}
+}
+
+// GetAuthorizationData reads the data in one read transaction, so that all of it is consistent
+func (store RHABACRepo) GetAuthorizationData(ctx context.Context, req domain.GetAuthorizationDataReq) domain.GetAuthorizationDataResp {
+	tracer := otel.Tracer("oort.bolt.repo")
+	ctx, span := tracer.Start(ctx, "RHABACRepo.GetAuthorizationData")
+	defer span.End()
+	var resp domain.GetAuthorizationDataResp
+	err := store.view(func(tx *bbolt.Tx) error {
+		resp = domain.ReadAuthorizationData(ctx, RHABACRepo{db: store.db, tx: tx}, req)
+		return resp.Error
+	})
+	if err != nil {
+		return domain.GetAuthorizationDataResp{Error: err}
+	}
+	return resp
+}
+
+// GetApplicablePolicies returns the distinct permission names the subject holds on each object,
+// allow policies also grant the names their permission implies
+func (store RHABACRepo) GetApplicablePolicies(ctx context.Context, req domain.GetApplicablePoliciesReq) domain.GetApplicablePoliciesResp {
+	tracer := otel.Tracer("oort.bolt.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.GetApplicablePolicies")
+	defer span.End()
+	applicable := make([]domain.Policy, 0)
+	err := store.view(func(tx *bbolt.Tx) error {
+		relTypes, err := relTypes(tx, propagatesPermissions)
+		if err != nil {
+			return err
+		}
+		subDistances, err := distances(tx, req.Subject.Name(), relTypes, unbounded, true)
+		if err != nil {
+			return err
+		}
+		implications, err := implications(tx)
+		if err != nil {
+			return err
+		}
+		candidates := make([][]byte, 0)
+		for subParent := range subDistances {
+			candidates = append(candidates, keys(resource(tx, subParent).Bucket(subjectOfBucket))...)
+		}
+		candidatePolicies, err := policies(tx, candidates)
+		if err != nil {
+			return err
+		}
+		type permissionObject struct {
+			permission,
+			object string
+		}
+		seen := make(map[permissionObject]bool)
+		now := time.Now()
+		for _, p := range candidatePolicies {
+			if !p.validity().Active(now) {
+				continue
+			}
+			permNames := []string{p.Name}
+			if p.Kind == domain.PermissionKindAllow {
+				permNames = append(permNames, impliedPermissions(implications, p.Name)...)
+			}
+			objNames, err := descendants(tx, p.Object, relTypes)
+			if err != nil {
+				return err
+			}
+			for _, objName := range objNames {
+				object, err := domain.NewResourceFromName(objName)
+				if err != nil {
+					return err
+				}
+				for _, permName := range permNames {
+					if key := (permissionObject{permission: permName, object: objName}); !seen[key] {
+						seen[key] = true
+						applicable = append(applicable, domain.Policy{PermissionName: permName, Object: *object})
+					}
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return domain.GetApplicablePoliciesResp{Error: err}
+	}
+	return domain.GetApplicablePoliciesResp{Policies: applicable}
+}
+
+func (store RHABACRepo) PutAttributeSchema(ctx context.Context, req domain.PutAttributeSchemaReq) domain.AdministrationResp {
+	tracer := otel.Tracer("oort.bolt.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.PutAttributeSchema")
+	defer span.End()
+	err := store.update(func(tx *bbolt.Tx) error {
+		record, err := schemaToRecord(req.Schema)
+		if err != nil {
+			return err
+		}
+		return tx.Bucket(schemasBucket).Put([]byte(req.Schema.ResourceKind()), record)
+	})
+	return domain.AdministrationResp{Error: err}
+}
+
+func (store RHABACRepo) DeleteAttributeSchema(ctx context.Context, req domain.DeleteAttributeSchemaReq) domain.AdministrationResp {
+	tracer := otel.Tracer("oort.bolt.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.DeleteAttributeSchema")
+	defer span.End()
+	err := store.update(func(tx *bbolt.Tx) error {
+		return tx.Bucket(schemasBucket).Delete([]byte(req.ResourceKind))
+	})
+	return domain.AdministrationResp{Error: err}
+}
+
+func (store RHABACRepo) GetAttributeSchema(ctx context.Context, req domain.GetAttributeSchemaReq) domain.GetAttributeSchemaResp {
+	tracer := otel.Tracer("oort.bolt.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.GetAttributeSchema")
+	defer span.End()
+	var schema *domain.AttributeSchema
+	err := store.view(func(tx *bbolt.Tx) error {
+		record := tx.Bucket(schemasBucket).Get([]byte(req.ResourceKind))
+		if record == nil {
+			return nil
+		}
+		var err error
+		schema, err = schemaFromRecord(record)
+		return err
+	})
+	return domain.GetAttributeSchemaResp{Schema: schema, Error: err}
+}
+
+func (store RHABACRepo) SetCombiningAlgorithm(ctx context.Context, req domain.SetCombiningAlgorithmReq) domain.AdministrationResp {
+	tracer := otel.Tracer("oort.bolt.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.SetCombiningAlgorithm")
+	defer span.End()
+	err := store.update(func(tx *bbolt.Tx) error {
+		return tx.Bucket(algorithmsBucket).Put([]byte(req.PermissionName), []byte(strconv.Itoa(int(req.Algorithm))))
+	})
+	return domain.AdministrationResp{Error: err}
+}
+
+func (store RHABACRepo) GetCombiningAlgorithm(ctx context.Context, req domain.GetCombiningAlgorithmReq) domain.GetCombiningAlgorithmResp {
+	tracer := otel.Tracer("oort.bolt.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.GetCombiningAlgorithm")
+	defer span.End()
+	algorithm := domain.DefaultCombiningAlgorithm
+	err := store.view(func(tx *bbolt.Tx) error {
+		record := tx.Bucket(algorithmsBucket).Get([]byte(req.PermissionName))
+		if record == nil {
+			return nil
+		}
+		stored, err := strconv.Atoi(string(record))
+		if err != nil {
+			return errors.New("invalid record format - combining algorithm")
+		}
+		algorithm = domain.CombiningAlgorithm(stored)
+		return nil
+	})
+	if err != nil {
+		return domain.GetCombiningAlgorithmResp{Algorithm: domain.DefaultCombiningAlgorithm, Error: err}
+	}
+	return domain.GetCombiningAlgorithmResp{Algorithm: algorithm}
+}
+
+func (store RHABACRepo) CreatePermissionImplication(ctx context.Context, req domain.CreatePermissionImplicationReq) domain.AdministrationResp {
+	tracer := otel.Tracer("oort.bolt.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.CreatePermissionImplication")
+	defer span.End()
+	err := store.update(func(tx *bbolt.Tx) error {
+		implications, err := implications(tx)
+		if err != nil {
+			return err
+		}
+		if domain.CreatesImplicationCycle(implications, req.Implication) {
+			return domain.ErrImplicationCycle
+		}
+		return tx.Bucket(implicationsBucket).Put(key(req.Implication.Permission(), req.Implication.ImpliedPermission()), nil)
+	})
+	return domain.AdministrationResp{Error: err}
+}
+
+func (store RHABACRepo) DeletePermissionImplication(ctx context.Context, req domain.DeletePermissionImplicationReq) domain.AdministrationResp {
+	tracer := otel.Tracer("oort.bolt.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.DeletePermissionImplication")
+	defer span.End()
+	err := store.update(func(tx *bbolt.Tx) error {
+		return tx.Bucket(implicationsBucket).Delete(key(req.Implication.Permission(), req.Implication.ImpliedPermission()))
+	})
+	return domain.AdministrationResp{Error: err}
+}
+
+func (store RHABACRepo) DeleteExpiredPolicies(ctx context.Context, req domain.DeleteExpiredPoliciesReq) domain.DeleteExpiredPoliciesResp {
+	tracer := otel.Tracer("oort.bolt.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.DeleteExpiredPolicies")
+	defer span.End()
+	expired := make([]domain.Policy, 0)
+	err := store.update(func(tx *bbolt.Tx) error {
+		stored, err := allPolicies(tx)
+		if err != nil {
+			return err
+		}
+		for _, p := range stored {
+			if !p.validity().Expired(req.Now) {
+				continue
+			}
+			subject, err := domain.NewResourceFromName(p.Subject)
+			if err != nil {
+				return err
+			}
+			object, err := domain.NewResourceFromName(p.Object)
+			if err != nil {
+				return err
+			}
+			if err := deletePolicy(tx, policyKey(p.Subject, p.Object, p.Name, p.Kind)); err != nil {
+				return err
+			}
+			expired = append(expired, domain.Policy{
+				PermissionName: p.Name,
+				Subject:        *subject,
+				Object:         *object,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return domain.DeleteExpiredPoliciesResp{Error: err}
+	}
+	return domain.DeleteExpiredPoliciesResp{Policies: expired}
+}
+
+// break glass entries only reference resources by name,
+// so the audit trail outlives the resources it refers to
+func (store RHABACRepo) CreateBreakGlass(ctx context.Context, req domain.CreateBreakGlassReq) domain.AdministrationResp {
+	tracer := otel.Tracer("oort.bolt.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.CreateBreakGlass")
+	defer span.End()
+	err := store.update(func(tx *bbolt.Tx) error {
+		record, err := breakGlassToRecord(req.BreakGlass)
+		if err != nil {
+			return err
+		}
+		return tx.Bucket(breakGlassesBucket).Put([]byte(req.BreakGlass.Id()), record)
+	})
+	return domain.AdministrationResp{Error: err}
+}
+
+func (store RHABACRepo) GetActiveBreakGlass(ctx context.Context, req domain.GetActiveBreakGlassReq) domain.GetActiveBreakGlassResp {
+	tracer := otel.Tracer("oort.bolt.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.GetActiveBreakGlass")
+	defer span.End()
+	var active *domain.BreakGlass
+	err := store.view(func(tx *bbolt.Tx) error {
+		return tx.Bucket(breakGlassesBucket).ForEach(func(k, v []byte) error {
+			breakGlass, err := breakGlassFromRecord(v)
+			if err != nil {
+				return err
+			}
+			if breakGlass.Subject().Name() != req.Subject.Name() || breakGlass.Object().Name() != req.Object.Name() ||
+				breakGlass.PermissionName() != req.PermissionName || !breakGlass.Active(req.Now) {
+				return nil
+			}
+			if active == nil || breakGlass.ExpiresAt().After(active.ExpiresAt()) {
+				active = breakGlass
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return domain.GetActiveBreakGlassResp{Error: err}
+	}
+	return domain.GetActiveBreakGlassResp{BreakGlass: active}
+}
+
+// uses are keyed by the break glass id and a sequence number, so each use is kept
+func (store RHABACRepo) CreateBreakGlassUse(ctx context.Context, req domain.CreateBreakGlassUseReq) domain.AdministrationResp {
+	tracer := otel.Tracer("oort.bolt.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.CreateBreakGlassUse")
+	defer span.End()
+	err := store.update(func(tx *bbolt.Tx) error {
+		uses := tx.Bucket(breakGlassUsesBucket)
+		seq, err := uses.NextSequence()
+		if err != nil {
+			return err
+		}
+		record, err := breakGlassUseToRecord(req.Use)
+		if err != nil {
+			return err
+		}
+		return uses.Put(key(req.Use.BreakGlassId(), strconv.FormatUint(seq, 10)), record)
+	})
+	return domain.AdministrationResp{Error: err}
+}
+
+func (store RHABACRepo) GetBreakGlassUses(ctx context.Context, req domain.GetBreakGlassUsesReq) domain.GetBreakGlassUsesResp {
+	tracer := otel.Tracer("oort.bolt.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.GetBreakGlassUses")
+	defer span.End()
+	uses := make([]domain.BreakGlassUse, 0)
+	err := store.view(func(tx *bbolt.Tx) error {
+		prefix := key(req.BreakGlassId, "")
+		c := tx.Bucket(breakGlassUsesBucket).Cursor()
+		for k, v := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, v = c.Next() {
+			use, err := breakGlassUseFromRecord(v)
+			if err != nil {
+				return err
+			}
+			uses = append(uses, *use)
+		}
+		return nil
+	})
+	if err != nil {
+		return domain.GetBreakGlassUsesResp{Error: err}
+	}
+	sort.SliceStable(uses, func(i, j int) bool {
+		return uses[i].UsedAt().Before(uses[j].UsedAt())
+	})
+	return domain.GetBreakGlassUsesResp{Uses: uses}
+}
+
+// CreateSoDConstraint only accepts a static constraint if no subject violates it already
+func (store RHABACRepo) CreateSoDConstraint(ctx context.Context, req domain.CreateSoDConstraintReq) domain.AdministrationResp {
+	tracer := otel.Tracer("oort.bolt.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.CreateSoDConstraint")
+	defer span.End()
+	err := store.update(func(tx *bbolt.Tx) error {
+		if req.Constraint.Kind() == domain.SoDStatic {
+			rootName := domain.RootResource.Name()
+			scopes := []sodScope{{subject: rootName, object: rootName}}
+			if err := sodViolation(tx, []domain.SoDConstraint{req.Constraint}, scopes, time.Now()); err != nil {
+				return err
+			}
+		}
+		record, err := sodConstraintToRecord(req.Constraint)
+		if err != nil {
+			return err
+		}
+		return tx.Bucket(sodConstraintsBucket).Put([]byte(req.Constraint.Name()), record)
+	})
+	return domain.AdministrationResp{Error: err}
+}
+
+func (store RHABACRepo) DeleteSoDConstraint(ctx context.Context, req domain.DeleteSoDConstraintReq) domain.AdministrationResp {
+	tracer := otel.Tracer("oort.bolt.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.DeleteSoDConstraint")
+	defer span.End()
+	err := store.update(func(tx *bbolt.Tx) error {
+		return tx.Bucket(sodConstraintsBucket).Delete([]byte(req.Name))
+	})
+	return domain.AdministrationResp{Error: err}
+}
+
+// GetSoDConstraints returns the constraints ordered by name
+func (store RHABACRepo) GetSoDConstraints(ctx context.Context, req domain.GetSoDConstraintsReq) domain.GetSoDConstraintsResp {
+	tracer := otel.Tracer("oort.bolt.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.GetSoDConstraints")
+	defer span.End()
+	constraints := make([]domain.SoDConstraint, 0)
+	err := store.view(func(tx *bbolt.Tx) error {
+		return tx.Bucket(sodConstraintsBucket).ForEach(func(k, v []byte) error {
+			constraint, err := sodConstraintFromRecord(v)
+			if err != nil {
+				return err
+			}
+			if constraint.Kind() == req.Kind && (req.PermissionName == "" || constraint.Contains(req.PermissionName)) {
+				constraints = append(constraints, *constraint)
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return domain.GetSoDConstraintsResp{Error: err}
+	}
+	return domain.GetSoDConstraintsResp{Constraints: constraints}
+}
+
+// ExercisePermission records the exercise like ncExercisePermissionCypher,
+// which only records it if there are constraints to check it against
+func (store RHABACRepo) ExercisePermission(ctx context.Context, req domain.ExercisePermissionReq) domain.AdministrationResp {
+	tracer := otel.Tracer("oort.bolt.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.ExercisePermission")
+	defer span.End()
+	run := store.update
+	if req.DryRun {
+		run = store.view
+	}
+	err := run(func(tx *bbolt.Tx) error {
+		subName, objName := req.Subject.Name(), req.Object.Name()
+		exercised := tx.Bucket(exercisedBucket)
+		for _, constraint := range req.SoDConstraints {
+			for _, conflicting := range constraint.ConflictingPermissions(req.PermissionName) {
+				if exercised.Get(key(subName, objName, conflicting)) != nil {
+					return sodViolationError(constraint.Name(), subName, objName)
+				}
+			}
+		}
+		if len(req.SoDConstraints) == 0 || req.DryRun {
+			return nil
+		}
+		// bbolt can't tell a missing key from one without a value
+		return exercised.Put(key(subName, objName, req.PermissionName), []byte{1})
+	})
+	return domain.AdministrationResp{Error: err}
+}
+
+func (store RHABACRepo) GetInheritanceCycles(ctx context.Context, req domain.GetInheritanceCyclesReq) domain.GetInheritanceCyclesResp {
+	tracer := otel.Tracer("oort.bolt.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.GetInheritanceCycles")
+	defer span.End()
+	var found []domain.InheritanceCycle
+	err := store.view(func(tx *bbolt.Tx) error {
+		relTypes, err := relTypes(tx, nil)
+		if err != nil {
+			return err
+		}
+		found, err = cycles(tx, relTypes)
+		return err
+	})
+	return domain.GetInheritanceCyclesResp{Cycles: found, Error: err}
+}
+
+// GetAncestorAttributes follows the relations that propagate attributes,
+// the distance of an ancestor is the length of the shortest path to it
+func (store RHABACRepo) GetAncestorAttributes(ctx context.Context, req domain.GetAncestorAttributesReq) domain.GetAncestorAttributesResp {
+	tracer := otel.Tracer("oort.bolt.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.GetAncestorAttributes")
+	defer span.End()
+	ancestors := make([]domain.AncestorAttributes, 0)
+	err := store.view(func(tx *bbolt.Tx) error {
+		relTypes, err := relTypes(tx, propagatesAttributes)
+		if err != nil {
+			return err
+		}
+		ancestorDistances, err := distances(tx, req.Resource.Name(), relTypes, unbounded, false)
+		if err != nil {
+			return err
+		}
+		for name, distance := range ancestorDistances {
+			ancestor, err := domain.NewResourceFromName(name)
+			if err != nil {
+				return err
+			}
+			attrs, err := attributes(resource(tx, name))
+			if err != nil {
+				return err
+			}
+			ancestors = append(ancestors, domain.AncestorAttributes{Ancestor: *ancestor, Distance: distance, Attributes: attrs})
+		}
+		return nil
+	})
+	if err != nil {
+		return domain.GetAncestorAttributesResp{Error: err}
+	}
+	sort.Slice(ancestors, func(i, j int) bool {
+		if ancestors[i].Distance != ancestors[j].Distance {
+			return ancestors[i].Distance < ancestors[j].Distance
+		}
+		return ancestors[i].Ancestor.Name() < ancestors[j].Ancestor.Name()
+	})
+	return domain.GetAncestorAttributesResp{Ancestors: ancestors}
+}
+
+// ResolveGraphPredicates resolves every predicate to false if the subject or the object doesn't exist
+func (store RHABACRepo) ResolveGraphPredicates(ctx context.Context, req domain.ResolveGraphPredicatesReq) domain.ResolveGraphPredicatesResp {
+	tracer := otel.Tracer("oort.bolt.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.ResolveGraphPredicates")
+	defer span.End()
+	relations := make(domain.GraphRelations, len(req.Predicates))
+	err := store.view(func(tx *bbolt.Tx) error {
+		operands := map[string]string{
+			domain.GraphOperandSubject: req.Subject.Name(),
+			domain.GraphOperandObject:  req.Object.Name(),
+		}
+		exist := resource(tx, req.Subject.Name()) != nil && resource(tx, req.Object.Name()) != nil
+		for _, predicate := range req.Predicates {
+			relations[predicate] = false
+			if !exist {
+				continue
+			}
+			from, to := operands[predicate.From], operands[predicate.To]
+			var holds bool
+			var err error
+			switch predicate.Function {
+			case domain.RelatedFunction:
+				holds, err = related(tx, from, to, predicate.Argument, predicate.MaxDepth)
+			case domain.ShareAncestorFunction:
+				holds, err = shareAncestor(tx, from, to, predicate.Argument)
+			}
+			if err != nil {
+				return err
+			}
+			relations[predicate] = holds
+		}
+		return nil
+	})
+	if err != nil {
+		return domain.ResolveGraphPredicatesResp{Error: err}
+	}
+	return domain.ResolveGraphPredicatesResp{Relations: relations}
+}
+
+func (store RHABACRepo) PutRelationType(ctx context.Context, req domain.PutRelationTypeReq) domain.AdministrationResp {
+	tracer := otel.Tracer("oort.bolt.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.PutRelationType")
+	defer span.End()
+	err := store.update(func(tx *bbolt.Tx) error {
+		record, err := relationTypeToRecord(req.RelationType)
+		if err != nil {
+			return err
+		}
+		return tx.Bucket(relationTypesBucket).Put([]byte(req.RelationType.Name()), record)
+	})
+	return domain.AdministrationResp{Error: err}
+}
+
+// DeleteRelationType only deletes a relation type no relation uses
+func (store RHABACRepo) DeleteRelationType(ctx context.Context, req domain.DeleteRelationTypeReq) domain.AdministrationResp {
+	tracer := otel.Tracer("oort.bolt.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.DeleteRelationType")
+	defer span.End()
+	err := store.update(func(tx *bbolt.Tx) error {
+		relationTypes := tx.Bucket(relationTypesBucket)
+		if relationTypes.Get([]byte(req.Name)) == nil {
+			return nil
+		}
+		err := tx.Bucket(resourcesBucket).ForEachBucket(func(name []byte) error {
+			return tx.Bucket(resourcesBucket).Bucket(name).Bucket(parentsBucket).ForEach(func(k, v []byte) error {
+				if splitKey(k)[0] == req.Name {
+					return domain.ErrRelationTypeInUse
+				}
+				return nil
+			})
+		})
+		if err != nil {
+			return err
+		}
+		return relationTypes.Delete([]byte(req.Name))
+	})
+	return domain.AdministrationResp{Error: err}
+}
+
+// GetRelationTypes returns INHERITS_FROM first and the defined relation types ordered by name
+func (store RHABACRepo) GetRelationTypes(ctx context.Context, req domain.GetRelationTypesReq) domain.GetRelationTypesResp {
+	tracer := otel.Tracer("oort.bolt.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.GetRelationTypes")
+	defer span.End()
+	relationTypes := []domain.RelationType{domain.InheritsFromRelationType}
+	err := store.view(func(tx *bbolt.Tx) error {
+		return tx.Bucket(relationTypesBucket).ForEach(func(k, v []byte) error {
+			relationType, err := relationTypeFromRecord(v)
+			if err != nil {
+				return err
+			}
+			relationTypes = append(relationTypes, *relationType)
+			return nil
+		})
+	})
+	if err != nil {
+		return domain.GetRelationTypesResp{Error: err}
+	}
+	return domain.GetRelationTypesResp{RelationTypes: relationTypes}
+}
+
+// Batch applies the ops in one transaction, which is rolled back if any of them fails
+func (store RHABACRepo) Batch(ctx context.Context, req domain.BatchReq) domain.BatchResp {
+	tracer := otel.Tracer("oort.bolt.repo")
+	ctx, span := tracer.Start(ctx, "RHABACRepo.Batch")
+	defer span.End()
+	var resp domain.BatchResp
+	err := store.update(func(tx *bbolt.Tx) error {
+		resp = domain.ApplyBatch(ctx, RHABACRepo{db: store.db, tx: tx}, req)
+		return resp.Error
+	})
+	// the ops succeeded but the transaction couldn't be committed
+	if err != nil && resp.Error == nil {
+		return domain.NewBatchResp(len(req.Ops), -1, err)
+	}
+	return resp
+}
@@ -0,0 +1,21 @@
+package bolt
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/c12s/oort/internal/domain"
+	"github.com/c12s/oort/internal/repos/rhabac/repotest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRHABACRepo(t *testing.T) {
+	repotest.Run(t, func(t *testing.T) domain.RHABACRepo {
+		db, err := Open(filepath.Join(t.TempDir(), "oort.db"))
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			_ = db.Close()
+		})
+		return NewRHABACRepo(db)
+	})
+}
@@ -0,0 +1,266 @@
+package bolt
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/c12s/oort/internal/domain"
+	"go.etcd.io/bbolt"
+)
+
+// Every resource has a bucket of its own in the resources bucket, which holds its indexes:
+// its attributes by name, the relations it has to its parents and the ones its children have to it,
+// and the keys of the policies it is the subject or the object of.
+// Relations and policy references are keys without values.
+var (
+	resourcesBucket      = []byte("resources")
+	attributesBucket     = []byte("attributes")
+	parentsBucket        = []byte("parents")
+	childrenBucket       = []byte("children")
+	subjectOfBucket      = []byte("subjectOf")
+	objectOfBucket       = []byte("objectOf")
+	policiesBucket       = []byte("policies")
+	schemasBucket        = []byte("schemas")
+	algorithmsBucket     = []byte("algorithms")
+	implicationsBucket   = []byte("implications")
+	breakGlassesBucket   = []byte("breakGlasses")
+	breakGlassUsesBucket = []byte("breakGlassUses")
+	sodConstraintsBucket = []byte("sodConstraints")
+	exercisedBucket      = []byte("exercised")
+	relationTypesBucket  = []byte("relationTypes")
+)
+
+var resourceBuckets = [][]byte{attributesBucket, parentsBucket, childrenBucket, subjectOfBucket, objectOfBucket}
+
+var topLevelBuckets = [][]byte{resourcesBucket, policiesBucket, schemasBucket, algorithmsBucket, implicationsBucket,
+	breakGlassesBucket, breakGlassUsesBucket, sodConstraintsBucket, exercisedBucket, relationTypesBucket}
+
+// keySeparator can't be part of resource, permission or relation type names,
+// the domain rejects names with NUL characters
+const keySeparator = "\x00"
+
+// Open opens the database file, creating it and its buckets if needed
+func Open(path string) (*bbolt.DB, error) {
+	db, err := bbolt.Open(path, 0600, &bbolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, err
+	}
+	err = db.Update(func(tx *bbolt.Tx) error {
+		for _, name := range topLevelBuckets {
+			if _, err := tx.CreateBucketIfNotExists(name); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+	return db, nil
+}
+
+func key(parts ...string) []byte {
+	return []byte(strings.Join(parts, keySeparator))
+}
+
+func splitKey(k []byte) []string {
+	return strings.Split(string(k), keySeparator)
+}
+
+func policyKey(subject, object, permission string, kind domain.PermissionKind) []byte {
+	return key(subject, object, permission, strconv.Itoa(int(kind)))
+}
+
+func resource(tx *bbolt.Tx, name string) *bbolt.Bucket {
+	return tx.Bucket(resourcesBucket).Bucket([]byte(name))
+}
+
+func mergeResource(tx *bbolt.Tx, name string) (*bbolt.Bucket, error) {
+	r, err := tx.Bucket(resourcesBucket).CreateBucketIfNotExists([]byte(name))
+	if err != nil {
+		return nil, err
+	}
+	for _, index := range resourceBuckets {
+		if _, err := r.CreateBucketIfNotExists(index); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// mergeRooted merges the resource together with its INHERITS_FROM relation to the root
+func mergeRooted(tx *bbolt.Tx, name string) error {
+	if _, err := mergeResource(tx, name); err != nil {
+		return err
+	}
+	if _, err := mergeResource(tx, domain.RootResource.Name()); err != nil {
+		return err
+	}
+	return mergeRelation(tx, name, domain.RootResource.Name(), domain.InheritsFrom)
+}
+
+// mergeRelation relates the child to the parent, both have to exist
+func putAttribute(tx *bbolt.Tx, name string, attr domain.Attribute) error {
+	record, err := attributeToRecord(attr)
+	if err != nil {
+		return err
+	}
+	return resource(tx, name).Bucket(attributesBucket).Put([]byte(attr.Name()), record)
+}
+
+func mergeRelation(tx *bbolt.Tx, child, parent, relType string) error {
+	if err := resource(tx, child).Bucket(parentsBucket).Put(key(relType, parent), nil); err != nil {
+		return err
+	}
+	return resource(tx, parent).Bucket(childrenBucket).Put(key(relType, child), nil)
+}
+
+func deleteRelation(tx *bbolt.Tx, child, parent, relType string) error {
+	c, p := resource(tx, child), resource(tx, parent)
+	if c == nil || p == nil {
+		return nil
+	}
+	if err := c.Bucket(parentsBucket).Delete(key(relType, parent)); err != nil {
+		return err
+	}
+	return p.Bucket(childrenBucket).Delete(key(relType, child))
+}
+
+func putPolicy(tx *bbolt.Tx, req domain.CreatePolicyReq) error {
+	subName, objName := req.SubjectScope.Name(), req.ObjectScope.Name()
+	k := policyKey(subName, objName, req.Permission.Name(), req.Permission.Kind())
+	policies := tx.Bucket(policiesBucket)
+	// recreating a policy keeps its position
+	var seq uint64
+	if record := policies.Get(k); record != nil {
+		stored, err := policyFromRecord(record)
+		if err != nil {
+			return err
+		}
+		seq = stored.Seq
+	} else {
+		next, err := policies.NextSequence()
+		if err != nil {
+			return err
+		}
+		seq = next
+	}
+	record, err := policyToRecord(seq, subName, objName, req.Permission, req.Validity)
+	if err != nil {
+		return err
+	}
+	if err := policies.Put(k, record); err != nil {
+		return err
+	}
+	if err := resource(tx, subName).Bucket(subjectOfBucket).Put(k, nil); err != nil {
+		return err
+	}
+	return resource(tx, objName).Bucket(objectOfBucket).Put(k, nil)
+}
+
+func deletePolicy(tx *bbolt.Tx, k []byte) error {
+	record := tx.Bucket(policiesBucket).Get(k)
+	if record == nil {
+		return nil
+	}
+	stored, err := policyFromRecord(record)
+	if err != nil {
+		return err
+	}
+	if sub := resource(tx, stored.Subject); sub != nil {
+		if err := sub.Bucket(subjectOfBucket).Delete(k); err != nil {
+			return err
+		}
+	}
+	if obj := resource(tx, stored.Object); obj != nil {
+		if err := obj.Bucket(objectOfBucket).Delete(k); err != nil {
+			return err
+		}
+	}
+	return tx.Bucket(policiesBucket).Delete(k)
+}
+
+// policies returns the stored policies with the given keys, in the order they were created
+func policies(tx *bbolt.Tx, keys [][]byte) ([]storedPolicy, error) {
+	policies := make([]storedPolicy, 0, len(keys))
+	for _, k := range keys {
+		record := tx.Bucket(policiesBucket).Get(k)
+		if record == nil {
+			return nil, errors.New("invalid index - policy missing")
+		}
+		stored, err := policyFromRecord(record)
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, *stored)
+	}
+	sortPolicies(policies)
+	return policies, nil
+}
+
+func sortPolicies(policies []storedPolicy) {
+	sort.Slice(policies, func(i, j int) bool {
+		return policies[i].Seq < policies[j].Seq
+	})
+}
+
+func allPolicies(tx *bbolt.Tx) ([]storedPolicy, error) {
+	policies := make([]storedPolicy, 0)
+	err := tx.Bucket(policiesBucket).ForEach(func(k, v []byte) error {
+		stored, err := policyFromRecord(v)
+		if err != nil {
+			return err
+		}
+		policies = append(policies, *stored)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sortPolicies(policies)
+	return policies, nil
+}
+
+// keys copies the keys of the bucket, keys returned by bbolt are only valid during the transaction
+// and can't be used while the bucket is modified
+func keys(b *bbolt.Bucket) [][]byte {
+	keys := make([][]byte, 0)
+	_ = b.ForEach(func(k, v []byte) error {
+		keys = append(keys, append([]byte(nil), k...))
+		return nil
+	})
+	return keys
+}
+
+func implications(tx *bbolt.Tx) ([]domain.PermissionImplication, error) {
+	implications := make([]domain.PermissionImplication, 0)
+	for _, k := range keys(tx.Bucket(implicationsBucket)) {
+		parts := splitKey(k)
+		if len(parts) != 2 {
+			return nil, errors.New("invalid key format - implication")
+		}
+		implication, err := domain.NewPermissionImplication(parts[0], parts[1])
+		if err != nil {
+			return nil, err
+		}
+		implications = append(implications, *implication)
+	}
+	return implications, nil
+}
+
+func attributes(r *bbolt.Bucket) ([]domain.Attribute, error) {
+	attrs := make([]domain.Attribute, 0)
+	err := r.Bucket(attributesBucket).ForEach(func(k, v []byte) error {
+		attr, err := attributeFromRecord(v)
+		if err != nil {
+			return err
+		}
+		attrs = append(attrs, *attr)
+		return nil
+	})
+	return attrs, err
+}
@@ -0,0 +1,348 @@
+package memory
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/c12s/oort/internal/domain"
+)
+
+// unbounded lets a traversal follow any number of relations
+const unbounded = -1
+
+// edge leaves the related resource, following it leads to the resource it is related to
+type edge struct {
+	parent  string
+	relType string
+}
+
+// relTypes returns the names of the relation types that pass the filter, INHERITS_FROM is always included
+func (store *RHABACRepo) relTypes(filter func(domain.RelationType) bool) map[string]bool {
+	relTypes := map[string]bool{domain.InheritsFrom: true}
+	for name, relationType := range store.relationTypes {
+		if filter == nil || filter(relationType) {
+			relTypes[name] = true
+		}
+	}
+	return relTypes
+}
+
+func propagatesPermissions(relationType domain.RelationType) bool {
+	return relationType.PropagatesPermissions()
+}
+
+func propagatesAttributes(relationType domain.RelationType) bool {
+	return relationType.PropagatesAttributes()
+}
+
+// distances returns the length of the shortest path from the resource to every resource it reaches,
+// the resource itself included, following at most maxDepth relations of the given types
+func (store *RHABACRepo) distances(name string, relTypes map[string]bool, maxDepth int) map[string]int {
+	distances := make(map[string]int)
+	if _, ok := store.resources[name]; !ok {
+		return distances
+	}
+	distances[name] = 0
+	queue := []string{name}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		if maxDepth != unbounded && distances[current] >= maxDepth {
+			continue
+		}
+		for e := range store.resources[current].edges {
+			if _, visited := distances[e.parent]; visited || !relTypes[e.relType] {
+				continue
+			}
+			distances[e.parent] = distances[current] + 1
+			queue = append(queue, e.parent)
+		}
+	}
+	return distances
+}
+
+// ancestorDistances is like distances, but only counts paths of at least one relation,
+// so the resource itself is only included if it is part of a cycle
+func (store *RHABACRepo) ancestorDistances(name string, relTypes map[string]bool) map[string]int {
+	distances := make(map[string]int)
+	r, ok := store.resources[name]
+	if !ok {
+		return distances
+	}
+	queue := make([]string, 0)
+	for e := range r.edges {
+		if _, visited := distances[e.parent]; visited || !relTypes[e.relType] {
+			continue
+		}
+		distances[e.parent] = 1
+		queue = append(queue, e.parent)
+	}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for e := range store.resources[current].edges {
+			if _, visited := distances[e.parent]; visited || !relTypes[e.relType] {
+				continue
+			}
+			distances[e.parent] = distances[current] + 1
+			queue = append(queue, e.parent)
+		}
+	}
+	return distances
+}
+
+func (store *RHABACRepo) reaches(from, to string, relTypes map[string]bool) bool {
+	_, ok := store.distances(from, relTypes, unbounded)[to]
+	return ok
+}
+
+// children indexes the relations of the given types in the opposite direction
+func (store *RHABACRepo) children(relTypes map[string]bool) map[string][]string {
+	children := make(map[string][]string)
+	for name, r := range store.resources {
+		for e := range r.edges {
+			if relTypes[e.relType] {
+				children[e.parent] = append(children[e.parent], name)
+			}
+		}
+	}
+	return children
+}
+
+// descendants returns the resource and all resources that reach it through the indexed relations
+func (store *RHABACRepo) descendants(name string, children map[string][]string) []string {
+	if _, ok := store.resources[name]; !ok {
+		return nil
+	}
+	visited := map[string]bool{name: true}
+	descendants := []string{name}
+	for i := 0; i < len(descendants); i++ {
+		for _, child := range children[descendants[i]] {
+			if !visited[child] {
+				visited[child] = true
+				descendants = append(descendants, child)
+			}
+		}
+	}
+	return descendants
+}
+
+// longestPath returns the length of the longest path leaving the resource through the given neighbours,
+// resources already on the path aren't visited again, so cycles can't make it loop
+func longestPath(name string, neighbours func(string) []string, memo map[string]int, onPath map[string]bool) int {
+	if length, ok := memo[name]; ok {
+		return length
+	}
+	onPath[name] = true
+	longest := 0
+	for _, neighbour := range neighbours(name) {
+		if onPath[neighbour] {
+			continue
+		}
+		if length := longestPath(neighbour, neighbours, memo, onPath) + 1; length > longest {
+			longest = length
+		}
+	}
+	delete(onPath, name)
+	memo[name] = longest
+	return longest
+}
+
+// depthThrough returns the length of the longest chain of relations of the given types
+// that would pass through a new relation of from to to
+func (store *RHABACRepo) depthThrough(from, to string, relTypes map[string]bool) int {
+	children := store.children(relTypes)
+	below := longestPath(to, func(name string) []string {
+		return children[name]
+	}, make(map[string]int), make(map[string]bool))
+	above := longestPath(from, func(name string) []string {
+		parents := make([]string, 0)
+		for e := range store.resources[name].edges {
+			if relTypes[e.relType] {
+				parents = append(parents, e.parent)
+			}
+		}
+		return parents
+	}, make(map[string]int), make(map[string]bool))
+	return below + 1 + above
+}
+
+// cycles returns every cycle of relations of the given types once, starting from its resource with the lowest name,
+// a cycle is reported once per distinct sequence of relations, like ncGetInheritanceCyclesCypher does
+func (store *RHABACRepo) cycles(relTypes map[string]bool) []domain.InheritanceCycle {
+	names := make([]string, 0, len(store.resources))
+	for name := range store.resources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	cycles := make([]domain.InheritanceCycle, 0)
+	for _, start := range names {
+		var visit func(path []string)
+		visit = func(path []string) {
+			for e := range store.resources[path[len(path)-1]].edges {
+				if !relTypes[e.relType] || e.parent < start {
+					continue
+				}
+				if e.parent == start {
+					cycles = append(cycles, domain.InheritanceCycle{Resources: resourcesFromNames(path)})
+					continue
+				}
+				if contains(path, e.parent) {
+					continue
+				}
+				visit(append(path[:len(path):len(path)], e.parent))
+			}
+		}
+		visit([]string{start})
+	}
+	return cycles
+}
+
+// related reports whether to can be reached from from by following between one and maxDepth relations of the type
+func (store *RHABACRepo) related(from, to, relType string, maxDepth int) bool {
+	distance, ok := store.ancestorDistances(from, map[string]bool{relType: true})[to]
+	return ok && distance <= maxDepth
+}
+
+// shareAncestor reports whether both resources are related to a resource of the kind
+// through relations that propagate permissions
+func (store *RHABACRepo) shareAncestor(from, to, kind string) bool {
+	relTypes := store.relTypes(propagatesPermissions)
+	toAncestors := store.ancestorDistances(to, relTypes)
+	for ancestor := range store.ancestorDistances(from, relTypes) {
+		if _, ok := toAncestors[ancestor]; ok && hasKind(ancestor, kind) {
+			return true
+		}
+	}
+	return false
+}
+
+type sodScope struct {
+	subject,
+	object string
+}
+
+type subjectObject struct {
+	subject,
+	object string
+}
+
+// sodViolation follows ncVerifySoDCypher, it returns the first subject that holds allow policies
+// granting more than one permission of a constraint on the same object,
+// considering only subjects and objects that descend from one of the scopes
+func (store *RHABACRepo) sodViolation(constraints []domain.SoDConstraint, scopes []sodScope, now time.Time) error {
+	children := store.children(store.relTypes(propagatesPermissions))
+	allRelTypes := store.relTypes(nil)
+	inScopes := func(held subjectObject) bool {
+		for _, scope := range scopes {
+			if store.reaches(held.subject, scope.subject, allRelTypes) && store.reaches(held.object, scope.object, allRelTypes) {
+				return true
+			}
+		}
+		return false
+	}
+	for _, constraint := range constraints {
+		granted := make(map[subjectObject]map[int]bool)
+		for i, names := range constraint.GrantingNames() {
+			grantingNames := toSet(append(names, domain.ImplyingPermissions(store.implications, names)...))
+			for _, p := range store.policies {
+				if p.permission.Kind() != domain.PermissionKindAllow || !grantingNames[p.key.permission] || p.validity.Expired(now) {
+					continue
+				}
+				for _, sub := range store.descendants(p.key.subject, children) {
+					for _, obj := range store.descendants(p.key.object, children) {
+						held := subjectObject{subject: sub, object: obj}
+						if granted[held] == nil {
+							if !inScopes(held) {
+								continue
+							}
+							granted[held] = make(map[int]bool)
+						}
+						granted[held][i] = true
+					}
+				}
+			}
+		}
+		violations := make([]subjectObject, 0)
+		for held, permissions := range granted {
+			if len(permissions) > 1 {
+				violations = append(violations, held)
+			}
+		}
+		if len(violations) == 0 {
+			continue
+		}
+		sort.Slice(violations, func(i, j int) bool {
+			if violations[i].subject != violations[j].subject {
+				return violations[i].subject < violations[j].subject
+			}
+			return violations[i].object < violations[j].object
+		})
+		return sodViolationError(constraint.Name(), violations[0].subject, violations[0].object)
+	}
+	return nil
+}
+
+func sodViolationError(constraint, subName, objName string) error {
+	subject, err := domain.NewResourceFromName(subName)
+	if err != nil {
+		return err
+	}
+	object, err := domain.NewResourceFromName(objName)
+	if err != nil {
+		return err
+	}
+	return domain.SoDViolation{Constraint: constraint, Subject: *subject, Object: *object}
+}
+
+// impliedPermissions returns the names the permission transitively implies
+func (store *RHABACRepo) impliedPermissions(name string) []string {
+	visited := map[string]bool{name: true}
+	implied := make([]string, 0)
+	queue := []string{name}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, implication := range store.implications {
+			if implication.Permission() != current || visited[implication.ImpliedPermission()] {
+				continue
+			}
+			visited[implication.ImpliedPermission()] = true
+			implied = append(implied, implication.ImpliedPermission())
+			queue = append(queue, implication.ImpliedPermission())
+		}
+	}
+	return implied
+}
+
+func resourcesFromNames(names []string) []domain.Resource {
+	resources := make([]domain.Resource, 0, len(names))
+	for _, name := range names {
+		if resource, err := domain.NewResourceFromName(name); err == nil {
+			resources = append(resources, *resource)
+		}
+	}
+	return resources
+}
+
+func hasKind(name, kind string) bool {
+	return strings.HasPrefix(name, kind+"/")
+}
+
+func toSet(values []string) map[string]bool {
+	set := make(map[string]bool, len(values))
+	for _, value := range values {
+		set[value] = true
+	}
+	return set
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
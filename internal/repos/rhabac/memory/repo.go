@@ -0,0 +1,891 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/c12s/oort/internal/domain"
+	"go.opentelemetry.io/otel"
+)
+
+type resource struct {
+	attributes map[string]domain.Attribute
+	edges      map[edge]bool
+}
+
+// policyKey identifies a policy the way ncCreatePermissionCypher merges it
+type policyKey struct {
+	subject,
+	object,
+	permission string
+	kind domain.PermissionKind
+}
+
+type policy struct {
+	key        policyKey
+	permission domain.Permission
+	validity   domain.Validity
+}
+
+type exercise struct {
+	subject,
+	object,
+	permission string
+}
+
+// RHABACRepo keeps the resource graph in memory and answers every query
+// the way the neo4j repo's cyphers do, it is meant for development and tests
+type RHABACRepo struct {
+	mu             sync.RWMutex
+	resources      map[string]*resource
+	policies       []policy
+	schemas        map[string]domain.AttributeSchema
+	algorithms     map[string]domain.CombiningAlgorithm
+	implications   []domain.PermissionImplication
+	breakGlasses   []domain.BreakGlass
+	breakGlassUses []domain.BreakGlassUse
+	sodConstraints map[string]domain.SoDConstraint
+	exercised      map[exercise]bool
+	relationTypes  map[string]domain.RelationType
+}
+
+func NewRHABACRepo() domain.RHABACRepo {
+	return &RHABACRepo{
+		resources:      make(map[string]*resource),
+		policies:       make([]policy, 0),
+		schemas:        make(map[string]domain.AttributeSchema),
+		algorithms:     make(map[string]domain.CombiningAlgorithm),
+		implications:   make([]domain.PermissionImplication, 0),
+		breakGlasses:   make([]domain.BreakGlass, 0),
+		breakGlassUses: make([]domain.BreakGlassUse, 0),
+		sodConstraints: make(map[string]domain.SoDConstraint),
+		exercised:      make(map[exercise]bool),
+		relationTypes:  make(map[string]domain.RelationType),
+	}
+}
+
+// changes records what a verified mutation merged, so that it can be undone if the verification fails
+type changes struct {
+	resources []string
+	edges     map[string][]edge
+}
+
+func (store *RHABACRepo) mergeResource(name string, c *changes) {
+	if _, ok := store.resources[name]; ok {
+		return
+	}
+	store.resources[name] = &resource{
+		attributes: make(map[string]domain.Attribute),
+		edges:      make(map[edge]bool),
+	}
+	c.resources = append(c.resources, name)
+}
+
+func (store *RHABACRepo) mergeEdge(name string, e edge, c *changes) {
+	r := store.resources[name]
+	if r.edges[e] {
+		return
+	}
+	r.edges[e] = true
+	if c.edges == nil {
+		c.edges = make(map[string][]edge)
+	}
+	c.edges[name] = append(c.edges[name], e)
+}
+
+// mergeRooted merges the resource together with its INHERITS_FROM relation to the root
+func (store *RHABACRepo) mergeRooted(name string, c *changes) {
+	rootName := domain.RootResource.Name()
+	store.mergeResource(name, c)
+	store.mergeResource(rootName, c)
+	store.mergeEdge(name, edge{parent: rootName, relType: domain.InheritsFrom}, c)
+}
+
+func (store *RHABACRepo) undo(c changes) {
+	for name, edges := range c.edges {
+		if r, ok := store.resources[name]; ok {
+			for _, e := range edges {
+				delete(r.edges, e)
+			}
+		}
+	}
+	for _, name := range c.resources {
+		delete(store.resources, name)
+	}
+}
+
+func (store *RHABACRepo) CreateResource(ctx context.Context, req domain.CreateResourceReq) domain.AdministrationResp {
+	tracer := otel.Tracer("oort.memory.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.CreateResource")
+	defer span.End()
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	name := req.Resource.Name()
+	store.mergeRooted(name, &changes{})
+	for _, attr := range req.Resource.Attributes {
+		store.resources[name].attributes[attr.Name()] = attr
+	}
+	return domain.AdministrationResp{}
+}
+
+// DeleteResource removes the resource, its attributes and the policies it is the subject or the object of
+func (store *RHABACRepo) DeleteResource(ctx context.Context, req domain.DeleteResourceReq) domain.AdministrationResp {
+	tracer := otel.Tracer("oort.memory.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.DeleteResource")
+	defer span.End()
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	name := req.Resource.Name()
+	if _, ok := store.resources[name]; !ok {
+		return domain.AdministrationResp{}
+	}
+	policies := make([]policy, 0, len(store.policies))
+	for _, p := range store.policies {
+		if p.key.subject != name && p.key.object != name {
+			policies = append(policies, p)
+		}
+	}
+	store.policies = policies
+	delete(store.resources, name)
+	for _, r := range store.resources {
+		for e := range r.edges {
+			if e.parent == name {
+				delete(r.edges, e)
+			}
+		}
+	}
+	return domain.AdministrationResp{}
+}
+
+func (store *RHABACRepo) GetResource(ctx context.Context, req domain.GetResourceReq) domain.GetResourceResp {
+	tracer := otel.Tracer("oort.memory.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.GetResource")
+	defer span.End()
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	r, ok := store.resources[req.Resource.Name()]
+	if !ok {
+		return domain.GetResourceResp{Error: errors.New("resource not found")}
+	}
+	// like the neo4j mapper, only the attributes of the resource are returned
+	resource, err := domain.NewResource("", "")
+	if err != nil {
+		return domain.GetResourceResp{Error: err}
+	}
+	resource.Attributes = sortedAttributes(r.attributes)
+	return domain.GetResourceResp{Resource: resource}
+}
+
+func (store *RHABACRepo) PutAttribute(ctx context.Context, req domain.PutAttributeReq) domain.AdministrationResp {
+	tracer := otel.Tracer("oort.memory.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.PutAttribute")
+	defer span.End()
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	name := req.Resource.Name()
+	store.mergeRooted(name, &changes{})
+	store.resources[name].attributes[req.Attribute.Name()] = req.Attribute
+	return domain.AdministrationResp{}
+}
+
+func (store *RHABACRepo) DeleteAttribute(ctx context.Context, req domain.DeleteAttributeReq) domain.AdministrationResp {
+	tracer := otel.Tracer("oort.memory.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.DeleteAttribute")
+	defer span.End()
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	if r, ok := store.resources[req.Resource.Name()]; ok {
+		delete(r.attributes, req.AttributeId.Name())
+	}
+	return domain.AdministrationResp{}
+}
+
+// CreateRelation makes the same checks as ncCreateRelationCypher,
+// the merged resources and the relation are undone if the separation of duty verification fails
+func (store *RHABACRepo) CreateRelation(ctx context.Context, req domain.CreateRelationReq) domain.AdministrationResp {
+	tracer := otel.Tracer("oort.memory.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.CreateRelation")
+	defer span.End()
+	if err := domain.ValidateRelationTypeName(req.Type); err != nil {
+		return domain.AdministrationResp{Error: err}
+	}
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	fromName, toName := req.From.Name(), req.To.Name()
+	c := changes{}
+	store.mergeRooted(fromName, &c)
+	store.mergeRooted(toName, &c)
+	relTypes := store.relTypes(nil)
+	if !relTypes[req.Type] {
+		store.undo(c)
+		return domain.AdministrationResp{Error: domain.ErrUnknownRelationType}
+	}
+	if store.reaches(fromName, toName, relTypes) {
+		store.undo(c)
+		return domain.AdministrationResp{Error: domain.ErrInheritanceCycle}
+	}
+	if depth := store.depthThrough(fromName, toName, relTypes); depth > req.MaxDepth {
+		store.undo(c)
+		return domain.AdministrationResp{Error: fmt.Errorf("%w: %d > %d", domain.ErrInheritanceDepth, depth, req.MaxDepth)}
+	}
+	store.mergeEdge(toName, edge{parent: fromName, relType: req.Type}, &c)
+	if len(req.SoDConstraints) > 0 {
+		// the new relationship changes what descendants of to hold, both as subjects and as objects
+		rootName := domain.RootResource.Name()
+		scopes := []sodScope{
+			{subject: toName, object: rootName},
+			{subject: rootName, object: toName},
+		}
+		if err := store.sodViolation(req.SoDConstraints, scopes, time.Now()); err != nil {
+			store.undo(c)
+			return domain.AdministrationResp{Error: err}
+		}
+	}
+	return domain.AdministrationResp{}
+}
+
+func (store *RHABACRepo) DeleteRelation(ctx context.Context, req domain.DeleteRelationReq) domain.AdministrationResp {
+	tracer := otel.Tracer("oort.memory.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.DeleteRelation")
+	defer span.End()
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	if r, ok := store.resources[req.To.Name()]; ok {
+		delete(r.edges, edge{parent: req.From.Name(), relType: req.Type})
+	}
+	return domain.AdministrationResp{}
+}
+
+func (store *RHABACRepo) CreatePolicy(ctx context.Context, req domain.CreatePolicyReq) domain.AdministrationResp {
+	tracer := otel.Tracer("oort.memory.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.CreatePolicy")
+	defer span.End()
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	subName, objName := req.SubjectScope.Name(), req.ObjectScope.Name()
+	c := changes{}
+	store.mergeRooted(subName, &c)
+	store.mergeRooted(objName, &c)
+	created := policy{
+		key: policyKey{
+			subject:    subName,
+			object:     objName,
+			permission: req.Permission.Name(),
+			kind:       req.Permission.Kind(),
+		},
+		permission: req.Permission,
+		validity:   req.Validity,
+	}
+	previous := store.policies
+	store.policies = make([]policy, 0, len(previous)+1)
+	replaced := false
+	for _, p := range previous {
+		if p.key == created.key {
+			p = created
+			replaced = true
+		}
+		store.policies = append(store.policies, p)
+	}
+	if !replaced {
+		store.policies = append(store.policies, created)
+	}
+	if len(req.SoDConstraints) > 0 {
+		scopes := []sodScope{{subject: subName, object: objName}}
+		if err := store.sodViolation(req.SoDConstraints, scopes, time.Now()); err != nil {
+			store.policies = previous
+			store.undo(c)
+			return domain.AdministrationResp{Error: err}
+		}
+	}
+	return domain.AdministrationResp{}
+}
+
+func (store *RHABACRepo) DeletePolicy(ctx context.Context, req domain.DeletePolicyReq) domain.AdministrationResp {
+	tracer := otel.Tracer("oort.memory.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.DeletePolicy")
+	defer span.End()
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	key := policyKey{
+		subject:    req.SubjectScope.Name(),
+		object:     req.ObjectScope.Name(),
+		permission: req.Permission.Name(),
+		kind:       req.Permission.Kind(),
+	}
+	policies := make([]policy, 0, len(store.policies))
+	for _, p := range store.policies {
+		if p.key != key {
+			policies = append(policies, p)
+		}
+	}
+	store.policies = policies
+	return domain.AdministrationResp{}
+}
+
+// GetPermissionHierarchy ranks the policies like ncGetPermissionsCypher,
+// by the shortest paths of relations that propagate permissions from the subject and the object to the policy's resources.
+// A policy reached through several paths is added once, in the order the policies were created.
+func (store *RHABACRepo) GetPermissionHierarchy(ctx context.Context, req domain.GetPermissionHierarchyReq) domain.GetPermissionHierarchyResp {
+	tracer := otel.Tracer("oort.memory.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.GetPermissionHierarchy")
+	defer span.End()
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	relTypes := store.relTypes(propagatesPermissions)
+	subDistances := store.distances(req.Subject.Name(), relTypes, unbounded)
+	objDistances := store.distances(req.Object.Name(), relTypes, unbounded)
+	names := domain.PermissionNameCandidates(req.PermissionName)
+	permNames := toSet(names)
+	implyingNames := toSet(domain.ImplyingPermissions(store.implications, names))
+	now := time.Now()
+
+	hierarchy := make(domain.PermissionHierarchy)
+	for _, p := range store.policies {
+		if !permNames[p.key.permission] && !(p.key.kind == domain.PermissionKindAllow && implyingNames[p.key.permission]) {
+			continue
+		}
+		if !p.validity.Active(now) {
+			continue
+		}
+		subDistance, ok := subDistances[p.key.subject]
+		if !ok {
+			continue
+		}
+		objDistance, ok := objDistances[p.key.object]
+		if !ok {
+			continue
+		}
+		subPriority := domain.PermissionPriority(-subDistance)
+		objPriority := domain.PermissionPriority(-objDistance)
+		if _, ok := hierarchy[subPriority]; !ok {
+			hierarchy[subPriority] = make(domain.PermissionObjHierarchy)
+		}
+		hierarchy[subPriority][objPriority] = append(hierarchy[subPriority][objPriority], p.permission)
+	}
+	return domain.GetPermissionHierarchyResp{Hierarchy: hierarchy}
+}
+
+// GetAuthorizationData reads the data under one read lock, so that all of it is consistent
+func (store *RHABACRepo) GetAuthorizationData(ctx context.Context, req domain.GetAuthorizationDataReq) domain.GetAuthorizationDataResp {
+	tracer := otel.Tracer("oort.memory.repo")
+	ctx, span := tracer.Start(ctx, "RHABACRepo.GetAuthorizationData")
+	defer span.End()
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	// the view has a lock of its own, so its methods don't wait for the one held here
+	return domain.ReadAuthorizationData(ctx, store.view(), req)
+}
+
+// GetApplicablePolicies returns the distinct permission names the subject holds on each object,
+// allow policies also grant the names their permission implies
+func (store *RHABACRepo) GetApplicablePolicies(ctx context.Context, req domain.GetApplicablePoliciesReq) domain.GetApplicablePoliciesResp {
+	tracer := otel.Tracer("oort.memory.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.GetApplicablePolicies")
+	defer span.End()
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	relTypes := store.relTypes(propagatesPermissions)
+	subDistances := store.distances(req.Subject.Name(), relTypes, unbounded)
+	children := store.children(relTypes)
+	now := time.Now()
+
+	policies := make([]domain.Policy, 0)
+	type permissionObject struct {
+		permission,
+		object string
+	}
+	seen := make(map[permissionObject]bool)
+	for _, p := range store.policies {
+		if _, ok := subDistances[p.key.subject]; !ok || !p.validity.Active(now) {
+			continue
+		}
+		permNames := []string{p.key.permission}
+		if p.key.kind == domain.PermissionKindAllow {
+			permNames = append(permNames, store.impliedPermissions(p.key.permission)...)
+		}
+		for _, objName := range store.descendants(p.key.object, children) {
+			object, err := domain.NewResourceFromName(objName)
+			if err != nil {
+				return domain.GetApplicablePoliciesResp{Error: err}
+			}
+			for _, permName := range permNames {
+				if key := (permissionObject{permission: permName, object: objName}); !seen[key] {
+					seen[key] = true
+					policies = append(policies, domain.Policy{PermissionName: permName, Object: *object})
+				}
+			}
+		}
+	}
+	return domain.GetApplicablePoliciesResp{Policies: policies}
+}
+
+func (store *RHABACRepo) PutAttributeSchema(ctx context.Context, req domain.PutAttributeSchemaReq) domain.AdministrationResp {
+	tracer := otel.Tracer("oort.memory.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.PutAttributeSchema")
+	defer span.End()
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	store.schemas[req.Schema.ResourceKind()] = req.Schema
+	return domain.AdministrationResp{}
+}
+
+func (store *RHABACRepo) DeleteAttributeSchema(ctx context.Context, req domain.DeleteAttributeSchemaReq) domain.AdministrationResp {
+	tracer := otel.Tracer("oort.memory.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.DeleteAttributeSchema")
+	defer span.End()
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	delete(store.schemas, req.ResourceKind)
+	return domain.AdministrationResp{}
+}
+
+func (store *RHABACRepo) GetAttributeSchema(ctx context.Context, req domain.GetAttributeSchemaReq) domain.GetAttributeSchemaResp {
+	tracer := otel.Tracer("oort.memory.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.GetAttributeSchema")
+	defer span.End()
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	schema, ok := store.schemas[req.ResourceKind]
+	if !ok {
+		return domain.GetAttributeSchemaResp{}
+	}
+	return domain.GetAttributeSchemaResp{Schema: &schema}
+}
+
+func (store *RHABACRepo) SetCombiningAlgorithm(ctx context.Context, req domain.SetCombiningAlgorithmReq) domain.AdministrationResp {
+	tracer := otel.Tracer("oort.memory.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.SetCombiningAlgorithm")
+	defer span.End()
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	store.algorithms[req.PermissionName] = req.Algorithm
+	return domain.AdministrationResp{}
+}
+
+func (store *RHABACRepo) GetCombiningAlgorithm(ctx context.Context, req domain.GetCombiningAlgorithmReq) domain.GetCombiningAlgorithmResp {
+	tracer := otel.Tracer("oort.memory.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.GetCombiningAlgorithm")
+	defer span.End()
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	algorithm, ok := store.algorithms[req.PermissionName]
+	if !ok {
+		algorithm = domain.DefaultCombiningAlgorithm
+	}
+	return domain.GetCombiningAlgorithmResp{Algorithm: algorithm}
+}
+
+func (store *RHABACRepo) CreatePermissionImplication(ctx context.Context, req domain.CreatePermissionImplicationReq) domain.AdministrationResp {
+	tracer := otel.Tracer("oort.memory.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.CreatePermissionImplication")
+	defer span.End()
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	if domain.CreatesImplicationCycle(store.implications, req.Implication) {
+		return domain.AdministrationResp{Error: domain.ErrImplicationCycle}
+	}
+	for _, implication := range store.implications {
+		if implication == req.Implication {
+			return domain.AdministrationResp{}
+		}
+	}
+	store.implications = append(store.implications, req.Implication)
+	return domain.AdministrationResp{}
+}
+
+func (store *RHABACRepo) DeletePermissionImplication(ctx context.Context, req domain.DeletePermissionImplicationReq) domain.AdministrationResp {
+	tracer := otel.Tracer("oort.memory.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.DeletePermissionImplication")
+	defer span.End()
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	implications := make([]domain.PermissionImplication, 0, len(store.implications))
+	for _, implication := range store.implications {
+		if implication != req.Implication {
+			implications = append(implications, implication)
+		}
+	}
+	store.implications = implications
+	return domain.AdministrationResp{}
+}
+
+func (store *RHABACRepo) DeleteExpiredPolicies(ctx context.Context, req domain.DeleteExpiredPoliciesReq) domain.DeleteExpiredPoliciesResp {
+	tracer := otel.Tracer("oort.memory.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.DeleteExpiredPolicies")
+	defer span.End()
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	remaining := make([]policy, 0, len(store.policies))
+	expired := make([]domain.Policy, 0)
+	for _, p := range store.policies {
+		if !p.validity.Expired(req.Now) {
+			remaining = append(remaining, p)
+			continue
+		}
+		subject, err := domain.NewResourceFromName(p.key.subject)
+		if err != nil {
+			return domain.DeleteExpiredPoliciesResp{Error: err}
+		}
+		object, err := domain.NewResourceFromName(p.key.object)
+		if err != nil {
+			return domain.DeleteExpiredPoliciesResp{Error: err}
+		}
+		expired = append(expired, domain.Policy{
+			PermissionName: p.key.permission,
+			Subject:        *subject,
+			Object:         *object,
+		})
+	}
+	store.policies = remaining
+	return domain.DeleteExpiredPoliciesResp{Policies: expired}
+}
+
+func (store *RHABACRepo) CreateBreakGlass(ctx context.Context, req domain.CreateBreakGlassReq) domain.AdministrationResp {
+	tracer := otel.Tracer("oort.memory.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.CreateBreakGlass")
+	defer span.End()
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	store.breakGlasses = append(store.breakGlasses, req.BreakGlass)
+	return domain.AdministrationResp{}
+}
+
+func (store *RHABACRepo) GetActiveBreakGlass(ctx context.Context, req domain.GetActiveBreakGlassReq) domain.GetActiveBreakGlassResp {
+	tracer := otel.Tracer("oort.memory.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.GetActiveBreakGlass")
+	defer span.End()
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	var active *domain.BreakGlass
+	for i, breakGlass := range store.breakGlasses {
+		if breakGlass.Subject().Name() != req.Subject.Name() || breakGlass.Object().Name() != req.Object.Name() ||
+			breakGlass.PermissionName() != req.PermissionName || !breakGlass.Active(req.Now) {
+			continue
+		}
+		if active == nil || breakGlass.ExpiresAt().After(active.ExpiresAt()) {
+			active = &store.breakGlasses[i]
+		}
+	}
+	if active == nil {
+		return domain.GetActiveBreakGlassResp{}
+	}
+	breakGlass := *active
+	return domain.GetActiveBreakGlassResp{BreakGlass: &breakGlass}
+}
+
+func (store *RHABACRepo) CreateBreakGlassUse(ctx context.Context, req domain.CreateBreakGlassUseReq) domain.AdministrationResp {
+	tracer := otel.Tracer("oort.memory.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.CreateBreakGlassUse")
+	defer span.End()
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	store.breakGlassUses = append(store.breakGlassUses, req.Use)
+	return domain.AdministrationResp{}
+}
+
+func (store *RHABACRepo) GetBreakGlassUses(ctx context.Context, req domain.GetBreakGlassUsesReq) domain.GetBreakGlassUsesResp {
+	tracer := otel.Tracer("oort.memory.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.GetBreakGlassUses")
+	defer span.End()
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	uses := make([]domain.BreakGlassUse, 0)
+	for _, use := range store.breakGlassUses {
+		if use.BreakGlassId() == req.BreakGlassId {
+			uses = append(uses, use)
+		}
+	}
+	sort.SliceStable(uses, func(i, j int) bool {
+		return uses[i].UsedAt().Before(uses[j].UsedAt())
+	})
+	return domain.GetBreakGlassUsesResp{Uses: uses}
+}
+
+// CreateSoDConstraint only accepts a static constraint if no subject violates it already
+func (store *RHABACRepo) CreateSoDConstraint(ctx context.Context, req domain.CreateSoDConstraintReq) domain.AdministrationResp {
+	tracer := otel.Tracer("oort.memory.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.CreateSoDConstraint")
+	defer span.End()
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	if req.Constraint.Kind() == domain.SoDStatic {
+		rootName := domain.RootResource.Name()
+		scopes := []sodScope{{subject: rootName, object: rootName}}
+		if err := store.sodViolation([]domain.SoDConstraint{req.Constraint}, scopes, time.Now()); err != nil {
+			return domain.AdministrationResp{Error: err}
+		}
+	}
+	store.sodConstraints[req.Constraint.Name()] = req.Constraint
+	return domain.AdministrationResp{}
+}
+
+func (store *RHABACRepo) DeleteSoDConstraint(ctx context.Context, req domain.DeleteSoDConstraintReq) domain.AdministrationResp {
+	tracer := otel.Tracer("oort.memory.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.DeleteSoDConstraint")
+	defer span.End()
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	delete(store.sodConstraints, req.Name)
+	return domain.AdministrationResp{}
+}
+
+func (store *RHABACRepo) GetSoDConstraints(ctx context.Context, req domain.GetSoDConstraintsReq) domain.GetSoDConstraintsResp {
+	tracer := otel.Tracer("oort.memory.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.GetSoDConstraints")
+	defer span.End()
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	constraints := make([]domain.SoDConstraint, 0)
+	for _, constraint := range store.sodConstraints {
+		if constraint.Kind() == req.Kind && (req.PermissionName == "" || constraint.Contains(req.PermissionName)) {
+			constraints = append(constraints, constraint)
+		}
+	}
+	sort.Slice(constraints, func(i, j int) bool {
+		return constraints[i].Name() < constraints[j].Name()
+	})
+	return domain.GetSoDConstraintsResp{Constraints: constraints}
+}
+
+// ExercisePermission records the exercise like ncExercisePermissionCypher,
+// which only records it if there are constraints to check it against
+func (store *RHABACRepo) ExercisePermission(ctx context.Context, req domain.ExercisePermissionReq) domain.AdministrationResp {
+	tracer := otel.Tracer("oort.memory.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.ExercisePermission")
+	defer span.End()
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	subName, objName := req.Subject.Name(), req.Object.Name()
+	for _, constraint := range req.SoDConstraints {
+		for _, conflicting := range constraint.ConflictingPermissions(req.PermissionName) {
+			if store.exercised[exercise{subject: subName, object: objName, permission: conflicting}] {
+				return domain.AdministrationResp{Error: sodViolationError(constraint.Name(), subName, objName)}
+			}
+		}
+	}
+	if len(req.SoDConstraints) > 0 && !req.DryRun {
+		store.exercised[exercise{subject: subName, object: objName, permission: req.PermissionName}] = true
+	}
+	return domain.AdministrationResp{}
+}
+
+func (store *RHABACRepo) GetInheritanceCycles(ctx context.Context, req domain.GetInheritanceCyclesReq) domain.GetInheritanceCyclesResp {
+	tracer := otel.Tracer("oort.memory.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.GetInheritanceCycles")
+	defer span.End()
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	return domain.GetInheritanceCyclesResp{Cycles: store.cycles(store.relTypes(nil))}
+}
+
+// GetAncestorAttributes follows the relations that propagate attributes,
+// the distance of an ancestor is the length of the shortest path to it
+func (store *RHABACRepo) GetAncestorAttributes(ctx context.Context, req domain.GetAncestorAttributesReq) domain.GetAncestorAttributesResp {
+	tracer := otel.Tracer("oort.memory.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.GetAncestorAttributes")
+	defer span.End()
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	ancestors := make([]domain.AncestorAttributes, 0)
+	for name, distance := range store.ancestorDistances(req.Resource.Name(), store.relTypes(propagatesAttributes)) {
+		ancestor, err := domain.NewResourceFromName(name)
+		if err != nil {
+			return domain.GetAncestorAttributesResp{Error: err}
+		}
+		ancestors = append(ancestors, domain.AncestorAttributes{
+			Ancestor:   *ancestor,
+			Distance:   distance,
+			Attributes: sortedAttributes(store.resources[name].attributes),
+		})
+	}
+	sort.Slice(ancestors, func(i, j int) bool {
+		if ancestors[i].Distance != ancestors[j].Distance {
+			return ancestors[i].Distance < ancestors[j].Distance
+		}
+		return ancestors[i].Ancestor.Name() < ancestors[j].Ancestor.Name()
+	})
+	return domain.GetAncestorAttributesResp{Ancestors: ancestors}
+}
+
+// ResolveGraphPredicates resolves every predicate to false if the subject or the object doesn't exist
+func (store *RHABACRepo) ResolveGraphPredicates(ctx context.Context, req domain.ResolveGraphPredicatesReq) domain.ResolveGraphPredicatesResp {
+	tracer := otel.Tracer("oort.memory.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.ResolveGraphPredicates")
+	defer span.End()
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	operands := map[string]string{
+		domain.GraphOperandSubject: req.Subject.Name(),
+		domain.GraphOperandObject:  req.Object.Name(),
+	}
+	_, subExists := store.resources[req.Subject.Name()]
+	_, objExists := store.resources[req.Object.Name()]
+	relations := make(domain.GraphRelations, len(req.Predicates))
+	for _, predicate := range req.Predicates {
+		if !subExists || !objExists {
+			relations[predicate] = false
+			continue
+		}
+		from, to := operands[predicate.From], operands[predicate.To]
+		switch predicate.Function {
+		case domain.RelatedFunction:
+			relations[predicate] = store.related(from, to, predicate.Argument, predicate.MaxDepth)
+		case domain.ShareAncestorFunction:
+			relations[predicate] = store.shareAncestor(from, to, predicate.Argument)
+		default:
+			relations[predicate] = false
+		}
+	}
+	return domain.ResolveGraphPredicatesResp{Relations: relations}
+}
+
+func (store *RHABACRepo) PutRelationType(ctx context.Context, req domain.PutRelationTypeReq) domain.AdministrationResp {
+	tracer := otel.Tracer("oort.memory.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.PutRelationType")
+	defer span.End()
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	store.relationTypes[req.RelationType.Name()] = req.RelationType
+	return domain.AdministrationResp{}
+}
+
+// DeleteRelationType only deletes a relation type no relation uses
+func (store *RHABACRepo) DeleteRelationType(ctx context.Context, req domain.DeleteRelationTypeReq) domain.AdministrationResp {
+	tracer := otel.Tracer("oort.memory.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.DeleteRelationType")
+	defer span.End()
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	if _, ok := store.relationTypes[req.Name]; !ok {
+		return domain.AdministrationResp{}
+	}
+	for _, r := range store.resources {
+		for e := range r.edges {
+			if e.relType == req.Name {
+				return domain.AdministrationResp{Error: domain.ErrRelationTypeInUse}
+			}
+		}
+	}
+	delete(store.relationTypes, req.Name)
+	return domain.AdministrationResp{}
+}
+
+func (store *RHABACRepo) GetRelationTypes(ctx context.Context, req domain.GetRelationTypesReq) domain.GetRelationTypesResp {
+	tracer := otel.Tracer("oort.memory.repo")
+	_, span := tracer.Start(ctx, "RHABACRepo.GetRelationTypes")
+	defer span.End()
+	store.mu.RLock()
+	defer store.mu.RUnlock()
+	defined := make([]domain.RelationType, 0, len(store.relationTypes))
+	for _, relationType := range store.relationTypes {
+		defined = append(defined, relationType)
+	}
+	sort.Slice(defined, func(i, j int) bool {
+		return defined[i].Name() < defined[j].Name()
+	})
+	relationTypes := append([]domain.RelationType{domain.InheritsFromRelationType}, defined...)
+	return domain.GetRelationTypesResp{RelationTypes: relationTypes}
+}
+
+// Batch applies the ops to a copy of the graph, which replaces the graph only if all of them succeeded
+func (store *RHABACRepo) Batch(ctx context.Context, req domain.BatchReq) domain.BatchResp {
+	tracer := otel.Tracer("oort.memory.repo")
+	ctx, span := tracer.Start(ctx, "RHABACRepo.Batch")
+	defer span.End()
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	// the copy has a lock of its own, so its methods don't wait for the one held here
+	staged := store.copy()
+	resp := domain.ApplyBatch(ctx, staged, req)
+	if resp.Error == nil {
+		store.replace(staged)
+	}
+	return resp
+}
+
+func (store *RHABACRepo) copy() *RHABACRepo {
+	resources := make(map[string]*resource, len(store.resources))
+	for name, r := range store.resources {
+		attributes := make(map[string]domain.Attribute, len(r.attributes))
+		for attrName, attr := range r.attributes {
+			attributes[attrName] = attr
+		}
+		edges := make(map[edge]bool, len(r.edges))
+		for e := range r.edges {
+			edges[e] = true
+		}
+		resources[name] = &resource{attributes: attributes, edges: edges}
+	}
+	staged := &RHABACRepo{
+		resources:      resources,
+		policies:       append(make([]policy, 0, len(store.policies)), store.policies...),
+		schemas:        make(map[string]domain.AttributeSchema, len(store.schemas)),
+		algorithms:     make(map[string]domain.CombiningAlgorithm, len(store.algorithms)),
+		implications:   append(make([]domain.PermissionImplication, 0, len(store.implications)), store.implications...),
+		breakGlasses:   append(make([]domain.BreakGlass, 0, len(store.breakGlasses)), store.breakGlasses...),
+		breakGlassUses: append(make([]domain.BreakGlassUse, 0, len(store.breakGlassUses)), store.breakGlassUses...),
+		sodConstraints: make(map[string]domain.SoDConstraint, len(store.sodConstraints)),
+		exercised:      make(map[exercise]bool, len(store.exercised)),
+		relationTypes:  make(map[string]domain.RelationType, len(store.relationTypes)),
+	}
+	for k, v := range store.schemas {
+		staged.schemas[k] = v
+	}
+	for k, v := range store.algorithms {
+		staged.algorithms[k] = v
+	}
+	for k, v := range store.sodConstraints {
+		staged.sodConstraints[k] = v
+	}
+	for k, v := range store.exercised {
+		staged.exercised[k] = v
+	}
+	for k, v := range store.relationTypes {
+		staged.relationTypes[k] = v
+	}
+	return staged
+}
+
+// view shares the data of the store without copying it, it may only be read while the store's lock is held
+func (store *RHABACRepo) view() *RHABACRepo {
+	return &RHABACRepo{
+		resources:      store.resources,
+		policies:       store.policies,
+		schemas:        store.schemas,
+		algorithms:     store.algorithms,
+		implications:   store.implications,
+		breakGlasses:   store.breakGlasses,
+		breakGlassUses: store.breakGlassUses,
+		sodConstraints: store.sodConstraints,
+		exercised:      store.exercised,
+		relationTypes:  store.relationTypes,
+	}
+}
+
+func (store *RHABACRepo) replace(staged *RHABACRepo) {
+	store.resources = staged.resources
+	store.policies = staged.policies
+	store.schemas = staged.schemas
+	store.algorithms = staged.algorithms
+	store.implications = staged.implications
+	store.breakGlasses = staged.breakGlasses
+	store.breakGlassUses = staged.breakGlassUses
+	store.sodConstraints = staged.sodConstraints
+	store.exercised = staged.exercised
+	store.relationTypes = staged.relationTypes
+}
+
+func sortedAttributes(attributes map[string]domain.Attribute) []domain.Attribute {
+	sorted := make([]domain.Attribute, 0, len(attributes))
+	for _, attribute := range attributes {
+		sorted = append(sorted, attribute)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Name() < sorted[j].Name()
+	})
+	return sorted
+}
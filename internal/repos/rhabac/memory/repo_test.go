@@ -0,0 +1,14 @@
+package memory
+
+import (
+	"testing"
+
+	"github.com/c12s/oort/internal/domain"
+	"github.com/c12s/oort/internal/repos/rhabac/repotest"
+)
+
+func TestRHABACRepo(t *testing.T) {
+	repotest.Run(t, func(t *testing.T) domain.RHABACRepo {
+		return NewRHABACRepo()
+	})
+}
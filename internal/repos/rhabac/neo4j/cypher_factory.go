@@ -1,7 +1,13 @@
 package neo4j
 
 import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
 	"github.com/c12s/oort/internal/domain"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
 )
 
 type CypherFactory interface {
@@ -10,32 +16,78 @@ type CypherFactory interface {
 	getResource(req domain.GetResourceReq) (string, map[string]interface{})
 	putAttribute(req domain.PutAttributeReq) (string, map[string]interface{})
 	deleteAttribute(req domain.DeleteAttributeReq) (string, map[string]interface{})
-	createInheritanceRel(req domain.CreateInheritanceRelReq) (string, map[string]interface{})
-	deleteInheritanceRel(req domain.DeleteInheritanceRelReq) (string, map[string]interface{})
+	createRelation(req domain.CreateRelationReq, relationTypes []domain.RelationType) (string, map[string]interface{})
+	verifyRelationSoD(req domain.CreateRelationReq, relationTypes []domain.RelationType) (string, map[string]interface{})
+	deleteRelation(req domain.DeleteRelationReq) (string, map[string]interface{})
 	createPolicy(req domain.CreatePolicyReq) (string, map[string]interface{})
+	verifyPolicySoD(req domain.CreatePolicyReq, relationTypes []domain.RelationType) (string, map[string]interface{})
 	deletePolicy(req domain.DeletePolicyReq) (string, map[string]interface{})
-	getEffectivePermissionsWithPriority(req domain.GetPermissionHierarchyReq) (string, map[string]interface{})
-	getApplicablePolicies(req domain.GetApplicablePoliciesReq) (string, map[string]interface{})
+	getEffectivePermissionsWithPriority(req domain.GetPermissionHierarchyReq, relationTypes []domain.RelationType) (string, map[string]interface{})
+	getAuthorizationData(req domain.GetAuthorizationDataReq, relationTypes []domain.RelationType) (string, map[string]interface{})
+	getApplicablePolicies(req domain.GetApplicablePoliciesReq, relationTypes []domain.RelationType) (string, map[string]interface{})
+	putAttributeSchema(req domain.PutAttributeSchemaReq) (string, map[string]interface{})
+	deleteAttributeSchema(req domain.DeleteAttributeSchemaReq) (string, map[string]interface{})
+	getAttributeSchema(req domain.GetAttributeSchemaReq) (string, map[string]interface{})
+	setCombiningAlgorithm(req domain.SetCombiningAlgorithmReq) (string, map[string]interface{})
+	getCombiningAlgorithm(req domain.GetCombiningAlgorithmReq) (string, map[string]interface{})
+	createPermissionImplication(req domain.CreatePermissionImplicationReq) (string, map[string]interface{})
+	deletePermissionImplication(req domain.DeletePermissionImplicationReq) (string, map[string]interface{})
+	deleteExpiredPolicies(req domain.DeleteExpiredPoliciesReq) (string, map[string]interface{})
+	createBreakGlass(req domain.CreateBreakGlassReq) (string, map[string]interface{})
+	getActiveBreakGlass(req domain.GetActiveBreakGlassReq) (string, map[string]interface{})
+	createBreakGlassUse(req domain.CreateBreakGlassUseReq) (string, map[string]interface{})
+	getBreakGlassUses(req domain.GetBreakGlassUsesReq) (string, map[string]interface{})
+	createSoDConstraint(req domain.CreateSoDConstraintReq) (string, map[string]interface{})
+	verifySoDConstraint(req domain.CreateSoDConstraintReq, relationTypes []domain.RelationType) (string, map[string]interface{})
+	deleteSoDConstraint(req domain.DeleteSoDConstraintReq) (string, map[string]interface{})
+	getSoDConstraints(req domain.GetSoDConstraintsReq) (string, map[string]interface{})
+	exercisePermission(req domain.ExercisePermissionReq) (string, map[string]interface{})
+	getInheritanceCycles(req domain.GetInheritanceCyclesReq, relationTypes []domain.RelationType) (string, map[string]interface{})
+	getAncestorAttributes(req domain.GetAncestorAttributesReq, relationTypes []domain.RelationType) (string, map[string]interface{})
+	resolveGraphPredicates(req domain.ResolveGraphPredicatesReq, relationTypes []domain.RelationType) (string, map[string]interface{})
+	putRelationType(req domain.PutRelationTypeReq) (string, map[string]interface{})
+	deleteRelationType(req domain.DeleteRelationTypeReq) (string, map[string]interface{})
+	getRelationTypes(req domain.GetRelationTypesReq) (string, map[string]interface{})
 }
 
+// simpleCypherFactory follows at most maxDepth relations wherever it traverses the resource graph,
+// no chain of relations is created longer than the configured max inheritance depth
 type simpleCypherFactory struct {
+	maxDepth int
 }
 
-func NewSimpleCypherFactory() CypherFactory {
-	return &simpleCypherFactory{}
+// NewSimpleCypherFactory uses the DefaultMaxInheritanceDepth if maxDepth isn't positive
+func NewSimpleCypherFactory(maxDepth int) CypherFactory {
+	if maxDepth <= 0 {
+		maxDepth = domain.DefaultMaxInheritanceDepth
+	}
+	return &simpleCypherFactory{maxDepth: maxDepth}
 }
 
 const ncCreateResourceCypher = `
 MERGE (r:Resource{name: $name})
 MERGE (root:Resource{name: $rootName})
 MERGE (r)-[:INHERITS_FROM]->(root)
+WITH r
+UNWIND $attrs AS attr
+MERGE ((r)-[:HAS]->(a:Attribute{name: attr.name}))
+SET a += {kind: attr.kind, value: attr.value, inheritable: attr.inheritable}
 `
 
 func (f simpleCypherFactory) createResource(req domain.CreateResourceReq) (string, map[string]interface{}) {
+	attrs := make([]map[string]interface{}, len(req.Resource.Attributes))
+	for i, attr := range req.Resource.Attributes {
+		attrs[i] = map[string]interface{}{
+			"name":        attr.Name(),
+			"kind":        attr.Kind(),
+			"value":       attributeValueParam(attr),
+			"inheritable": attr.Inheritable()}
+	}
 	return ncCreateResourceCypher,
 		map[string]interface{}{
 			"name":     req.Resource.Name(),
-			"rootName": domain.RootResource.Name()}
+			"rootName": domain.RootResource.Name(),
+			"attrs":    attrs}
 }
 
 const ncDeleteResourceCypher = `
@@ -83,17 +135,39 @@ MERGE (r:Resource{name: $name})
 MERGE (root:Resource{name: $rootName})
 MERGE (r)-[:INHERITS_FROM]->(root)
 MERGE ((r)-[:HAS]->(a:Attribute{name: $attrName}))
-SET a += {kind: $attrKind, value: $ attrValue}
+SET a += {kind: $attrKind, value: $attrValue, inheritable: $attrInheritable}
 `
 
 func (f simpleCypherFactory) putAttribute(req domain.PutAttributeReq) (string, map[string]interface{}) {
 	return ncPutAttributeCypher,
 		map[string]interface{}{
-			"name":      req.Resource.Name(),
-			"rootName":  domain.RootResource.Name(),
-			"attrName":  req.Attribute.Name(),
-			"attrKind":  req.Attribute.Kind(),
-			"attrValue": req.Attribute.Value()}
+			"name":            req.Resource.Name(),
+			"rootName":        domain.RootResource.Name(),
+			"attrName":        req.Attribute.Name(),
+			"attrKind":        req.Attribute.Kind(),
+			"attrValue":       attributeValueParam(req.Attribute),
+			"attrInheritable": req.Attribute.Inheritable()}
+}
+
+// lists are stored as native neo4j list properties,
+// a nil list would be sent as null and remove the property, so it is stored as an empty one.
+// timestamps are stored as neo4j datetimes and durations as neo4j durations
+func attributeValueParam(attr domain.Attribute) interface{} {
+	switch attr.Kind() {
+	case domain.StringList:
+		if value, ok := attr.Value().([]string); ok && value == nil {
+			return []string{}
+		}
+	case domain.Int64List:
+		if value, ok := attr.Value().([]int64); ok && value == nil {
+			return []int64{}
+		}
+	case domain.Duration:
+		if value, ok := attr.Value().(time.Duration); ok {
+			return neo4j.DurationOf(0, 0, int64(value/time.Second), int(value%time.Second))
+		}
+	}
+	return attr.Value()
 }
 
 const ncDeleteAttributeCypher = `
@@ -108,37 +182,99 @@ func (f simpleCypherFactory) deleteAttribute(req domain.DeleteAttributeReq) (str
 			"attrName": req.AttributeId.Name()}
 }
 
-const ncCreateInheritanceRelCypher = `
+// noRelationType is a relationship type no relationship has, relation type names are upper case
+const noRelationType = "oort_no_relation_type"
+
+// relTypesPattern joins the names of the relation types that pass the filter into the types of a relationship pattern,
+// e.g. INHERITS_FROM|MEMBER_OF, so that relationships of other types, such as HAS and ON, aren't followed.
+// If no type passes, the pattern matches no relationship at all instead of any.
+// Relationship types can't be parameters, the names are formatted into the statements,
+// which is safe since every relation type name passed ValidateRelationTypeName.
+func relTypesPattern(relationTypes []domain.RelationType, filter func(domain.RelationType) bool) string {
+	names := make([]string, 0, len(relationTypes))
+	for _, relationType := range relationTypes {
+		if filter == nil || filter(relationType) {
+			names = append(names, relationType.Name())
+		}
+	}
+	if len(names) == 0 {
+		return noRelationType
+	}
+	return strings.Join(names, "|")
+}
+
+func propagatesPermissions(relationType domain.RelationType) bool {
+	return relationType.PropagatesPermissions()
+}
+
+func propagatesAttributes(relationType domain.RelationType) bool {
+	return relationType.PropagatesAttributes()
+}
+
+// the relationship is only created if its type is defined, from isn't already related to to
+// and the longest chain of relations through it stays within the max depth,
+// relations of all types count, since any of them may propagate permissions or attributes.
+// The chains below to and above from are only followed one relation past the max depth,
+// which is enough to tell that it is exceeded, and so is the path from from to to that would close a cycle,
+// since a longer one exceeds the max depth anyway.
+// The returned values are verified before the transaction is committed.
+const ncCreateRelationCypher = `
 MERGE (from:Resource{name: $fromName})
 MERGE (to:Resource{name: $toName})
 MERGE (root:Resource{name: $rootName})
 MERGE (from)-[:INHERITS_FROM]->(root)
 MERGE (to)-[:INHERITS_FROM]->(root)
 WITH from, to
-MATCH (f) WHERE ID(f) = ID(from)
-MATCH (t) WHERE ID(t) = ID(to)
-AND NOT (t)-[:INHERITS_FROM]->(f) AND NOT (f)-[:INHERITS_FROM*]->(t)
-CREATE (t)-[:INHERITS_FROM]->(f)
+OPTIONAL MATCH (relType:RelationType{name: $relType})
+WITH from, to, relType IS NOT NULL OR $relType = $inheritsFrom AS known,
+EXISTS { (from)-[:%[1]s*0..%[2]d]->(to) } AS cycle
+CALL {
+	WITH to
+	MATCH below=(:Resource)-[:%[1]s*0..%[2]d]->(to)
+	RETURN max(length(below)) AS belowDepth
+}
+CALL {
+	WITH from
+	MATCH above=(from)-[:%[1]s*0..%[2]d]->(:Resource)
+	RETURN max(length(above)) AS aboveDepth
+}
+WITH from, to, known, cycle, belowDepth + 1 + aboveDepth AS depth
+FOREACH (_ IN CASE WHEN known AND NOT cycle AND depth <= $maxDepth THEN [1] ELSE [] END | MERGE (to)-[:%[3]s]->(from))
+RETURN known, cycle, depth, $maxDepth
 `
 
-func (f simpleCypherFactory) createInheritanceRel(req domain.CreateInheritanceRelReq) (string, map[string]interface{}) {
-	return ncCreateInheritanceRelCypher,
+func (f simpleCypherFactory) createRelation(req domain.CreateRelationReq, relationTypes []domain.RelationType) (string, map[string]interface{}) {
+	return fmt.Sprintf(ncCreateRelationCypher, relTypesPattern(relationTypes, nil), max(req.MaxDepth+1, 0), req.Type),
 		map[string]interface{}{
-			"fromName": req.From.Name(),
-			"toName":   req.To.Name(),
-			"rootName": domain.RootResource.Name()}
+			"fromName":     req.From.Name(),
+			"toName":       req.To.Name(),
+			"rootName":     domain.RootResource.Name(),
+			"relType":      req.Type,
+			"inheritsFrom": domain.InheritsFrom,
+			"maxDepth":     req.MaxDepth}
 }
 
-const ncDeleteInheritanceRelCypher = `
-MATCH (:Resource{name: $toName})-[rel:INHERITS_FROM]->(:Resource{name: $fromName})
+func (f simpleCypherFactory) verifyRelationSoD(req domain.CreateRelationReq, relationTypes []domain.RelationType) (string, map[string]interface{}) {
+	// the new relationship changes what descendants of to hold, both as subjects and as objects
+	scopes := []sodScope{
+		{subject: req.To, object: domain.RootResource},
+		{subject: domain.RootResource, object: req.To},
+	}
+	return f.verifySoDCypher(relationTypes), sodParams(req.SoDConstraints, scopes)
+}
+
+const ncDeleteRelationCypher = `
+MATCH (:Resource{name: $toName})-[rel]->(:Resource{name: $fromName})
+WHERE type(rel) = $relType
 DELETE rel
 `
 
-func (f simpleCypherFactory) deleteInheritanceRel(req domain.DeleteInheritanceRelReq) (string, map[string]interface{}) {
-	return ncDeleteInheritanceRelCypher,
+func (f simpleCypherFactory) deleteRelation(req domain.DeleteRelationReq) (string, map[string]interface{}) {
+	return ncDeleteRelationCypher,
 		map[string]interface{}{
 			"fromName": req.From.Name(),
-			"toName":   req.To.Name()}
+			"toName":   req.To.Name(),
+			"relType":  req.Type}
 }
 
 const ncCreatePermissionCypher = `
@@ -148,18 +284,60 @@ MERGE (root:Resource{name: $rootName})
 MERGE (sub)-[:INHERITS_FROM]->(root)
 MERGE (obj)-[:INHERITS_FROM]->(root)
 MERGE ((sub)-[:HAS]->(p:Permission{name: $permName, kind: $permKind})-[:ON]->(obj))
-SET p.condition = $permCond
+SET p.condition = $permCond, p.conditionLanguage = $permCondLang, p.onConditionError = $permOnCondErr, p.obligations = $permObligations,
+p.notBefore = $permNotBefore, p.notAfter = $permNotAfter
 `
 
 func (f simpleCypherFactory) createPolicy(req domain.CreatePolicyReq) (string, map[string]interface{}) {
-	return ncCreatePermissionCypher,
-		map[string]interface{}{
-			"subName":  req.SubjectScope.Name(),
-			"objName":  req.ObjectScope.Name(),
-			"rootName": domain.RootResource.Name(),
-			"permName": req.Permission.Name(),
-			"permKind": req.Permission.Kind(),
-			"permCond": req.Permission.Condition().Expression()}
+	params := map[string]interface{}{
+		"subName":         req.SubjectScope.Name(),
+		"objName":         req.ObjectScope.Name(),
+		"rootName":        domain.RootResource.Name(),
+		"permName":        req.Permission.Name(),
+		"permKind":        req.Permission.Kind(),
+		"permCond":        req.Permission.Condition().Expression(),
+		"permCondLang":    req.Permission.Condition().Language(),
+		"permOnCondErr":   req.Permission.OnConditionError(),
+		"permObligations": obligationsParam(req.Permission.Obligations()),
+		"permNotBefore":   validityBoundParam(req.Validity.NotBefore),
+		"permNotAfter":    validityBoundParam(req.Validity.NotAfter)}
+	return ncCreatePermissionCypher, params
+}
+
+func (f simpleCypherFactory) verifyPolicySoD(req domain.CreatePolicyReq, relationTypes []domain.RelationType) (string, map[string]interface{}) {
+	scopes := []sodScope{{subject: req.SubjectScope, object: req.ObjectScope}}
+	return f.verifySoDCypher(relationTypes), sodParams(req.SoDConstraints, scopes)
+}
+
+// unbounded validity is stored as a missing property,
+// setting a property to null removes it, so recreating a policy can also lift its bounds
+func validityBoundParam(bound time.Time) interface{} {
+	if bound.IsZero() {
+		return nil
+	}
+	return bound
+}
+
+// storedObligation is the JSON representation of an obligation,
+// neo4j properties can't hold maps, so the obligations of a permission are stored as one JSON string
+type storedObligation struct {
+	Name   string            `json:"name"`
+	Params map[string]string `json:"params,omitempty"`
+	Advice bool              `json:"advice,omitempty"`
+}
+
+func obligationsParam(obligations []domain.Obligation) string {
+	stored := make([]storedObligation, len(obligations))
+	for i, obligation := range obligations {
+		stored[i] = storedObligation{
+			Name:   obligation.Name(),
+			Params: obligation.Params(),
+			Advice: obligation.Advice(),
+		}
+	}
+	// a slice of plain structs always marshals
+	marshalled, _ := json.Marshal(stored)
+	return string(marshalled)
 }
 
 const ncDeletePermissionCypher = `
@@ -178,46 +356,584 @@ func (f simpleCypherFactory) deletePolicy(req domain.DeletePolicyReq) (string, m
 			"permKind": req.Permission.Kind()}
 }
 
+// only relations that propagate permissions are followed, both to find the policies and to rank them
 const ncGetPermissionsCypher = `
-MATCH (sub:Resource{name: $subName})-[:INHERITS_FROM*0..]->(subParent:Resource)-[:HAS]->
-(p:Permission{name: $permName})-[:ON]->(objParent:Resource)<-[:INHERITS_FROM*0..]-(obj:Resource{name: $objName})
+OPTIONAL MATCH (implying:PermissionDefinition)-[:IMPLIES*1..]->(implied:PermissionDefinition)
+WHERE implied.name IN $permNames
+WITH collect(DISTINCT implying.name) AS implyingNames
+MATCH (sub:Resource{name: $subName})-[:%[1]s*0..%[2]d]->(subParent:Resource)-[:HAS]->
+(p:Permission)-[:ON]->(objParent:Resource)<-[:%[1]s*0..%[2]d]-(obj:Resource{name: $objName})
+WHERE (p.name IN $permNames OR (p.kind = $allowKind AND p.name IN implyingNames))
+AND (p.notBefore IS NULL OR p.notBefore <= datetime()) AND (p.notAfter IS NULL OR p.notAfter > datetime())
 WITH p, sub, subParent, obj, objParent
 CALL {
 	WITH sub, subParent
-	MATCH path=(sub)-[:INHERITS_FROM*0..100]->(subParent)
+	MATCH path=(sub)-[:%[1]s*0..%[2]d]->(subParent)
 	RETURN -length(path) AS subPriority
 	ORDER BY subPriority ASC
 	LIMIT 1
 }
 CALL {
 	WITH obj, objParent
-	MATCH path=(obj)-[:INHERITS_FROM*0..100]->(objParent)
+	MATCH path=(obj)-[:%[1]s*0..%[2]d]->(objParent)
 	RETURN -length(path) AS objPriority
 	ORDER BY objPriority ASC
 	LIMIT 1
 }
-RETURN p.name, p.kind, p.condition, subPriority, objPriority
+RETURN p.name AS name, p.kind AS kind, p.condition AS condition, subPriority, objPriority,
+coalesce(p.onConditionError, 0) AS onConditionError, coalesce(p.conditionLanguage, 0) AS conditionLanguage, coalesce(p.obligations, '[]') AS obligations
+`
+
+func (f simpleCypherFactory) getEffectivePermissionsWithPriority(req domain.GetPermissionHierarchyReq, relationTypes []domain.RelationType) (string, map[string]interface{}) {
+	return fmt.Sprintf(ncGetPermissionsCypher, relTypesPattern(relationTypes, propagatesPermissions), f.maxDepth),
+		map[string]interface{}{
+			"subName": req.Subject.Name(),
+			"objName": req.Object.Name(),
+			// policies on wildcard names are matched too, the hierarchy ranks them by specificity
+			"permNames": domain.PermissionNameCandidates(req.PermissionName),
+			"allowKind": domain.PermissionKindAllow}
+}
+
+// every part of the authorization data is read by a subquery that collects its rows into a list,
+// a row holds the columns the part's own cypher returns, so that the part is mapped like its records.
+// The permission hierarchy is read by ncGetPermissionsCypher, whose columns are aliased for the subquery.
+const ncGetAuthorizationDataCypher = `
+CALL {
+	CALL {
+%[1]s
+	}
+	RETURN collect([name, kind, condition, subPriority, objPriority, onConditionError, conditionLanguage, obligations]) AS permissions
+}
+CALL {
+	OPTIONAL MATCH (def:PermissionDefinition{name: $permName})
+	RETURN collect([coalesce(def.combiningAlgorithm, $defaultAlgorithm)]) AS algorithm
+}
+%[2]s
+%[3]s
+CALL {
+	MATCH (c:SoDConstraint{kind: $sodKind})
+	WHERE $permName IN c.permissions
+	RETURN collect([c.name, c.kind, c.permissions]) AS sodConstraints
+}
+CALL {
+	MATCH (bg:BreakGlass{subject: $subName, object: $objName, permissionName: $permName})
+	WHERE bg.grantedAt <= $now AND bg.expiresAt > $now
+	WITH bg
+	ORDER BY bg.expiresAt DESC
+	LIMIT 1
+	RETURN collect([bg.id, bg.subject, bg.object, bg.permissionName, bg.justification, bg.grantedAt, bg.expiresAt]) AS breakGlass
+}
+RETURN permissions, algorithm, subResource, subAncestors, subSchema, objResource, objAncestors, objSchema, sodConstraints, breakGlass
+`
+
+// the resource, its ancestors and its schema are read like ncGetResourceCypher, ncGetAncestorAttributesCypher
+// and ncGetAttributeSchemaCypher do, the columns are prefixed with the side of the resource, sub or obj
+const ncGetResourceAttributesCypher = `
+CALL {
+	MATCH (resource:Resource{name: $%[1]sName})
+	OPTIONAL MATCH (attr:Attribute)<-[:HAS]-(resource)
+	WITH resource, collect(properties(attr)) AS attrs
+	RETURN collect([resource.name, attrs]) AS %[1]sResource
+}
+CALL {
+	MATCH path=(r:Resource{name: $%[1]sName})-[:%[2]s*1..%[3]d]->(ancestor:Resource)
+	WITH ancestor, min(length(path)) AS distance
+	OPTIONAL MATCH (ancestor)-[:HAS]->(attr:Attribute)
+	WITH ancestor, distance, collect(properties(attr)) AS attrs
+	RETURN collect([ancestor.name, distance, attrs]) AS %[1]sAncestors
+}
+CALL {
+	MATCH (schema:AttributeSchema{resourceKind: $%[1]sKind})
+	OPTIONAL MATCH (schema)-[:DEFINES]->(def:AttributeDefinition)
+	WITH schema, collect(properties(def)) AS defs
+	RETURN collect([schema.resourceKind, defs]) AS %[1]sSchema
+}`
+
+func (f simpleCypherFactory) getAuthorizationData(req domain.GetAuthorizationDataReq, relationTypes []domain.RelationType) (string, map[string]interface{}) {
+	hierarchy, params := f.getEffectivePermissionsWithPriority(domain.GetPermissionHierarchyReq{
+		Subject:        req.Subject,
+		Object:         req.Object,
+		PermissionName: req.PermissionName,
+	}, relationTypes)
+	attributesPattern := relTypesPattern(relationTypes, propagatesAttributes)
+	params["subKind"] = req.Subject.Kind()
+	params["objKind"] = req.Object.Kind()
+	params["permName"] = req.PermissionName
+	params["defaultAlgorithm"] = domain.DefaultCombiningAlgorithm
+	params["sodKind"] = domain.SoDDynamic
+	params["now"] = req.Now
+	return fmt.Sprintf(ncGetAuthorizationDataCypher,
+			hierarchy,
+			fmt.Sprintf(ncGetResourceAttributesCypher, "sub", attributesPattern, f.maxDepth),
+			fmt.Sprintf(ncGetResourceAttributesCypher, "obj", attributesPattern, f.maxDepth)),
+		params
+}
+
+const ncGetApplicablePoliciesCypher = `
+MATCH (sub:Resource{name: $subName})-[:%[1]s*0..%[2]d]->(subParent:Resource)-[:HAS]->
+(p:Permission)-[:ON]->(objParent:Resource)<-[:%[1]s*0..%[2]d]-(obj:Resource)
+WHERE (p.notBefore IS NULL OR p.notBefore <= datetime()) AND (p.notAfter IS NULL OR p.notAfter > datetime())
+OPTIONAL MATCH (:PermissionDefinition{name: p.name})-[:IMPLIES*1..]->(implied:PermissionDefinition)
+WITH p, obj, collect(implied.name) AS impliedNames
+WITH p, obj, CASE WHEN p.kind = $allowKind THEN impliedNames ELSE [] END AS impliedNames
+UNWIND [p.name] + impliedNames AS permName
+RETURN DISTINCT permName, obj.name
+`
+
+func (f simpleCypherFactory) getApplicablePolicies(req domain.GetApplicablePoliciesReq, relationTypes []domain.RelationType) (string, map[string]interface{}) {
+	return fmt.Sprintf(ncGetApplicablePoliciesCypher, relTypesPattern(relationTypes, propagatesPermissions), f.maxDepth),
+		map[string]interface{}{
+			"subName":   req.Subject.Name(),
+			"allowKind": domain.PermissionKindAllow,
+		}
+}
+
+const ncPutAttributeSchemaCypher = `
+MERGE (schema:AttributeSchema{resourceKind: $resourceKind})
+WITH schema
+// replace all previous definitions
+CALL {
+    WITH schema
+    MATCH (schema)-[:DEFINES]->(def:AttributeDefinition)
+    DETACH DELETE def
+}
+UNWIND $definitions AS def
+CREATE (schema)-[:DEFINES]->(:AttributeDefinition{name: def.name, kind: def.kind, required: def.required, default: def.default})
+`
+
+func (f simpleCypherFactory) putAttributeSchema(req domain.PutAttributeSchemaReq) (string, map[string]interface{}) {
+	definitions := make([]interface{}, 0, len(req.Schema.Definitions()))
+	for _, definition := range req.Schema.Definitions() {
+		var defaultValue interface{}
+		if attr, ok := definition.Default(); ok {
+			defaultValue = attributeValueParam(*attr)
+		}
+		definitions = append(definitions, map[string]interface{}{
+			"name":     definition.Name(),
+			"kind":     definition.Kind(),
+			"required": definition.Required(),
+			"default":  defaultValue,
+		})
+	}
+	return ncPutAttributeSchemaCypher,
+		map[string]interface{}{
+			"resourceKind": req.Schema.ResourceKind(),
+			"definitions":  definitions}
+}
+
+const ncDeleteAttributeSchemaCypher = `
+MATCH (schema:AttributeSchema{resourceKind: $resourceKind})
+OPTIONAL MATCH (schema)-[:DEFINES]->(def:AttributeDefinition)
+DETACH DELETE def, schema
+`
+
+func (f simpleCypherFactory) deleteAttributeSchema(req domain.DeleteAttributeSchemaReq) (string, map[string]interface{}) {
+	return ncDeleteAttributeSchemaCypher,
+		map[string]interface{}{
+			"resourceKind": req.ResourceKind}
+}
+
+const ncGetAttributeSchemaCypher = `
+MATCH (schema:AttributeSchema{resourceKind: $resourceKind})
+OPTIONAL MATCH (schema)-[:DEFINES]->(def:AttributeDefinition)
+RETURN schema.resourceKind, collect(properties(def)) AS defs
+`
+
+func (f simpleCypherFactory) getAttributeSchema(req domain.GetAttributeSchemaReq) (string, map[string]interface{}) {
+	return ncGetAttributeSchemaCypher,
+		map[string]interface{}{
+			"resourceKind": req.ResourceKind}
+}
+
+const ncSetCombiningAlgorithmCypher = `
+MERGE (def:PermissionDefinition{name: $permName})
+SET def.combiningAlgorithm = $algorithm
+`
+
+func (f simpleCypherFactory) setCombiningAlgorithm(req domain.SetCombiningAlgorithmReq) (string, map[string]interface{}) {
+	return ncSetCombiningAlgorithmCypher,
+		map[string]interface{}{
+			"permName":  req.PermissionName,
+			"algorithm": req.Algorithm}
+}
+
+const ncGetCombiningAlgorithmCypher = `
+OPTIONAL MATCH (def:PermissionDefinition{name: $permName})
+RETURN coalesce(def.combiningAlgorithm, $defaultAlgorithm)
+`
+
+func (f simpleCypherFactory) getCombiningAlgorithm(req domain.GetCombiningAlgorithmReq) (string, map[string]interface{}) {
+	return ncGetCombiningAlgorithmCypher,
+		map[string]interface{}{
+			"permName":         req.PermissionName,
+			"defaultAlgorithm": domain.DefaultCombiningAlgorithm}
+}
+
+// the implication is only created if the implied permission doesn't already imply the implying one,
+// the returned cycle count is verified before the transaction is committed
+const ncCreatePermissionImplicationCypher = `
+MERGE (from:PermissionDefinition{name: $permName})
+MERGE (to:PermissionDefinition{name: $impliedPermName})
+WITH from, to
+OPTIONAL MATCH cycle=(to)-[:IMPLIES*1..]->(from)
+WITH from, to, count(cycle) AS cycles
+FOREACH (_ IN CASE WHEN cycles = 0 THEN [1] ELSE [] END | MERGE (from)-[:IMPLIES]->(to))
+RETURN cycles
 `
 
-func (f simpleCypherFactory) getEffectivePermissionsWithPriority(req domain.GetPermissionHierarchyReq) (string, map[string]interface{}) {
-	return ncGetPermissionsCypher,
+func (f simpleCypherFactory) createPermissionImplication(req domain.CreatePermissionImplicationReq) (string, map[string]interface{}) {
+	return ncCreatePermissionImplicationCypher,
+		map[string]interface{}{
+			"permName":        req.Implication.Permission(),
+			"impliedPermName": req.Implication.ImpliedPermission()}
+}
+
+const ncDeletePermissionImplicationCypher = `
+MATCH (:PermissionDefinition{name: $permName})-[i:IMPLIES]->(:PermissionDefinition{name: $impliedPermName})
+DELETE i
+`
+
+func (f simpleCypherFactory) deletePermissionImplication(req domain.DeletePermissionImplicationReq) (string, map[string]interface{}) {
+	return ncDeletePermissionImplicationCypher,
+		map[string]interface{}{
+			"permName":        req.Implication.Permission(),
+			"impliedPermName": req.Implication.ImpliedPermission()}
+}
+
+const ncDeleteExpiredPoliciesCypher = `
+MATCH (sub:Resource)-[:HAS]->(p:Permission)-[:ON]->(obj:Resource)
+WHERE p.notAfter IS NOT NULL AND p.notAfter <= $now
+WITH p, p.name AS permName, sub.name AS subName, obj.name AS objName
+DETACH DELETE p
+RETURN permName, subName, objName
+`
+
+func (f simpleCypherFactory) deleteExpiredPolicies(req domain.DeleteExpiredPoliciesReq) (string, map[string]interface{}) {
+	return ncDeleteExpiredPoliciesCypher,
+		map[string]interface{}{
+			"now": req.Now}
+}
+
+// break glass entries only reference resources by name and are never matched by the resource cyphers,
+// so the audit trail outlives the resources it refers to
+const ncCreateBreakGlassCypher = `
+CREATE (:BreakGlass{id: $id, subject: $subName, object: $objName, permissionName: $permName,
+justification: $justification, grantedAt: $grantedAt, expiresAt: $expiresAt})
+`
+
+func (f simpleCypherFactory) createBreakGlass(req domain.CreateBreakGlassReq) (string, map[string]interface{}) {
+	return ncCreateBreakGlassCypher,
+		map[string]interface{}{
+			"id":            req.BreakGlass.Id(),
+			"subName":       req.BreakGlass.Subject().Name(),
+			"objName":       req.BreakGlass.Object().Name(),
+			"permName":      req.BreakGlass.PermissionName(),
+			"justification": req.BreakGlass.Justification(),
+			"grantedAt":     req.BreakGlass.GrantedAt(),
+			"expiresAt":     req.BreakGlass.ExpiresAt()}
+}
+
+const ncGetActiveBreakGlassCypher = `
+MATCH (bg:BreakGlass{subject: $subName, object: $objName, permissionName: $permName})
+WHERE bg.grantedAt <= $now AND bg.expiresAt > $now
+RETURN bg.id, bg.subject, bg.object, bg.permissionName, bg.justification, bg.grantedAt, bg.expiresAt
+ORDER BY bg.expiresAt DESC
+LIMIT 1
+`
+
+func (f simpleCypherFactory) getActiveBreakGlass(req domain.GetActiveBreakGlassReq) (string, map[string]interface{}) {
+	return ncGetActiveBreakGlassCypher,
 		map[string]interface{}{
 			"subName":  req.Subject.Name(),
 			"objName":  req.Object.Name(),
+			"permName": req.PermissionName,
+			"now":      req.Now}
+}
+
+const ncCreateBreakGlassUseCypher = `
+CREATE (:BreakGlassUse{breakGlassId: $id, usedAt: $usedAt})
+`
+
+func (f simpleCypherFactory) createBreakGlassUse(req domain.CreateBreakGlassUseReq) (string, map[string]interface{}) {
+	return ncCreateBreakGlassUseCypher,
+		map[string]interface{}{
+			"id":     req.Use.BreakGlassId(),
+			"usedAt": req.Use.UsedAt()}
+}
+
+const ncGetBreakGlassUsesCypher = `
+MATCH (u:BreakGlassUse{breakGlassId: $id})
+RETURN u.breakGlassId, u.usedAt
+ORDER BY u.usedAt
+`
+
+func (f simpleCypherFactory) getBreakGlassUses(req domain.GetBreakGlassUsesReq) (string, map[string]interface{}) {
+	return ncGetBreakGlassUsesCypher,
+		map[string]interface{}{
+			"id": req.BreakGlassId}
+}
+
+// ncVerifySoDCypher runs after mutations that could violate static separation of duty constraints,
+// it returns the first subject that holds allow policies granting more than one permission of a constraint
+// on the same object, considering only subjects and objects that descend from one of the scopes.
+// Policies that aren't active yet are counted too, since they will be held once they become active.
+// Descent from the scopes is checked along relations of all types, which can only widen the verified subjects and objects.
+const ncVerifySoDCypher = `
+UNWIND $sodConstraints AS constraint
+CALL {
+	WITH constraint
+	UNWIND range(0, size(constraint.grantingNames) - 1) AS i
+	WITH i, constraint.grantingNames[i] AS names
+	OPTIONAL MATCH (implying:PermissionDefinition)-[:IMPLIES*1..]->(implied:PermissionDefinition)
+	WHERE implied.name IN names
+	WITH i, names + collect(DISTINCT implying.name) AS names
+	MATCH (sub:Resource)-[:%[1]s*0..%[3]d]->(:Resource)-[:HAS]->
+	(p:Permission)-[:ON]->(:Resource)<-[:%[1]s*0..%[3]d]-(obj:Resource)
+	WHERE p.kind = $allowKind AND p.name IN names AND (p.notAfter IS NULL OR p.notAfter > datetime())
+	AND any(scope IN $sodScopes WHERE (sub)-[:%[2]s*0..%[3]d]->(:Resource{name: scope.subName})
+		AND (obj)-[:%[2]s*0..%[3]d]->(:Resource{name: scope.objName}))
+	WITH sub, obj, collect(DISTINCT i) AS granted
+	WHERE size(granted) > 1
+	RETURN sub.name AS subName, obj.name AS objName
+	LIMIT 1
+}
+RETURN constraint.name, subName, objName
+LIMIT 1
+`
+
+func (f simpleCypherFactory) verifySoDCypher(relationTypes []domain.RelationType) string {
+	return fmt.Sprintf(ncVerifySoDCypher, relTypesPattern(relationTypes, propagatesPermissions), relTypesPattern(relationTypes, nil), f.maxDepth)
+}
+
+type sodScope struct {
+	subject,
+	object domain.Resource
+}
+
+func sodParams(constraints []domain.SoDConstraint, scopes []sodScope) map[string]interface{} {
+	sodConstraints := make([]interface{}, len(constraints))
+	for i, constraint := range constraints {
+		grantingNames := make([]interface{}, 0, len(constraint.Permissions()))
+		for _, names := range constraint.GrantingNames() {
+			grantingNames = append(grantingNames, names)
+		}
+		sodConstraints[i] = map[string]interface{}{
+			"name":          constraint.Name(),
+			"grantingNames": grantingNames,
+		}
+	}
+	sodScopes := make([]interface{}, len(scopes))
+	for i, scope := range scopes {
+		sodScopes[i] = map[string]interface{}{
+			"subName": scope.subject.Name(),
+			"objName": scope.object.Name(),
+		}
+	}
+	return map[string]interface{}{
+		"sodConstraints": sodConstraints,
+		"sodScopes":      sodScopes,
+		"allowKind":      domain.PermissionKindAllow}
+}
+
+const ncCreateSoDConstraintCypher = `
+MERGE (c:SoDConstraint{name: $name})
+SET c.kind = $kind, c.permissions = $permissions
+`
+
+func (f simpleCypherFactory) createSoDConstraint(req domain.CreateSoDConstraintReq) (string, map[string]interface{}) {
+	return ncCreateSoDConstraintCypher,
+		map[string]interface{}{
+			"name":        req.Constraint.Name(),
+			"kind":        req.Constraint.Kind(),
+			"permissions": req.Constraint.Permissions()}
+}
+
+// a static constraint is only accepted if no subject violates it already
+func (f simpleCypherFactory) verifySoDConstraint(req domain.CreateSoDConstraintReq, relationTypes []domain.RelationType) (string, map[string]interface{}) {
+	scopes := []sodScope{{subject: domain.RootResource, object: domain.RootResource}}
+	return f.verifySoDCypher(relationTypes), sodParams([]domain.SoDConstraint{req.Constraint}, scopes)
+}
+
+const ncDeleteSoDConstraintCypher = `
+MATCH (c:SoDConstraint{name: $name})
+DELETE c
+`
+
+func (f simpleCypherFactory) deleteSoDConstraint(req domain.DeleteSoDConstraintReq) (string, map[string]interface{}) {
+	return ncDeleteSoDConstraintCypher,
+		map[string]interface{}{
+			"name": req.Name}
+}
+
+const ncGetSoDConstraintsCypher = `
+MATCH (c:SoDConstraint{kind: $kind})
+WHERE $permName = '' OR $permName IN c.permissions
+RETURN c.name, c.kind, c.permissions
+`
+
+func (f simpleCypherFactory) getSoDConstraints(req domain.GetSoDConstraintsReq) (string, map[string]interface{}) {
+	return ncGetSoDConstraintsCypher,
+		map[string]interface{}{
+			"kind":     req.Kind,
 			"permName": req.PermissionName}
 }
 
-const ncGetApplicablePoliciesCypher = `
-MATCH (sub:Resource{name: $subName})-[:INHERITS_FROM*0..]->(subParent:Resource)-[:HAS]->
-(p:Permission)-[:ON]->(objParent:Resource)<-[:INHERITS_FROM*0..]-(obj:Resource)
-RETURN DISTINCT p.name, obj.name
+const ncViolatedExerciseConstraintsCypher = `
+UNWIND $sodConstraints AS constraint
+OPTIONAL MATCH (e:ExercisedPermission{subject: $subName, object: $objName})
+WHERE e.permissionName IN constraint.conflicting
+WITH constraint, count(e) AS conflicts
+WITH [c IN collect({name: constraint.name, conflicts: conflicts}) WHERE c.conflicts > 0 | c.name] AS violated
 `
 
-func (f simpleCypherFactory) getApplicablePolicies(req domain.GetApplicablePoliciesReq) (string, map[string]interface{}) {
-	return ncGetApplicablePoliciesCypher,
+const ncReturnViolatedExerciseConstraintCypher = `
+UNWIND violated AS constraintName
+RETURN constraintName, $subName, $objName
+LIMIT 1
+`
+
+// the exercise is only recorded if no conflicting permission was exercised before,
+// the first violated constraint is returned otherwise
+const ncExercisePermissionCypher = ncViolatedExerciseConstraintsCypher + `
+FOREACH (_ IN CASE WHEN size(violated) = 0 THEN [1] ELSE [] END |
+	MERGE (:ExercisedPermission{subject: $subName, object: $objName, permissionName: $permName}))
+WITH violated
+` + ncReturnViolatedExerciseConstraintCypher
+
+// a dry run only returns the first violated constraint, it can run in a read transaction
+const ncCheckExercisePermissionCypher = ncViolatedExerciseConstraintsCypher + ncReturnViolatedExerciseConstraintCypher
+
+func (f simpleCypherFactory) exercisePermission(req domain.ExercisePermissionReq) (string, map[string]interface{}) {
+	sodConstraints := make([]interface{}, len(req.SoDConstraints))
+	for i, constraint := range req.SoDConstraints {
+		sodConstraints[i] = map[string]interface{}{
+			"name":        constraint.Name(),
+			"conflicting": constraint.ConflictingPermissions(req.PermissionName),
+		}
+	}
+	cypher := ncExercisePermissionCypher
+	if req.DryRun {
+		cypher = ncCheckExercisePermissionCypher
+	}
+	return cypher,
 		map[string]interface{}{
-			"subName": req.Subject.Name(),
+			"subName":        req.Subject.Name(),
+			"objName":        req.Object.Name(),
+			"permName":       req.PermissionName,
+			"sodConstraints": sodConstraints}
+}
+
+// the cycles are searched for in the returned relations, see inheritanceCycles,
+// enumerating them with a variable length pattern would follow every path of the graph
+const ncGetInheritanceCyclesCypher = `
+MATCH (child:Resource)-[:%s]->(parent:Resource)
+RETURN child.name, collect(DISTINCT parent.name)
+`
+
+func (f simpleCypherFactory) getInheritanceCycles(req domain.GetInheritanceCyclesReq, relationTypes []domain.RelationType) (string, map[string]interface{}) {
+	return fmt.Sprintf(ncGetInheritanceCyclesCypher, relTypesPattern(relationTypes, nil)), map[string]interface{}{}
+}
+
+// only relations that propagate attributes are followed,
+// the distance of an ancestor is the length of the shortest path to it
+const ncGetAncestorAttributesCypher = `
+MATCH path=(r:Resource{name: $name})-[:%s*1..%d]->(ancestor:Resource)
+WITH ancestor, min(length(path)) AS distance
+OPTIONAL MATCH (ancestor)-[:HAS]->(attr:Attribute)
+RETURN ancestor.name, distance, collect(properties(attr))
+`
+
+func (f simpleCypherFactory) getAncestorAttributes(req domain.GetAncestorAttributesReq, relationTypes []domain.RelationType) (string, map[string]interface{}) {
+	return fmt.Sprintf(ncGetAncestorAttributesCypher, relTypesPattern(relationTypes, propagatesAttributes), f.maxDepth),
+		map[string]interface{}{
+			"name": req.Resource.Name()}
+}
+
+// relationship types and depths can't be parameters of a variable length pattern,
+// so related follows up to the maximum depth and filters the paths instead.
+// shareAncestor follows the relations that propagate permissions, like the policies do.
+// The indexes of the predicates that hold are returned, all others don't.
+const ncResolveGraphPredicatesCypher = `
+OPTIONAL MATCH (sub:Resource{name: $subName})
+OPTIONAL MATCH (obj:Resource{name: $objName})
+UNWIND $predicates AS predicate
+WITH predicate,
+     CASE predicate.source WHEN $subOperand THEN sub ELSE obj END AS source,
+     CASE predicate.target WHEN $subOperand THEN sub ELSE obj END AS target
+WHERE source IS NOT NULL AND target IS NOT NULL AND (
+    (predicate.function = $relatedFunction AND EXISTS {
+        MATCH path=(source)-[*1..%[1]d]->(target)
+        WHERE length(path) <= predicate.maxDepth
+        AND all(rel IN relationships(path) WHERE type(rel) = predicate.argument)
+    })
+    OR (predicate.function = $shareAncestorFunction AND EXISTS {
+        MATCH (source)-[:%[2]s*1..%[3]d]->(ancestor:Resource)<-[:%[2]s*1..%[3]d]-(target)
+        WHERE ancestor.name STARTS WITH predicate.argument + '/'
+    })
+)
+RETURN predicate.index
+`
+
+func (f simpleCypherFactory) resolveGraphPredicates(req domain.ResolveGraphPredicatesReq, relationTypes []domain.RelationType) (string, map[string]interface{}) {
+	predicates := make([]interface{}, len(req.Predicates))
+	for i, predicate := range req.Predicates {
+		predicates[i] = map[string]interface{}{
+			"index":    i,
+			"function": predicate.Function,
+			"source":   predicate.From,
+			"target":   predicate.To,
+			"argument": predicate.Argument,
+			"maxDepth": predicate.MaxDepth,
 		}
+	}
+	return fmt.Sprintf(ncResolveGraphPredicatesCypher, domain.MaxRelatedDepth, relTypesPattern(relationTypes, propagatesPermissions), f.maxDepth),
+		map[string]interface{}{
+			"subName":               req.Subject.Name(),
+			"objName":               req.Object.Name(),
+			"subOperand":            domain.GraphOperandSubject,
+			"relatedFunction":       domain.RelatedFunction,
+			"shareAncestorFunction": domain.ShareAncestorFunction,
+			"predicates":            predicates}
+}
+
+const ncPutRelationTypeCypher = `
+MERGE (relType:RelationType{name: $name})
+SET relType.permissions = $permissions, relType.attributes = $attributes
+`
+
+func (f simpleCypherFactory) putRelationType(req domain.PutRelationTypeReq) (string, map[string]interface{}) {
+	return ncPutRelationTypeCypher,
+		map[string]interface{}{
+			"name":        req.RelationType.Name(),
+			"permissions": req.RelationType.PropagatesPermissions(),
+			"attributes":  req.RelationType.PropagatesAttributes()}
+}
+
+// a relation type is only deleted if no relation uses it,
+// the returned count is verified before the transaction is committed
+const ncDeleteRelationTypeCypher = `
+MATCH (relType:RelationType{name: $name})
+OPTIONAL MATCH (:Resource)-[rel]->(:Resource)
+WHERE type(rel) = $name
+WITH relType, count(rel) AS relations
+FOREACH (_ IN CASE WHEN relations = 0 THEN [1] ELSE [] END | DELETE relType)
+RETURN relations
+`
+
+func (f simpleCypherFactory) deleteRelationType(req domain.DeleteRelationTypeReq) (string, map[string]interface{}) {
+	return ncDeleteRelationTypeCypher,
+		map[string]interface{}{
+			"name": req.Name}
+}
+
+const ncGetRelationTypesCypher = `
+MATCH (relType:RelationType)
+RETURN relType.name, relType.permissions, relType.attributes
+ORDER BY relType.name
+`
+
+func (f simpleCypherFactory) getRelationTypes(req domain.GetRelationTypesReq) (string, map[string]interface{}) {
+	return ncGetRelationTypesCypher, map[string]interface{}{}
 }
 
 // todo: sredi ovo
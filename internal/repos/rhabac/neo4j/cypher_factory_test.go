@@ -0,0 +1,96 @@
+package neo4j
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/c12s/oort/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRelTypesPattern(t *testing.T) {
+	memberOf, err := domain.NewRelationType("MEMBER_OF", true, false)
+	require.NoError(t, err)
+	ownedBy, err := domain.NewRelationType("OWNED_BY", false, true)
+	require.NoError(t, err)
+	relationTypes := []domain.RelationType{*memberOf, *ownedBy}
+
+	testCases := []struct {
+		relationTypes []domain.RelationType
+		filter        func(domain.RelationType) bool
+		pattern       string
+		description   string
+	}{
+		{
+			relationTypes: relationTypes,
+			filter:        nil,
+			pattern:       "MEMBER_OF|OWNED_BY",
+			description:   "no filter",
+		},
+		{
+			relationTypes: relationTypes,
+			filter:        propagatesPermissions,
+			pattern:       "MEMBER_OF",
+			description:   "filtered",
+		},
+		{
+			relationTypes: []domain.RelationType{*ownedBy},
+			filter:        propagatesPermissions,
+			pattern:       noRelationType,
+			description:   "no type passes the filter",
+		},
+		{
+			relationTypes: nil,
+			filter:        nil,
+			pattern:       noRelationType,
+			description:   "no types",
+		},
+	}
+	for _, testCase := range testCases {
+		c := testCase
+		t.Run(c.description, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, c.pattern, relTypesPattern(c.relationTypes, c.filter))
+		})
+	}
+}
+
+// variableLength matches the bounds of variable length patterns over relations between resources, e.g. *0..100
+var variableLength = regexp.MustCompile(`INHERITS_FROM\*(\d*)\.\.(\d*)\]`)
+
+func TestCypherFactoryTraversalsBounded(t *testing.T) {
+	const maxDepth = 7
+	factory := NewSimpleCypherFactory(maxDepth)
+	relationTypes := []domain.RelationType{domain.InheritsFromRelationType}
+	sub, err := domain.NewResourceFromName("user/u")
+	require.NoError(t, err)
+	obj, err := domain.NewResourceFromName("ns/n")
+	require.NoError(t, err)
+	constraint, err := domain.NewSoDConstraint("four-eyes", domain.SoDStatic, []string{"submit", "approve"})
+	require.NoError(t, err)
+
+	hierarchy, _ := factory.getEffectivePermissionsWithPriority(domain.GetPermissionHierarchyReq{Subject: *sub, Object: *obj, PermissionName: "read"}, relationTypes)
+	applicable, _ := factory.getApplicablePolicies(domain.GetApplicablePoliciesReq{Subject: *sub}, relationTypes)
+	ancestors, _ := factory.getAncestorAttributes(domain.GetAncestorAttributesReq{Resource: *sub}, relationTypes)
+	sod, _ := factory.verifySoDConstraint(domain.CreateSoDConstraintReq{Constraint: *constraint}, relationTypes)
+	predicates, _ := factory.resolveGraphPredicates(domain.ResolveGraphPredicatesReq{Subject: *sub, Object: *obj}, relationTypes)
+	authorizationData, _ := factory.getAuthorizationData(domain.GetAuthorizationDataReq{Subject: *sub, Object: *obj, PermissionName: "read"}, relationTypes)
+
+	for description, cypher := range map[string]string{
+		"permission hierarchy": hierarchy,
+		"applicable policies":  applicable,
+		"ancestor attributes":  ancestors,
+		"sod verification":     sod,
+		"graph predicates":     predicates,
+		"authorization data":   authorizationData,
+	} {
+		matches := variableLength.FindAllStringSubmatch(cypher, -1)
+		assert.NotEmpty(t, matches, description)
+		for _, match := range matches {
+			assert.NotEmpty(t, match[2], "%s: unbounded %s", description, match[0])
+		}
+	}
+	assert.Contains(t, hierarchy, "INHERITS_FROM*0..7]->(subParent)")
+	assert.Contains(t, predicates, "INHERITS_FROM*1..7]->(ancestor:Resource)")
+}
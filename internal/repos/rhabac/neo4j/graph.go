@@ -0,0 +1,116 @@
+package neo4j
+
+import (
+	"sort"
+
+	"github.com/c12s/oort/internal/domain"
+)
+
+// inheritanceCycles lists every cycle of the relations once, starting from its resource with the lowest name.
+// A cycle never leaves the strongly connected component of its resources,
+// so the search only follows relations within a component and resources outside of cycles are never searched from.
+func inheritanceCycles(parents map[string][]string) ([]domain.InheritanceCycle, error) {
+	components := stronglyConnectedComponents(parents)
+	names := make([]string, 0, len(components))
+	for name := range components {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	cycles := make([]domain.InheritanceCycle, 0)
+	for _, start := range names {
+		var visit func(path []string) error
+		visit = func(path []string) error {
+			for _, parent := range parents[path[len(path)-1]] {
+				if parent < start || components[parent] != components[start] {
+					continue
+				}
+				if parent == start {
+					resources, err := resourcesFromNames(path)
+					if err != nil {
+						return err
+					}
+					cycles = append(cycles, domain.InheritanceCycle{Resources: resources})
+					continue
+				}
+				if contains(path, parent) {
+					continue
+				}
+				if err := visit(append(path[:len(path):len(path)], parent)); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		if err := visit([]string{start}); err != nil {
+			return nil, err
+		}
+	}
+	return cycles, nil
+}
+
+// stronglyConnectedComponents numbers the components of the relations with Tarjan's algorithm,
+// resources without any relation aren't numbered
+func stronglyConnectedComponents(parents map[string][]string) map[string]int {
+	index := make(map[string]int)
+	lowLink := make(map[string]int)
+	onStack := make(map[string]bool)
+	stack := make([]string, 0)
+	components := make(map[string]int)
+	count := 0
+	var connect func(name string)
+	connect = func(name string) {
+		index[name] = len(index)
+		lowLink[name] = index[name]
+		stack = append(stack, name)
+		onStack[name] = true
+		for _, parent := range parents[name] {
+			if _, visited := index[parent]; !visited {
+				connect(parent)
+				lowLink[name] = min(lowLink[name], lowLink[parent])
+			} else if onStack[parent] {
+				lowLink[name] = min(lowLink[name], index[parent])
+			}
+		}
+		if lowLink[name] != index[name] {
+			return
+		}
+		component := count
+		count++
+		for {
+			member := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[member] = false
+			components[member] = component
+			if member == name {
+				return
+			}
+		}
+	}
+	for name := range parents {
+		if _, visited := index[name]; !visited {
+			connect(name)
+		}
+	}
+	return components
+}
+
+func resourcesFromNames(names []string) ([]domain.Resource, error) {
+	resources := make([]domain.Resource, len(names))
+	for i, name := range names {
+		resource, err := domain.NewResourceFromName(name)
+		if err != nil {
+			return nil, err
+		}
+		resources[i] = *resource
+	}
+	return resources, nil
+}
+
+func contains(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
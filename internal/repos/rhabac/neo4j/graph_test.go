@@ -0,0 +1,67 @@
+package neo4j
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInheritanceCycles(t *testing.T) {
+	testCases := []struct {
+		parents     map[string][]string
+		cycles      [][]string
+		description string
+	}{
+		{
+			parents: map[string][]string{
+				"user/a":  {"group/g", "root/"},
+				"user/b":  {"group/g", "root/"},
+				"group/g": {"root/"},
+			},
+			cycles:      [][]string{},
+			description: "no cycles",
+		},
+		{
+			parents: map[string][]string{
+				"user/a":  {"group/g"},
+				"group/g": {"user/b"},
+				"user/b":  {"user/a", "root/"},
+			},
+			cycles:      [][]string{{"group/g", "user/b", "user/a"}},
+			description: "one cycle starting from its lowest name",
+		},
+		{
+			parents: map[string][]string{
+				"a/1": {"a/2", "a/3"},
+				"a/2": {"a/1", "a/3"},
+				"a/3": {"a/1"},
+				"b/1": {"b/2", "a/1"},
+				"b/2": {"b/1"},
+			},
+			cycles: [][]string{
+				{"a/1", "a/2"},
+				{"a/1", "a/2", "a/3"},
+				{"a/1", "a/3"},
+				{"b/1", "b/2"},
+			},
+			description: "cycles of several components",
+		},
+	}
+	for _, testCase := range testCases {
+		c := testCase
+		t.Run(c.description, func(t *testing.T) {
+			t.Parallel()
+			cycles, err := inheritanceCycles(c.parents)
+			require.NoError(t, err)
+			names := make([][]string, len(cycles))
+			for i, cycle := range cycles {
+				names[i] = make([]string, len(cycle.Resources))
+				for j, resource := range cycle.Resources {
+					names[i][j] = resource.Name()
+				}
+			}
+			assert.ElementsMatch(t, c.cycles, names)
+		})
+	}
+}
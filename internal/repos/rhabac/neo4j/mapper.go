@@ -1,11 +1,14 @@
 package neo4j
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
 	"log"
+	"time"
 
 	"github.com/c12s/oort/internal/domain"
-	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
 )
 
 func getResource(cypherResult interface{}) *domain.Resource {
@@ -16,29 +19,101 @@ func getResource(cypherResult interface{}) *domain.Resource {
 	resource.Attributes = make([]domain.Attribute, 0)
 	attrs := cypherResult.([]*neo4j.Record)[0].Values[1].([]interface{})
 	for _, attr := range attrs {
-		a := attr.(map[string]interface{})
-		name := a["name"].(string)
-		kind := domain.AttributeKind(a["kind"].(int64))
-		value := a["value"]
-		if name == "id" {
-			resource.SetId(value.(string))
-		}
-		if name == "kind" {
-			resource.SetKind(value.(string))
-		}
-		attrId, err := domain.NewAttributeId(name)
+		attribute, err := attributeFromProps(attr)
 		if err != nil {
 			return nil
 		}
-		attribute, err := domain.NewAttribute(*attrId, kind, value)
-		if err != nil {
-			return nil
+		if attribute.Name() == "id" {
+			resource.SetId(attribute.Value().(string))
+		}
+		if attribute.Name() == "kind" {
+			resource.SetKind(attribute.Value().(string))
 		}
 		resource.Attributes = append(resource.Attributes, *attribute)
 	}
 	return resource
 }
 
+// attributes written before inheritance was introduced have no inheritable property
+func attributeFromProps(props interface{}) (*domain.Attribute, error) {
+	a, ok := props.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("invalid record elem type - attribute")
+	}
+	name, ok := a["name"].(string)
+	if !ok {
+		return nil, errors.New("invalid record elem type - attribute name")
+	}
+	kindInt, ok := a["kind"].(int64)
+	if !ok {
+		return nil, errors.New("invalid record elem type - attribute kind")
+	}
+	kind := domain.AttributeKind(kindInt)
+	value, err := attributeValue(kind, a["value"])
+	if err != nil {
+		return nil, err
+	}
+	attrId, err := domain.NewAttributeId(name)
+	if err != nil {
+		return nil, err
+	}
+	attribute, err := domain.NewAttribute(*attrId, kind, value)
+	if err != nil {
+		return nil, err
+	}
+	inheritable, _ := a["inheritable"].(bool)
+	attribute.SetInheritable(inheritable)
+	return attribute, nil
+}
+
+// list values are returned by the driver as []interface{},
+// so they are converted back to the type that matches the attribute kind
+func attributeValue(kind domain.AttributeKind, value interface{}) (interface{}, error) {
+	switch kind {
+	case domain.StringList:
+		values, ok := value.([]interface{})
+		if !ok {
+			return nil, errors.New("invalid attribute value - string list")
+		}
+		list := make([]string, len(values))
+		for i, elem := range values {
+			if list[i], ok = elem.(string); !ok {
+				return nil, errors.New("invalid attribute value - string list elem")
+			}
+		}
+		return list, nil
+	case domain.Int64List:
+		values, ok := value.([]interface{})
+		if !ok {
+			return nil, errors.New("invalid attribute value - int64 list")
+		}
+		list := make([]int64, len(values))
+		for i, elem := range values {
+			if list[i], ok = elem.(int64); !ok {
+				return nil, errors.New("invalid attribute value - int64 list elem")
+			}
+		}
+		return list, nil
+	case domain.Timestamp:
+		timestamp, ok := value.(time.Time)
+		if !ok {
+			return nil, errors.New("invalid attribute value - timestamp")
+		}
+		return timestamp, nil
+	case domain.Duration:
+		duration, ok := value.(neo4j.Duration)
+		if !ok {
+			return nil, errors.New("invalid attribute value - duration")
+		}
+		// months are never written, days are converted assuming 24 hours
+		return time.Duration(duration.Days)*24*time.Hour +
+			time.Duration(duration.Seconds)*time.Second +
+			time.Duration(duration.Nanos), nil
+	default:
+		return value, nil
+	}
+}
+
 func getHierarchy(cypherResult interface{}) (domain.PermissionHierarchy, error) {
 	records, ok := cypherResult.([]*neo4j.Record)
 	log.Println(len(records))
@@ -77,13 +152,31 @@ func getHierarchy(cypherResult interface{}) (domain.PermissionHierarchy, error)
 			return domain.PermissionHierarchy{}, errors.New("invalid record elem type - perm obj priority")
 		}
 		objPriority := domain.PermissionPriority(objPriorityInt)
+		onCondErrInt, ok := recordElems[5].(int64)
+		if !ok {
+			return domain.PermissionHierarchy{}, errors.New("invalid record elem type - perm on condition error")
+		}
+		onCondErr := domain.ConditionErrorPolicy(onCondErrInt)
+		permCondLangInt, ok := recordElems[6].(int64)
+		if !ok {
+			return domain.PermissionHierarchy{}, errors.New("invalid record elem type - perm cond language")
+		}
+		permCondLang := domain.ConditionLanguage(permCondLangInt)
+		permObligationsJson, ok := recordElems[7].(string)
+		if !ok {
+			return domain.PermissionHierarchy{}, errors.New("invalid record elem type - perm obligations")
+		}
+		permObligations, err := obligations(permObligationsJson)
+		if err != nil {
+			return domain.PermissionHierarchy{}, err
+		}
 
 		// kreiraj dozvolu
-		cond, err := domain.NewCondition(permCond)
+		cond, err := domain.NewConditionInLanguage(permCond, permCondLang)
 		if err != nil {
 			return domain.PermissionHierarchy{}, errors.New("invalid condition")
 		}
-		perm, err := domain.NewPermission(permName, permKind, *cond)
+		perm, err := domain.NewPermission(permName, permKind, *cond, onCondErr, permObligations)
 		if err != nil {
 			return nil, err
 		}
@@ -106,6 +199,94 @@ func getHierarchy(cypherResult interface{}) (domain.PermissionHierarchy, error)
 	return hierarchy, nil
 }
 
+// getAuthorizationData maps the single record of ncGetAuthorizationDataCypher,
+// the rows of every part are mapped like the records of the part's own cypher
+func getAuthorizationData(cypherResult interface{}) (domain.GetAuthorizationDataResp, error) {
+	records, ok := cypherResult.([]*neo4j.Record)
+	if !ok || len(records) == 0 {
+		return domain.GetAuthorizationDataResp{}, errors.New("invalid resp format")
+	}
+	parts := make([][]*neo4j.Record, len(records[0].Values))
+	for i, value := range records[0].Values {
+		part, err := partRecords(value)
+		if err != nil {
+			return domain.GetAuthorizationDataResp{}, err
+		}
+		parts[i] = part
+	}
+	if len(parts) != 10 {
+		return domain.GetAuthorizationDataResp{}, errors.New("invalid resp format")
+	}
+	hierarchy, err := getHierarchy(parts[0])
+	if err != nil {
+		return domain.GetAuthorizationDataResp{}, err
+	}
+	algorithm, err := getCombiningAlgorithm(parts[1])
+	if err != nil {
+		return domain.GetAuthorizationDataResp{}, err
+	}
+	subject, err := getResourceAttributes(parts[2], parts[3], parts[4])
+	if err != nil {
+		return domain.GetAuthorizationDataResp{}, err
+	}
+	object, err := getResourceAttributes(parts[5], parts[6], parts[7])
+	if err != nil {
+		return domain.GetAuthorizationDataResp{}, err
+	}
+	constraints, err := getSoDConstraints(parts[8])
+	if err != nil {
+		return domain.GetAuthorizationDataResp{}, err
+	}
+	breakGlass, err := getBreakGlass(parts[9])
+	if err != nil {
+		return domain.GetAuthorizationDataResp{}, err
+	}
+	return domain.GetAuthorizationDataResp{
+		Hierarchy:      hierarchy,
+		Algorithm:      algorithm,
+		Subject:        subject,
+		Object:         object,
+		SoDConstraints: constraints,
+		BreakGlass:     breakGlass,
+	}, nil
+}
+
+// partRecords turns the rows a subquery collected into records
+func partRecords(value interface{}) ([]*neo4j.Record, error) {
+	rows, ok := value.([]interface{})
+	if !ok {
+		return nil, errors.New("invalid record elem type - authorization data part")
+	}
+	records := make([]*neo4j.Record, len(rows))
+	for i, row := range rows {
+		values, ok := row.([]interface{})
+		if !ok {
+			return nil, errors.New("invalid record elem type - authorization data row")
+		}
+		records[i] = &neo4j.Record{Values: values}
+	}
+	return records, nil
+}
+
+func getResourceAttributes(resourceRecords, ancestorRecords, schemaRecords []*neo4j.Record) (domain.ResourceAttributes, error) {
+	if len(resourceRecords) == 0 {
+		return domain.ResourceAttributes{}, errors.New("resource not found")
+	}
+	resource := getResource(resourceRecords)
+	if resource == nil {
+		return domain.ResourceAttributes{}, errors.New("invalid record elem type - resource attributes")
+	}
+	ancestors, err := getAncestorAttributes(ancestorRecords)
+	if err != nil {
+		return domain.ResourceAttributes{}, err
+	}
+	schema, err := getAttributeSchema(schemaRecords)
+	if err != nil {
+		return domain.ResourceAttributes{}, err
+	}
+	return domain.ResourceAttributes{Attributes: resource.Attributes, Ancestors: ancestors, Schema: schema}, nil
+}
+
 func getPolicies(cypherResult interface{}) ([]domain.Policy, error) {
 	records, ok := cypherResult.([]*neo4j.Record)
 	log.Println(len(records))
@@ -139,3 +320,428 @@ func getPolicies(cypherResult interface{}) ([]domain.Policy, error) {
 	}
 	return policies, nil
 }
+
+func getExpiredPolicies(cypherResult interface{}) ([]domain.Policy, error) {
+	records, ok := cypherResult.([]*neo4j.Record)
+	if !ok {
+		return nil, errors.New("invalid resp format")
+	}
+
+	policies := make([]domain.Policy, 0, len(records))
+	for _, record := range records {
+		permName, ok := record.Values[0].(string)
+		if !ok {
+			return nil, errors.New("invalid record elem type - perm name")
+		}
+		subName, ok := record.Values[1].(string)
+		if !ok {
+			return nil, errors.New("invalid record elem type - subject name")
+		}
+		objName, ok := record.Values[2].(string)
+		if !ok {
+			return nil, errors.New("invalid record elem type - object name")
+		}
+		subject, err := domain.NewResourceFromName(subName)
+		if err != nil {
+			return nil, err
+		}
+		object, err := domain.NewResourceFromName(objName)
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, domain.Policy{
+			PermissionName: permName,
+			Subject:        *subject,
+			Object:         *object,
+		})
+	}
+	return policies, nil
+}
+
+func getBreakGlass(cypherResult interface{}) (*domain.BreakGlass, error) {
+	records, ok := cypherResult.([]*neo4j.Record)
+	if !ok {
+		return nil, errors.New("invalid resp format")
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+	recordElems := records[0].Values
+	id, ok := recordElems[0].(string)
+	if !ok {
+		return nil, errors.New("invalid record elem type - break glass id")
+	}
+	subName, ok := recordElems[1].(string)
+	if !ok {
+		return nil, errors.New("invalid record elem type - subject name")
+	}
+	objName, ok := recordElems[2].(string)
+	if !ok {
+		return nil, errors.New("invalid record elem type - object name")
+	}
+	permName, ok := recordElems[3].(string)
+	if !ok {
+		return nil, errors.New("invalid record elem type - perm name")
+	}
+	justification, ok := recordElems[4].(string)
+	if !ok {
+		return nil, errors.New("invalid record elem type - break glass justification")
+	}
+	grantedAt, ok := recordElems[5].(time.Time)
+	if !ok {
+		return nil, errors.New("invalid record elem type - break glass granted at")
+	}
+	expiresAt, ok := recordElems[6].(time.Time)
+	if !ok {
+		return nil, errors.New("invalid record elem type - break glass expires at")
+	}
+	subject, err := domain.NewResourceFromName(subName)
+	if err != nil {
+		return nil, err
+	}
+	object, err := domain.NewResourceFromName(objName)
+	if err != nil {
+		return nil, err
+	}
+	breakGlass := domain.RestoreBreakGlass(id, *subject, *object, permName, justification, grantedAt, expiresAt)
+	return &breakGlass, nil
+}
+
+func getBreakGlassUses(cypherResult interface{}) ([]domain.BreakGlassUse, error) {
+	records, ok := cypherResult.([]*neo4j.Record)
+	if !ok {
+		return nil, errors.New("invalid resp format")
+	}
+	uses := make([]domain.BreakGlassUse, 0, len(records))
+	for _, record := range records {
+		id, ok := record.Values[0].(string)
+		if !ok {
+			return nil, errors.New("invalid record elem type - break glass id")
+		}
+		usedAt, ok := record.Values[1].(time.Time)
+		if !ok {
+			return nil, errors.New("invalid record elem type - break glass used at")
+		}
+		uses = append(uses, domain.NewBreakGlassUse(id, usedAt))
+	}
+	return uses, nil
+}
+
+func getAttributeSchema(cypherResult interface{}) (*domain.AttributeSchema, error) {
+	records, ok := cypherResult.([]*neo4j.Record)
+	if !ok {
+		return nil, errors.New("invalid resp format")
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+	resourceKind, ok := records[0].Values[0].(string)
+	if !ok {
+		return nil, errors.New("invalid record elem type - schema resource kind")
+	}
+	defs, ok := records[0].Values[1].([]interface{})
+	if !ok {
+		return nil, errors.New("invalid record elem type - schema definitions")
+	}
+	definitions := make([]domain.AttributeDefinition, 0, len(defs))
+	for _, def := range defs {
+		d, ok := def.(map[string]interface{})
+		if !ok {
+			return nil, errors.New("invalid record elem type - schema definition")
+		}
+		name, ok := d["name"].(string)
+		if !ok {
+			return nil, errors.New("invalid record elem type - definition name")
+		}
+		kindInt, ok := d["kind"].(int64)
+		if !ok {
+			return nil, errors.New("invalid record elem type - definition kind")
+		}
+		kind := domain.AttributeKind(kindInt)
+		required, _ := d["required"].(bool)
+		var defaultValue interface{}
+		if d["default"] != nil {
+			value, err := attributeValue(kind, d["default"])
+			if err != nil {
+				return nil, err
+			}
+			defaultValue = value
+		}
+		definition, err := domain.NewAttributeDefinition(name, kind, required, defaultValue)
+		if err != nil {
+			return nil, err
+		}
+		definitions = append(definitions, *definition)
+	}
+	return domain.NewAttributeSchema(resourceKind, definitions)
+}
+
+func getCombiningAlgorithm(cypherResult interface{}) (domain.CombiningAlgorithm, error) {
+	records, ok := cypherResult.([]*neo4j.Record)
+	if !ok {
+		return domain.DefaultCombiningAlgorithm, errors.New("invalid resp format")
+	}
+	if len(records) == 0 {
+		return domain.DefaultCombiningAlgorithm, nil
+	}
+	algorithm, ok := records[0].Values[0].(int64)
+	if !ok {
+		return domain.DefaultCombiningAlgorithm, errors.New("invalid record elem type - combining algorithm")
+	}
+	return domain.CombiningAlgorithm(algorithm), nil
+}
+
+func obligations(marshalled string) ([]domain.Obligation, error) {
+	stored := make([]storedObligation, 0)
+	if err := json.Unmarshal([]byte(marshalled), &stored); err != nil {
+		return nil, errors.New("invalid obligations format")
+	}
+	obligations := make([]domain.Obligation, len(stored))
+	for i, s := range stored {
+		obligation, err := domain.NewObligation(s.Name, s.Params, s.Advice)
+		if err != nil {
+			return nil, err
+		}
+		obligations[i] = *obligation
+	}
+	return obligations, nil
+}
+
+func verifyNoImplicationCycle(records []*neo4j.Record) error {
+	if len(records) == 0 {
+		return errors.New("invalid resp format")
+	}
+	cycles, ok := records[0].Values[0].(int64)
+	if !ok {
+		return errors.New("invalid record elem type - implication cycles")
+	}
+	if cycles > 0 {
+		return domain.ErrImplicationCycle
+	}
+	return nil
+}
+
+func getSoDConstraints(cypherResult interface{}) ([]domain.SoDConstraint, error) {
+	records, ok := cypherResult.([]*neo4j.Record)
+	if !ok {
+		return nil, errors.New("invalid resp format")
+	}
+	constraints := make([]domain.SoDConstraint, 0, len(records))
+	for _, record := range records {
+		name, ok := record.Values[0].(string)
+		if !ok {
+			return nil, errors.New("invalid record elem type - sod constraint name")
+		}
+		kind, ok := record.Values[1].(int64)
+		if !ok {
+			return nil, errors.New("invalid record elem type - sod constraint kind")
+		}
+		values, ok := record.Values[2].([]interface{})
+		if !ok {
+			return nil, errors.New("invalid record elem type - sod constraint permissions")
+		}
+		permissions := make([]string, len(values))
+		for i, value := range values {
+			if permissions[i], ok = value.(string); !ok {
+				return nil, errors.New("invalid record elem type - sod constraint permission")
+			}
+		}
+		constraint, err := domain.NewSoDConstraint(name, domain.SoDKind(kind), permissions)
+		if err != nil {
+			return nil, err
+		}
+		constraints = append(constraints, *constraint)
+	}
+	return constraints, nil
+}
+
+// verifyNoSoDViolation rejects the transaction if it returned a violation of a separation of duty constraint,
+// transactions without constraints to verify return no records
+func verifyNoSoDViolation(records []*neo4j.Record) error {
+	if len(records) == 0 {
+		return nil
+	}
+	constraint, ok := records[0].Values[0].(string)
+	if !ok {
+		return errors.New("invalid record elem type - sod constraint name")
+	}
+	subName, ok := records[0].Values[1].(string)
+	if !ok {
+		return errors.New("invalid record elem type - subject name")
+	}
+	objName, ok := records[0].Values[2].(string)
+	if !ok {
+		return errors.New("invalid record elem type - object name")
+	}
+	subject, err := domain.NewResourceFromName(subName)
+	if err != nil {
+		return err
+	}
+	object, err := domain.NewResourceFromName(objName)
+	if err != nil {
+		return err
+	}
+	return domain.SoDViolation{Constraint: constraint, Subject: *subject, Object: *object}
+}
+
+// verifyNothing accepts any records, it is used for the statements that only mutate
+func verifyNothing([]*neo4j.Record) error {
+	return nil
+}
+
+func verifyRelation(records []*neo4j.Record) error {
+	if len(records) == 0 {
+		return errors.New("invalid resp format")
+	}
+	known, ok := records[0].Values[0].(bool)
+	if !ok {
+		return errors.New("invalid record elem type - relation type known")
+	}
+	cycle, ok := records[0].Values[1].(bool)
+	if !ok {
+		return errors.New("invalid record elem type - inheritance cycle")
+	}
+	depth, ok := records[0].Values[2].(int64)
+	if !ok {
+		return errors.New("invalid record elem type - inheritance depth")
+	}
+	maxDepth, ok := records[0].Values[3].(int64)
+	if !ok {
+		return errors.New("invalid record elem type - inheritance max depth")
+	}
+	if !known {
+		return domain.ErrUnknownRelationType
+	}
+	if cycle {
+		return domain.ErrInheritanceCycle
+	}
+	if depth > maxDepth {
+		return fmt.Errorf("%w: %d > %d", domain.ErrInheritanceDepth, depth, maxDepth)
+	}
+	return nil
+}
+
+// getParents maps the name of every related resource to the names of the resources it is related to
+func getParents(cypherResult interface{}) (map[string][]string, error) {
+	records, ok := cypherResult.([]*neo4j.Record)
+	if !ok {
+		return nil, errors.New("invalid resp format")
+	}
+	parents := make(map[string][]string, len(records))
+	for _, record := range records {
+		childName, ok := record.Values[0].(string)
+		if !ok {
+			return nil, errors.New("invalid record elem type - child resource name")
+		}
+		parentNames, ok := record.Values[1].([]interface{})
+		if !ok {
+			return nil, errors.New("invalid record elem type - parent resources")
+		}
+		for _, parentName := range parentNames {
+			name, ok := parentName.(string)
+			if !ok {
+				return nil, errors.New("invalid record elem type - parent resource name")
+			}
+			parents[childName] = append(parents[childName], name)
+		}
+	}
+	return parents, nil
+}
+
+func getAncestorAttributes(cypherResult interface{}) ([]domain.AncestorAttributes, error) {
+	records, ok := cypherResult.([]*neo4j.Record)
+	if !ok {
+		return nil, errors.New("invalid resp format")
+	}
+	ancestors := make([]domain.AncestorAttributes, 0, len(records))
+	for _, record := range records {
+		name, ok := record.Values[0].(string)
+		if !ok {
+			return nil, errors.New("invalid record elem type - ancestor name")
+		}
+		distance, ok := record.Values[1].(int64)
+		if !ok {
+			return nil, errors.New("invalid record elem type - ancestor distance")
+		}
+		props, ok := record.Values[2].([]interface{})
+		if !ok {
+			return nil, errors.New("invalid record elem type - ancestor attributes")
+		}
+		ancestor, err := domain.NewResourceFromName(name)
+		if err != nil {
+			return nil, err
+		}
+		attrs := make([]domain.Attribute, 0, len(props))
+		for _, prop := range props {
+			attr, err := attributeFromProps(prop)
+			if err != nil {
+				return nil, err
+			}
+			attrs = append(attrs, *attr)
+		}
+		ancestors = append(ancestors, domain.AncestorAttributes{Ancestor: *ancestor, Distance: int(distance), Attributes: attrs})
+	}
+	return ancestors, nil
+}
+
+func getGraphRelations(cypherResult interface{}, predicates []domain.GraphPredicate) (domain.GraphRelations, error) {
+	records, ok := cypherResult.([]*neo4j.Record)
+	if !ok {
+		return nil, errors.New("invalid resp format")
+	}
+	relations := make(domain.GraphRelations, len(predicates))
+	for _, predicate := range predicates {
+		relations[predicate] = false
+	}
+	for _, record := range records {
+		index, ok := record.Values[0].(int64)
+		if !ok || index < 0 || int(index) >= len(predicates) {
+			return nil, errors.New("invalid record elem type - predicate index")
+		}
+		relations[predicates[index]] = true
+	}
+	return relations, nil
+}
+
+// deleting a relation type that doesn't exist returns no records
+func verifyRelationTypeUnused(records []*neo4j.Record) error {
+	if len(records) == 0 {
+		return nil
+	}
+	relations, ok := records[0].Values[0].(int64)
+	if !ok {
+		return errors.New("invalid record elem type - relation count")
+	}
+	if relations > 0 {
+		return domain.ErrRelationTypeInUse
+	}
+	return nil
+}
+
+func getRelationTypes(cypherResult interface{}) ([]domain.RelationType, error) {
+	records, ok := cypherResult.([]*neo4j.Record)
+	if !ok {
+		return nil, errors.New("invalid resp format")
+	}
+	relationTypes := []domain.RelationType{domain.InheritsFromRelationType}
+	for _, record := range records {
+		name, ok := record.Values[0].(string)
+		if !ok {
+			return nil, errors.New("invalid record elem type - relation type name")
+		}
+		permissions, ok := record.Values[1].(bool)
+		if !ok {
+			return nil, errors.New("invalid record elem type - relation type permissions")
+		}
+		attributes, ok := record.Values[2].(bool)
+		if !ok {
+			return nil, errors.New("invalid record elem type - relation type attributes")
+		}
+		relationType, err := domain.NewRelationType(name, permissions, attributes)
+		if err != nil {
+			return nil, err
+		}
+		relationTypes = append(relationTypes, *relationType)
+	}
+	return relationTypes, nil
+}
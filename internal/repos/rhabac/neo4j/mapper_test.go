@@ -0,0 +1,97 @@
+package neo4j
+
+import (
+	"testing"
+	"time"
+
+	"github.com/c12s/oort/internal/domain"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// authorizationDataValues returns the parts of an ncGetAuthorizationDataCypher record the way the driver returns them
+func authorizationDataValues() []interface{} {
+	grantedAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	tier := map[string]interface{}{"name": "tier", "kind": int64(domain.String), "value": "gold", "inheritable": true}
+	return []interface{}{
+		[]interface{}{[]interface{}{"read", int64(domain.PermissionKindAllow), "", int64(-1), int64(0), int64(0), int64(0), "[]"}},
+		[]interface{}{[]interface{}{int64(domain.CombiningAlgorithmPermitOverrides)}},
+		[]interface{}{[]interface{}{"user/u", []interface{}{map[string]interface{}{"name": "age", "kind": int64(domain.Int64), "value": int64(30)}}}},
+		[]interface{}{[]interface{}{"org/o", int64(1), []interface{}{tier}}},
+		[]interface{}{},
+		[]interface{}{[]interface{}{"ns/n", []interface{}{}}},
+		[]interface{}{},
+		[]interface{}{[]interface{}{"ns", []interface{}{map[string]interface{}{"name": "region", "kind": int64(domain.String), "required": false, "default": "eu"}}}},
+		[]interface{}{[]interface{}{"four-eyes", int64(domain.SoDDynamic), []interface{}{"read", "write"}}},
+		[]interface{}{[]interface{}{"bg", "user/u", "ns/n", "read", "incident", grantedAt, grantedAt.Add(time.Hour)}},
+	}
+}
+
+func TestGetAuthorizationData(t *testing.T) {
+	data, err := getAuthorizationData([]*neo4j.Record{{Values: authorizationDataValues()}})
+	require.NoError(t, err)
+
+	require.Contains(t, data.Hierarchy, domain.PermissionPriority(-1))
+	assert.Len(t, data.Hierarchy[-1][0], 1)
+	assert.Equal(t, domain.CombiningAlgorithmPermitOverrides, data.Algorithm)
+	require.Len(t, data.Subject.Attributes, 1)
+	assert.Equal(t, "age", data.Subject.Attributes[0].Name())
+	require.Len(t, data.Subject.Ancestors, 1)
+	assert.Equal(t, "org/o", data.Subject.Ancestors[0].Ancestor.Name())
+	assert.True(t, data.Subject.Ancestors[0].Attributes[0].Inheritable())
+	assert.Nil(t, data.Subject.Schema)
+	assert.Empty(t, data.Object.Attributes)
+	require.NotNil(t, data.Object.Schema)
+	assert.Equal(t, "ns", data.Object.Schema.ResourceKind())
+	require.Len(t, data.SoDConstraints, 1)
+	assert.Equal(t, "four-eyes", data.SoDConstraints[0].Name())
+	require.NotNil(t, data.BreakGlass)
+	assert.Equal(t, "bg", data.BreakGlass.Id())
+}
+
+func TestGetAuthorizationDataInvalid(t *testing.T) {
+	testCases := []struct {
+		values      func() []interface{}
+		description string
+	}{
+		{
+			values: func() []interface{} {
+				values := authorizationDataValues()
+				values[2] = []interface{}{}
+				return values
+			},
+			description: "unknown subject",
+		},
+		{
+			values: func() []interface{} {
+				values := authorizationDataValues()
+				values[5] = []interface{}{}
+				return values
+			},
+			description: "unknown object",
+		},
+		{
+			values: func() []interface{} {
+				return authorizationDataValues()[:9]
+			},
+			description: "missing part",
+		},
+		{
+			values: func() []interface{} {
+				values := authorizationDataValues()
+				values[8] = "four-eyes"
+				return values
+			},
+			description: "part isn't a list",
+		},
+	}
+	for _, testCase := range testCases {
+		c := testCase
+		t.Run(c.description, func(t *testing.T) {
+			t.Parallel()
+			_, err := getAuthorizationData([]*neo4j.Record{{Values: c.values()}})
+			assert.Error(t, err)
+		})
+	}
+}
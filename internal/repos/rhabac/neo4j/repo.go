@@ -3,9 +3,10 @@ package neo4j
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"github.com/c12s/oort/internal/domain"
-	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
 	"go.opentelemetry.io/otel"
 )
 
@@ -77,20 +78,36 @@ func (store RHABACRepo) DeleteAttribute(ctx context.Context, req domain.DeleteAt
 	return domain.AdministrationResp{Error: err}
 }
 
-func (store RHABACRepo) CreateInheritanceRel(ctx context.Context, req domain.CreateInheritanceRelReq) domain.AdministrationResp {
+func (store RHABACRepo) CreateRelation(ctx context.Context, req domain.CreateRelationReq) domain.AdministrationResp {
 	tracer := otel.Tracer("oort.neo4j.repo")
-	ctx, span := tracer.Start(ctx, "RHABACRepo.CreateInheritanceRel")
+	ctx, span := tracer.Start(ctx, "RHABACRepo.CreateRelation")
 	defer span.End()
-	cypher, params := store.factory.createInheritanceRel(req)
-	err := store.manager.WriteTransaction(ctx, cypher, params)
+	_, err := store.writeWithRelationTypes(ctx, func(relationTypes []domain.RelationType) ([][]VerifiedStatement, int, error) {
+		statements, err := store.createRelationStatements(req, relationTypes)
+		return [][]VerifiedStatement{statements}, 0, err
+	})
 	return domain.AdministrationResp{Error: err}
 }
 
-func (store RHABACRepo) DeleteInheritanceRel(ctx context.Context, req domain.DeleteInheritanceRelReq) domain.AdministrationResp {
+func (store RHABACRepo) createRelationStatements(req domain.CreateRelationReq, relationTypes []domain.RelationType) ([]VerifiedStatement, error) {
+	// the type is formatted into the statement
+	if err := domain.ValidateRelationTypeName(req.Type); err != nil {
+		return nil, err
+	}
+	cypher, params := store.factory.createRelation(req, relationTypes)
+	statements := []VerifiedStatement{{Cypher: cypher, Params: params, Verify: verifyRelation}}
+	if len(req.SoDConstraints) > 0 {
+		cypher, params := store.factory.verifyRelationSoD(req, relationTypes)
+		statements = append(statements, VerifiedStatement{Cypher: cypher, Params: params, Verify: verifyNoSoDViolation})
+	}
+	return statements, nil
+}
+
+func (store RHABACRepo) DeleteRelation(ctx context.Context, req domain.DeleteRelationReq) domain.AdministrationResp {
 	tracer := otel.Tracer("oort.neo4j.repo")
-	ctx, span := tracer.Start(ctx, "RHABACRepo.DeleteInheritanceRel")
+	ctx, span := tracer.Start(ctx, "RHABACRepo.DeleteRelation")
 	defer span.End()
-	cypher, params := store.factory.deleteInheritanceRel(req)
+	cypher, params := store.factory.deleteRelation(req)
 	err := store.manager.WriteTransaction(ctx, cypher, params)
 	return domain.AdministrationResp{Error: err}
 }
@@ -99,11 +116,21 @@ func (store RHABACRepo) CreatePolicy(ctx context.Context, req domain.CreatePolic
 	tracer := otel.Tracer("oort.neo4j.repo")
 	ctx, span := tracer.Start(ctx, "RHABACRepo.CreatePolicy")
 	defer span.End()
-	cypher, params := store.factory.createPolicy(req)
-	err := store.manager.WriteTransaction(ctx, cypher, params)
+	_, err := store.writeWithRelationTypes(ctx, func(relationTypes []domain.RelationType) ([][]VerifiedStatement, int, error) {
+		return [][]VerifiedStatement{store.createPolicyStatements(req, relationTypes)}, -1, nil
+	})
 	return domain.AdministrationResp{Error: err}
 }
 
+func (store RHABACRepo) createPolicyStatements(req domain.CreatePolicyReq, relationTypes []domain.RelationType) []VerifiedStatement {
+	statements := mutation(store.factory.createPolicy(req))
+	if len(req.SoDConstraints) > 0 {
+		cypher, params := store.factory.verifyPolicySoD(req, relationTypes)
+		statements = append(statements, VerifiedStatement{Cypher: cypher, Params: params, Verify: verifyNoSoDViolation})
+	}
+	return statements
+}
+
 func (store RHABACRepo) DeletePolicy(ctx context.Context, req domain.DeletePolicyReq) domain.AdministrationResp {
 	tracer := otel.Tracer("oort.neo4j.repo")
 	ctx, span := tracer.Start(ctx, "RHABACRepo.DeletePolicy")
@@ -117,8 +144,9 @@ func (store RHABACRepo) GetPermissionHierarchy(ctx context.Context, req domain.G
 	tracer := otel.Tracer("oort.neo4j.repo")
 	ctx, span := tracer.Start(ctx, "RHABACRepo.GetPermissionHierarchy")
 	defer span.End()
-	cypher, params := store.factory.getEffectivePermissionsWithPriority(req)
-	records, err := store.manager.ReadTransaction(ctx, cypher, params)
+	records, err := store.readWithRelationTypes(ctx, func(relationTypes []domain.RelationType) (string, map[string]interface{}) {
+		return store.factory.getEffectivePermissionsWithPriority(req, relationTypes)
+	})
 	if err != nil {
 		return domain.GetPermissionHierarchyResp{Hierarchy: nil, Error: err}
 	}
@@ -127,15 +155,414 @@ func (store RHABACRepo) GetPermissionHierarchy(ctx context.Context, req domain.G
 	return domain.GetPermissionHierarchyResp{Hierarchy: hierarchy, Error: err}
 }
 
+// GetAuthorizationData reads the data with one query, after the relation types it follows are read in the same transaction
+func (store RHABACRepo) GetAuthorizationData(ctx context.Context, req domain.GetAuthorizationDataReq) domain.GetAuthorizationDataResp {
+	tracer := otel.Tracer("oort.neo4j.repo")
+	ctx, span := tracer.Start(ctx, "RHABACRepo.GetAuthorizationData")
+	defer span.End()
+	records, err := store.readWithRelationTypes(ctx, func(relationTypes []domain.RelationType) (string, map[string]interface{}) {
+		return store.factory.getAuthorizationData(req, relationTypes)
+	})
+	if err != nil {
+		return domain.GetAuthorizationDataResp{Error: err}
+	}
+	resp, err := getAuthorizationData(records)
+	if err != nil {
+		return domain.GetAuthorizationDataResp{Error: err}
+	}
+	return resp
+}
+
 func (store RHABACRepo) GetApplicablePolicies(ctx context.Context, req domain.GetApplicablePoliciesReq) domain.GetApplicablePoliciesResp {
 	tracer := otel.Tracer("oort.neo4j.repo")
 	ctx, span := tracer.Start(ctx, "RHABACRepo.GetApplicablePolicies")
 	defer span.End()
-	cypher, params := store.factory.getApplicablePolicies(req)
-	records, err := store.manager.ReadTransaction(ctx, cypher, params)
+	records, err := store.readWithRelationTypes(ctx, func(relationTypes []domain.RelationType) (string, map[string]interface{}) {
+		return store.factory.getApplicablePolicies(req, relationTypes)
+	})
 	if err != nil {
 		return domain.GetApplicablePoliciesResp{Policies: nil, Error: err}
 	}
 	policies, err := getPolicies(records)
 	return domain.GetApplicablePoliciesResp{Policies: policies, Error: err}
 }
+
+func (store RHABACRepo) PutAttributeSchema(ctx context.Context, req domain.PutAttributeSchemaReq) domain.AdministrationResp {
+	tracer := otel.Tracer("oort.neo4j.repo")
+	ctx, span := tracer.Start(ctx, "RHABACRepo.PutAttributeSchema")
+	defer span.End()
+	cypher, params := store.factory.putAttributeSchema(req)
+	err := store.manager.WriteTransaction(ctx, cypher, params)
+	return domain.AdministrationResp{Error: err}
+}
+
+func (store RHABACRepo) DeleteAttributeSchema(ctx context.Context, req domain.DeleteAttributeSchemaReq) domain.AdministrationResp {
+	tracer := otel.Tracer("oort.neo4j.repo")
+	ctx, span := tracer.Start(ctx, "RHABACRepo.DeleteAttributeSchema")
+	defer span.End()
+	cypher, params := store.factory.deleteAttributeSchema(req)
+	err := store.manager.WriteTransaction(ctx, cypher, params)
+	return domain.AdministrationResp{Error: err}
+}
+
+func (store RHABACRepo) GetAttributeSchema(ctx context.Context, req domain.GetAttributeSchemaReq) domain.GetAttributeSchemaResp {
+	tracer := otel.Tracer("oort.neo4j.repo")
+	ctx, span := tracer.Start(ctx, "RHABACRepo.GetAttributeSchema")
+	defer span.End()
+	cypher, params := store.factory.getAttributeSchema(req)
+	records, err := store.manager.ReadTransaction(ctx, cypher, params)
+	if err != nil {
+		return domain.GetAttributeSchemaResp{Error: err}
+	}
+	schema, err := getAttributeSchema(records)
+	return domain.GetAttributeSchemaResp{Schema: schema, Error: err}
+}
+
+func (store RHABACRepo) SetCombiningAlgorithm(ctx context.Context, req domain.SetCombiningAlgorithmReq) domain.AdministrationResp {
+	tracer := otel.Tracer("oort.neo4j.repo")
+	ctx, span := tracer.Start(ctx, "RHABACRepo.SetCombiningAlgorithm")
+	defer span.End()
+	cypher, params := store.factory.setCombiningAlgorithm(req)
+	err := store.manager.WriteTransaction(ctx, cypher, params)
+	return domain.AdministrationResp{Error: err}
+}
+
+func (store RHABACRepo) GetCombiningAlgorithm(ctx context.Context, req domain.GetCombiningAlgorithmReq) domain.GetCombiningAlgorithmResp {
+	tracer := otel.Tracer("oort.neo4j.repo")
+	ctx, span := tracer.Start(ctx, "RHABACRepo.GetCombiningAlgorithm")
+	defer span.End()
+	cypher, params := store.factory.getCombiningAlgorithm(req)
+	records, err := store.manager.ReadTransaction(ctx, cypher, params)
+	if err != nil {
+		return domain.GetCombiningAlgorithmResp{Error: err}
+	}
+	algorithm, err := getCombiningAlgorithm(records)
+	return domain.GetCombiningAlgorithmResp{Algorithm: algorithm, Error: err}
+}
+
+func (store RHABACRepo) CreatePermissionImplication(ctx context.Context, req domain.CreatePermissionImplicationReq) domain.AdministrationResp {
+	tracer := otel.Tracer("oort.neo4j.repo")
+	ctx, span := tracer.Start(ctx, "RHABACRepo.CreatePermissionImplication")
+	defer span.End()
+	cypher, params := store.factory.createPermissionImplication(req)
+	err := store.manager.VerifiedWriteTransaction(ctx, cypher, params, verifyNoImplicationCycle)
+	return domain.AdministrationResp{Error: err}
+}
+
+func (store RHABACRepo) DeletePermissionImplication(ctx context.Context, req domain.DeletePermissionImplicationReq) domain.AdministrationResp {
+	tracer := otel.Tracer("oort.neo4j.repo")
+	ctx, span := tracer.Start(ctx, "RHABACRepo.DeletePermissionImplication")
+	defer span.End()
+	cypher, params := store.factory.deletePermissionImplication(req)
+	err := store.manager.WriteTransaction(ctx, cypher, params)
+	return domain.AdministrationResp{Error: err}
+}
+
+func (store RHABACRepo) DeleteExpiredPolicies(ctx context.Context, req domain.DeleteExpiredPoliciesReq) domain.DeleteExpiredPoliciesResp {
+	tracer := otel.Tracer("oort.neo4j.repo")
+	ctx, span := tracer.Start(ctx, "RHABACRepo.DeleteExpiredPolicies")
+	defer span.End()
+	cypher, params := store.factory.deleteExpiredPolicies(req)
+	records, err := store.manager.CollectingWriteTransaction(ctx, cypher, params)
+	if err != nil {
+		return domain.DeleteExpiredPoliciesResp{Error: err}
+	}
+	policies, err := getExpiredPolicies(records)
+	return domain.DeleteExpiredPoliciesResp{Policies: policies, Error: err}
+}
+
+func (store RHABACRepo) CreateBreakGlass(ctx context.Context, req domain.CreateBreakGlassReq) domain.AdministrationResp {
+	tracer := otel.Tracer("oort.neo4j.repo")
+	ctx, span := tracer.Start(ctx, "RHABACRepo.CreateBreakGlass")
+	defer span.End()
+	cypher, params := store.factory.createBreakGlass(req)
+	err := store.manager.WriteTransaction(ctx, cypher, params)
+	return domain.AdministrationResp{Error: err}
+}
+
+func (store RHABACRepo) GetActiveBreakGlass(ctx context.Context, req domain.GetActiveBreakGlassReq) domain.GetActiveBreakGlassResp {
+	tracer := otel.Tracer("oort.neo4j.repo")
+	ctx, span := tracer.Start(ctx, "RHABACRepo.GetActiveBreakGlass")
+	defer span.End()
+	cypher, params := store.factory.getActiveBreakGlass(req)
+	records, err := store.manager.ReadTransaction(ctx, cypher, params)
+	if err != nil {
+		return domain.GetActiveBreakGlassResp{Error: err}
+	}
+	breakGlass, err := getBreakGlass(records)
+	return domain.GetActiveBreakGlassResp{BreakGlass: breakGlass, Error: err}
+}
+
+func (store RHABACRepo) CreateBreakGlassUse(ctx context.Context, req domain.CreateBreakGlassUseReq) domain.AdministrationResp {
+	tracer := otel.Tracer("oort.neo4j.repo")
+	ctx, span := tracer.Start(ctx, "RHABACRepo.CreateBreakGlassUse")
+	defer span.End()
+	cypher, params := store.factory.createBreakGlassUse(req)
+	err := store.manager.WriteTransaction(ctx, cypher, params)
+	return domain.AdministrationResp{Error: err}
+}
+
+func (store RHABACRepo) GetBreakGlassUses(ctx context.Context, req domain.GetBreakGlassUsesReq) domain.GetBreakGlassUsesResp {
+	tracer := otel.Tracer("oort.neo4j.repo")
+	ctx, span := tracer.Start(ctx, "RHABACRepo.GetBreakGlassUses")
+	defer span.End()
+	cypher, params := store.factory.getBreakGlassUses(req)
+	records, err := store.manager.ReadTransaction(ctx, cypher, params)
+	if err != nil {
+		return domain.GetBreakGlassUsesResp{Error: err}
+	}
+	uses, err := getBreakGlassUses(records)
+	return domain.GetBreakGlassUsesResp{Uses: uses, Error: err}
+}
+
+func (store RHABACRepo) CreateSoDConstraint(ctx context.Context, req domain.CreateSoDConstraintReq) domain.AdministrationResp {
+	tracer := otel.Tracer("oort.neo4j.repo")
+	ctx, span := tracer.Start(ctx, "RHABACRepo.CreateSoDConstraint")
+	defer span.End()
+	_, err := store.writeWithRelationTypes(ctx, func(relationTypes []domain.RelationType) ([][]VerifiedStatement, int, error) {
+		return [][]VerifiedStatement{store.createSoDConstraintStatements(req, relationTypes)}, -1, nil
+	})
+	return domain.AdministrationResp{Error: err}
+}
+
+func (store RHABACRepo) createSoDConstraintStatements(req domain.CreateSoDConstraintReq, relationTypes []domain.RelationType) []VerifiedStatement {
+	statements := mutation(store.factory.createSoDConstraint(req))
+	if req.Constraint.Kind() == domain.SoDStatic {
+		cypher, params := store.factory.verifySoDConstraint(req, relationTypes)
+		statements = append(statements, VerifiedStatement{Cypher: cypher, Params: params, Verify: verifyNoSoDViolation})
+	}
+	return statements
+}
+
+func (store RHABACRepo) DeleteSoDConstraint(ctx context.Context, req domain.DeleteSoDConstraintReq) domain.AdministrationResp {
+	tracer := otel.Tracer("oort.neo4j.repo")
+	ctx, span := tracer.Start(ctx, "RHABACRepo.DeleteSoDConstraint")
+	defer span.End()
+	cypher, params := store.factory.deleteSoDConstraint(req)
+	err := store.manager.WriteTransaction(ctx, cypher, params)
+	return domain.AdministrationResp{Error: err}
+}
+
+func (store RHABACRepo) GetSoDConstraints(ctx context.Context, req domain.GetSoDConstraintsReq) domain.GetSoDConstraintsResp {
+	tracer := otel.Tracer("oort.neo4j.repo")
+	ctx, span := tracer.Start(ctx, "RHABACRepo.GetSoDConstraints")
+	defer span.End()
+	cypher, params := store.factory.getSoDConstraints(req)
+	records, err := store.manager.ReadTransaction(ctx, cypher, params)
+	if err != nil {
+		return domain.GetSoDConstraintsResp{Error: err}
+	}
+	constraints, err := getSoDConstraints(records)
+	return domain.GetSoDConstraintsResp{Constraints: constraints, Error: err}
+}
+
+func (store RHABACRepo) ExercisePermission(ctx context.Context, req domain.ExercisePermissionReq) domain.AdministrationResp {
+	tracer := otel.Tracer("oort.neo4j.repo")
+	ctx, span := tracer.Start(ctx, "RHABACRepo.ExercisePermission")
+	defer span.End()
+	cypher, params := store.factory.exercisePermission(req)
+	if req.DryRun {
+		records, err := store.manager.ReadTransaction(ctx, cypher, params)
+		if err != nil {
+			return domain.AdministrationResp{Error: err}
+		}
+		return domain.AdministrationResp{Error: verifyNoSoDViolation(records.([]*neo4j.Record))}
+	}
+	err := store.manager.VerifiedWriteTransaction(ctx, cypher, params, verifyNoSoDViolation)
+	return domain.AdministrationResp{Error: err}
+}
+
+func (store RHABACRepo) GetInheritanceCycles(ctx context.Context, req domain.GetInheritanceCyclesReq) domain.GetInheritanceCyclesResp {
+	tracer := otel.Tracer("oort.neo4j.repo")
+	ctx, span := tracer.Start(ctx, "RHABACRepo.GetInheritanceCycles")
+	defer span.End()
+	records, err := store.readWithRelationTypes(ctx, func(relationTypes []domain.RelationType) (string, map[string]interface{}) {
+		return store.factory.getInheritanceCycles(req, relationTypes)
+	})
+	if err != nil {
+		return domain.GetInheritanceCyclesResp{Error: err}
+	}
+	parents, err := getParents(records)
+	if err != nil {
+		return domain.GetInheritanceCyclesResp{Error: err}
+	}
+	cycles, err := inheritanceCycles(parents)
+	return domain.GetInheritanceCyclesResp{Cycles: cycles, Error: err}
+}
+
+func (store RHABACRepo) GetAncestorAttributes(ctx context.Context, req domain.GetAncestorAttributesReq) domain.GetAncestorAttributesResp {
+	tracer := otel.Tracer("oort.neo4j.repo")
+	ctx, span := tracer.Start(ctx, "RHABACRepo.GetAncestorAttributes")
+	defer span.End()
+	records, err := store.readWithRelationTypes(ctx, func(relationTypes []domain.RelationType) (string, map[string]interface{}) {
+		return store.factory.getAncestorAttributes(req, relationTypes)
+	})
+	if err != nil {
+		return domain.GetAncestorAttributesResp{Error: err}
+	}
+	ancestors, err := getAncestorAttributes(records)
+	return domain.GetAncestorAttributesResp{Ancestors: ancestors, Error: err}
+}
+
+func (store RHABACRepo) ResolveGraphPredicates(ctx context.Context, req domain.ResolveGraphPredicatesReq) domain.ResolveGraphPredicatesResp {
+	tracer := otel.Tracer("oort.neo4j.repo")
+	ctx, span := tracer.Start(ctx, "RHABACRepo.ResolveGraphPredicates")
+	defer span.End()
+	records, err := store.readWithRelationTypes(ctx, func(relationTypes []domain.RelationType) (string, map[string]interface{}) {
+		return store.factory.resolveGraphPredicates(req, relationTypes)
+	})
+	if err != nil {
+		return domain.ResolveGraphPredicatesResp{Error: err}
+	}
+	relations, err := getGraphRelations(records, req.Predicates)
+	return domain.ResolveGraphPredicatesResp{Relations: relations, Error: err}
+}
+
+func (store RHABACRepo) PutRelationType(ctx context.Context, req domain.PutRelationTypeReq) domain.AdministrationResp {
+	tracer := otel.Tracer("oort.neo4j.repo")
+	ctx, span := tracer.Start(ctx, "RHABACRepo.PutRelationType")
+	defer span.End()
+	cypher, params := store.factory.putRelationType(req)
+	err := store.manager.WriteTransaction(ctx, cypher, params)
+	return domain.AdministrationResp{Error: err}
+}
+
+func (store RHABACRepo) DeleteRelationType(ctx context.Context, req domain.DeleteRelationTypeReq) domain.AdministrationResp {
+	tracer := otel.Tracer("oort.neo4j.repo")
+	ctx, span := tracer.Start(ctx, "RHABACRepo.DeleteRelationType")
+	defer span.End()
+	cypher, params := store.factory.deleteRelationType(req)
+	err := store.manager.VerifiedWriteTransaction(ctx, cypher, params, verifyRelationTypeUnused)
+	return domain.AdministrationResp{Error: err}
+}
+
+func (store RHABACRepo) GetRelationTypes(ctx context.Context, req domain.GetRelationTypesReq) domain.GetRelationTypesResp {
+	tracer := otel.Tracer("oort.neo4j.repo")
+	ctx, span := tracer.Start(ctx, "RHABACRepo.GetRelationTypes")
+	defer span.End()
+	cypher, params := store.factory.getRelationTypes(req)
+	records, err := store.manager.ReadTransaction(ctx, cypher, params)
+	if err != nil {
+		return domain.GetRelationTypesResp{Error: err}
+	}
+	relationTypes, err := getRelationTypes(records)
+	return domain.GetRelationTypesResp{RelationTypes: relationTypes, Error: err}
+}
+
+// writeWithRelationTypes reads the relation types in the transaction that runs the statements built with them,
+// the cyphers that follow relations are typed with their names and a relation type can't change in between
+func (store RHABACRepo) writeWithRelationTypes(ctx context.Context, build func(relationTypes []domain.RelationType) ([][]VerifiedStatement, int, error)) (int, error) {
+	cypher, params := store.factory.getRelationTypes(domain.GetRelationTypesReq{})
+	return store.manager.QueriedWriteTransactions(ctx, cypher, params, func(records []*neo4j.Record) ([][]VerifiedStatement, int, error) {
+		relationTypes, err := getRelationTypes(records)
+		if err != nil {
+			return nil, -1, err
+		}
+		return build(relationTypes)
+	})
+}
+
+// readWithRelationTypes is like writeWithRelationTypes for a query
+func (store RHABACRepo) readWithRelationTypes(ctx context.Context, build func(relationTypes []domain.RelationType) (string, map[string]interface{})) (interface{}, error) {
+	cypher, params := store.factory.getRelationTypes(domain.GetRelationTypesReq{})
+	return store.manager.QueriedReadTransaction(ctx, cypher, params, func(records []*neo4j.Record) (string, map[string]interface{}, error) {
+		relationTypes, err := getRelationTypes(records)
+		if err != nil {
+			return "", nil, err
+		}
+		cypher, params := build(relationTypes)
+		return cypher, params, nil
+	})
+}
+
+// Batch runs the statements of all ops in one transaction, which is rolled back if any of them fails
+func (store RHABACRepo) Batch(ctx context.Context, req domain.BatchReq) domain.BatchResp {
+	tracer := otel.Tracer("oort.neo4j.repo")
+	ctx, span := tracer.Start(ctx, "RHABACRepo.Batch")
+	defer span.End()
+	failed, err := store.writeWithRelationTypes(ctx, func(relationTypes []domain.RelationType) ([][]VerifiedStatement, int, error) {
+		groups := make([][]VerifiedStatement, len(req.Ops))
+		for i, op := range req.Ops {
+			statements, err := store.batchStatements(op, relationTypes)
+			if err != nil {
+				return nil, i, err
+			}
+			groups[i] = statements
+			// the statements of the later ops see the relation types the op puts or deletes
+			relationTypes = batchRelationTypes(relationTypes, op)
+		}
+		return groups, -1, nil
+	})
+	return domain.NewBatchResp(len(req.Ops), failed, err)
+}
+
+// batchStatements builds the statements the matching repo method runs for the op
+func (store RHABACRepo) batchStatements(op domain.BatchOp, relationTypes []domain.RelationType) ([]VerifiedStatement, error) {
+	switch req := op.(type) {
+	case domain.CreateResourceReq:
+		return mutation(store.factory.createResource(req)), nil
+	case domain.DeleteResourceReq:
+		return mutation(store.factory.deleteResource(req)), nil
+	case domain.PutAttributeReq:
+		return mutation(store.factory.putAttribute(req)), nil
+	case domain.DeleteAttributeReq:
+		return mutation(store.factory.deleteAttribute(req)), nil
+	case domain.CreateRelationReq:
+		return store.createRelationStatements(req, relationTypes)
+	case domain.DeleteRelationReq:
+		return mutation(store.factory.deleteRelation(req)), nil
+	case domain.CreatePolicyReq:
+		return store.createPolicyStatements(req, relationTypes), nil
+	case domain.DeletePolicyReq:
+		return mutation(store.factory.deletePolicy(req)), nil
+	case domain.PutAttributeSchemaReq:
+		return mutation(store.factory.putAttributeSchema(req)), nil
+	case domain.DeleteAttributeSchemaReq:
+		return mutation(store.factory.deleteAttributeSchema(req)), nil
+	case domain.SetCombiningAlgorithmReq:
+		return mutation(store.factory.setCombiningAlgorithm(req)), nil
+	case domain.CreatePermissionImplicationReq:
+		cypher, params := store.factory.createPermissionImplication(req)
+		return []VerifiedStatement{{Cypher: cypher, Params: params, Verify: verifyNoImplicationCycle}}, nil
+	case domain.DeletePermissionImplicationReq:
+		return mutation(store.factory.deletePermissionImplication(req)), nil
+	case domain.CreateSoDConstraintReq:
+		return store.createSoDConstraintStatements(req, relationTypes), nil
+	case domain.DeleteSoDConstraintReq:
+		return mutation(store.factory.deleteSoDConstraint(req)), nil
+	case domain.PutRelationTypeReq:
+		return mutation(store.factory.putRelationType(req)), nil
+	case domain.DeleteRelationTypeReq:
+		cypher, params := store.factory.deleteRelationType(req)
+		return []VerifiedStatement{{Cypher: cypher, Params: params, Verify: verifyRelationTypeUnused}}, nil
+	default:
+		return nil, fmt.Errorf("%w: %T", domain.ErrUnknownBatchOp, op)
+	}
+}
+
+// batchRelationTypes returns the relation types after the op is applied
+func batchRelationTypes(relationTypes []domain.RelationType, op domain.BatchOp) []domain.RelationType {
+	switch req := op.(type) {
+	case domain.PutRelationTypeReq:
+		return append(withoutRelationType(relationTypes, req.RelationType.Name()), req.RelationType)
+	case domain.DeleteRelationTypeReq:
+		return withoutRelationType(relationTypes, req.Name)
+	default:
+		return relationTypes
+	}
+}
+
+func withoutRelationType(relationTypes []domain.RelationType, name string) []domain.RelationType {
+	remaining := make([]domain.RelationType, 0, len(relationTypes))
+	for _, relationType := range relationTypes {
+		if relationType.Name() != name {
+			remaining = append(remaining, relationType)
+		}
+	}
+	return remaining
+}
+
+// mutation is the statement of a cypher whose records aren't verified
+func mutation(cypher string, params map[string]interface{}) []VerifiedStatement {
+	return []VerifiedStatement{{Cypher: cypher, Params: params, Verify: verifyNothing}}
+}
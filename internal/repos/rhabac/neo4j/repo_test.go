@@ -0,0 +1,35 @@
+package neo4j
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/c12s/oort/internal/domain"
+	"github.com/c12s/oort/internal/repos/rhabac/repotest"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRHABACRepo runs against the database at OORT_TEST_NEO4J_URI, which it clears before every scenario.
+// The neo4j job of .github/workflows/test.yml runs it against neo4j 5.26, to run it locally start
+// docker run -p 7687:7687 -e NEO4J_AUTH=none neo4j:5.26
+// and set OORT_TEST_NEO4J_URI=bolt://localhost:7687
+func TestRHABACRepo(t *testing.T) {
+	uri := os.Getenv("OORT_TEST_NEO4J_URI")
+	if uri == "" {
+		t.Skip("OORT_TEST_NEO4J_URI not set")
+	}
+	dbName := os.Getenv("OORT_TEST_NEO4J_DBNAME")
+	if dbName == "" {
+		dbName = "neo4j"
+	}
+	manager, err := NewTransactionManager(uri, dbName, neo4j.NoAuth())
+	require.NoError(t, err)
+	defer manager.Stop()
+	repotest.Run(t, func(t *testing.T) domain.RHABACRepo {
+		err := manager.WriteTransaction(context.Background(), "MATCH (n) DETACH DELETE n", map[string]interface{}{})
+		require.NoError(t, err)
+		return NewRHABACRepo(manager, NewSimpleCypherFactory(domain.DefaultMaxInheritanceDepth))
+	})
+}
@@ -4,17 +4,19 @@ import (
 	"context"
 	"log"
 
-	"github.com/neo4j/neo4j-go-driver/v4/neo4j"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
 	"go.opentelemetry.io/otel"
 )
 
 type TransactionManager struct {
-	driver neo4j.Driver
+	driver neo4j.DriverWithContext
 	dbName string
 }
 
-func NewTransactionManager(uri, dbName string) (*TransactionManager, error) {
-	driver, err := neo4j.NewDriver(uri, neo4j.NoAuth())
+// NewTransactionManager connects to the database with the auth token,
+// the configurers customize the driver like they do in neo4j.NewDriverWithContext
+func NewTransactionManager(uri, dbName string, auth neo4j.AuthToken, configurers ...func(config *neo4j.Config)) (*TransactionManager, error) {
+	driver, err := neo4j.NewDriverWithContext(uri, auth, configurers...)
 	if err != nil {
 		return nil, err
 	}
@@ -24,50 +26,136 @@ func NewTransactionManager(uri, dbName string) (*TransactionManager, error) {
 	}, nil
 }
 
-type TransactionFunction func(transaction neo4j.Transaction) (interface{}, error)
+type TransactionFunction func(transaction neo4j.ManagedTransaction) (interface{}, error)
 
 func (manager *TransactionManager) WriteTransaction(ctx context.Context, cypher string, params map[string]interface{}) error {
 	tracer := otel.Tracer("oort.neo4j")
 	ctx, span := tracer.Start(ctx, "neo4j.WriteTransaction")
 	defer span.End()
 
-	_, err := manager.writeTransaction(func(transaction neo4j.Transaction) (interface{}, error) {
-		result, err := transaction.Run(cypher, params)
+	_, err := manager.writeTransaction(ctx, func(transaction neo4j.ManagedTransaction) (interface{}, error) {
+		// returning an error rolls the transaction back
+		result, err := transaction.Run(ctx, cypher, params)
 		if err != nil {
-			_ = transaction.Rollback()
-		}
-		if result == nil {
-			return nil, nil
+			return nil, err
 		}
-		return nil, result.Err()
+		_, err = result.Consume(ctx)
+		return nil, err
 	})
 	return err
 }
 
-func (manager *TransactionManager) WriteTransactions(ctx context.Context, cyphers []string, params []map[string]interface{}) error {
+// VerifiedWriteTransaction runs the cypher and passes the returned records to verify,
+// the changes are rolled back if verify returns an error
+func (manager *TransactionManager) VerifiedWriteTransaction(ctx context.Context, cypher string, params map[string]interface{}, verify func(records []*neo4j.Record) error) error {
+	return manager.VerifiedWriteTransactions(ctx, []VerifiedStatement{{Cypher: cypher, Params: params, Verify: verify}})
+}
+
+// VerifiedStatement is a cypher whose records are verified before the next statement runs
+type VerifiedStatement struct {
+	Cypher string
+	Params map[string]interface{}
+	Verify func(records []*neo4j.Record) error
+}
+
+// VerifiedWriteTransactions runs the statements in order in one transaction,
+// the changes of all statements are rolled back if any of them fails verification
+func (manager *TransactionManager) VerifiedWriteTransactions(ctx context.Context, statements []VerifiedStatement) error {
+	_, err := manager.WriteTransactions(ctx, [][]VerifiedStatement{statements})
+	return err
+}
+
+// CollectingWriteTransaction runs the cypher and returns the records it produced
+func (manager *TransactionManager) CollectingWriteTransaction(ctx context.Context, cypher string, params map[string]interface{}) (interface{}, error) {
 	tracer := otel.Tracer("oort.neo4j")
-	ctx, span := tracer.Start(ctx, "neo4j.WriteTransaction")
+	ctx, span := tracer.Start(ctx, "neo4j.CollectingWriteTransaction")
 	defer span.End()
 
-	_, err := manager.writeTransaction(func(transaction neo4j.Transaction) (interface{}, error) {
-		var txErr error = nil
-		for i := range cyphers {
-			cypher := cyphers[i]
-			param := params[i]
-			result, err := transaction.Run(cypher, param)
-			if err != nil || result.Err() != nil {
-				_ = transaction.Rollback()
-				if err != nil {
-					txErr = err
-				} else {
-					txErr = result.Err()
-				}
-				break
-			}
+	return manager.writeTransaction(ctx, func(transaction neo4j.ManagedTransaction) (interface{}, error) {
+		result, err := transaction.Run(ctx, cypher, params)
+		if err != nil {
+			return nil, err
 		}
-		return txErr, nil
+		return result.Collect(ctx)
 	})
-	return err
+}
+
+// WriteTransactions runs the groups of statements in order in one transaction,
+// if a statement fails or doesn't pass its verification the changes of all groups are rolled back
+// and the index of its group is returned with the error, the index is negative if no statement failed
+func (manager *TransactionManager) WriteTransactions(ctx context.Context, groups [][]VerifiedStatement) (int, error) {
+	tracer := otel.Tracer("oort.neo4j")
+	ctx, span := tracer.Start(ctx, "neo4j.WriteTransactions")
+	defer span.End()
+
+	failed := -1
+	_, err := manager.writeTransaction(ctx, func(transaction neo4j.ManagedTransaction) (interface{}, error) {
+		// the driver retries the whole function on transient errors
+		var err error
+		failed, err = runGroups(ctx, transaction, groups)
+		return nil, err
+	})
+	if err == nil {
+		return -1, nil
+	}
+	return failed, err
+}
+
+// GroupsBuilder builds the groups of statements from the records of the query that ran before them,
+// the index of the group that couldn't be built is returned with the error, it is negative if none was at fault
+type GroupsBuilder func(records []*neo4j.Record) ([][]VerifiedStatement, int, error)
+
+// QueriedWriteTransactions runs the query and the groups built from its records in one transaction,
+// so the statements are built from the same state they change. Failures are reported like in WriteTransactions
+func (manager *TransactionManager) QueriedWriteTransactions(ctx context.Context, cypher string, params map[string]interface{}, build GroupsBuilder) (int, error) {
+	tracer := otel.Tracer("oort.neo4j")
+	ctx, span := tracer.Start(ctx, "neo4j.QueriedWriteTransactions")
+	defer span.End()
+
+	failed := -1
+	_, err := manager.writeTransaction(ctx, func(transaction neo4j.ManagedTransaction) (interface{}, error) {
+		// the driver retries the whole function on transient errors, the groups are built again
+		failed = -1
+		records, err := collect(ctx, transaction, cypher, params)
+		if err != nil {
+			return nil, err
+		}
+		groups, buildFailed, err := build(records)
+		if err != nil {
+			failed = buildFailed
+			return nil, err
+		}
+		failed, err = runGroups(ctx, transaction, groups)
+		return nil, err
+	})
+	if err == nil {
+		return -1, nil
+	}
+	return failed, err
+}
+
+// runGroups returns the index of the group whose statement failed, returning an error rolls the transaction back
+func runGroups(ctx context.Context, transaction neo4j.ManagedTransaction, groups [][]VerifiedStatement) (int, error) {
+	for i, statements := range groups {
+		for _, statement := range statements {
+			records, err := collect(ctx, transaction, statement.Cypher, statement.Params)
+			if err != nil {
+				return i, err
+			}
+			if err := statement.Verify(records); err != nil {
+				return i, err
+			}
+		}
+	}
+	return -1, nil
+}
+
+func collect(ctx context.Context, transaction neo4j.ManagedTransaction, cypher string, params map[string]interface{}) ([]*neo4j.Record, error) {
+	result, err := transaction.Run(ctx, cypher, params)
+	if err != nil {
+		return nil, err
+	}
+	return result.Collect(ctx)
 }
 
 func (manager *TransactionManager) ReadTransaction(ctx context.Context, cypher string, params map[string]interface{}) (interface{}, error) {
@@ -75,30 +163,47 @@ func (manager *TransactionManager) ReadTransaction(ctx context.Context, cypher s
 	ctx, span := tracer.Start(ctx, "neo4j.ReadTransaction")
 	defer span.End()
 
-	return manager.readTransaction(func(transaction neo4j.Transaction) (interface{}, error) {
-		result, err := transaction.Run(cypher, params)
+	return manager.readTransaction(ctx, func(transaction neo4j.ManagedTransaction) (interface{}, error) {
+		result, err := transaction.Run(ctx, cypher, params)
 		if err != nil {
 			return nil, err
 		}
-		if result.Err() != nil {
-			return nil, result.Err()
+		return result.Collect(ctx)
+	})
+}
+
+// QueriedReadTransaction runs the query and the cypher built from its records in one transaction,
+// it returns the records of the built cypher
+func (manager *TransactionManager) QueriedReadTransaction(ctx context.Context, cypher string, params map[string]interface{}, build func(records []*neo4j.Record) (string, map[string]interface{}, error)) (interface{}, error) {
+	tracer := otel.Tracer("oort.neo4j")
+	ctx, span := tracer.Start(ctx, "neo4j.QueriedReadTransaction")
+	defer span.End()
+
+	return manager.readTransaction(ctx, func(transaction neo4j.ManagedTransaction) (interface{}, error) {
+		records, err := collect(ctx, transaction, cypher, params)
+		if err != nil {
+			return nil, err
+		}
+		builtCypher, builtParams, err := build(records)
+		if err != nil {
+			return nil, err
 		}
-		return result.Collect()
+		return collect(ctx, transaction, builtCypher, builtParams)
 	})
 }
 
-func (manager *TransactionManager) writeTransaction(txFunc TransactionFunction) (interface{}, error) {
-	session := manager.driver.NewSession(neo4j.SessionConfig{
+func (manager *TransactionManager) writeTransaction(ctx context.Context, txFunc TransactionFunction) (interface{}, error) {
+	session := manager.driver.NewSession(ctx, neo4j.SessionConfig{
 		AccessMode:   neo4j.AccessModeWrite,
 		DatabaseName: manager.dbName})
-	defer func(session neo4j.Session) {
-		err := session.Close()
+	defer func(session neo4j.SessionWithContext) {
+		err := session.Close(ctx)
 		if err != nil {
 			log.Println(err)
 		}
 	}(session)
 
-	result, err := session.WriteTransaction(neo4j.TransactionWork(txFunc))
+	result, err := session.ExecuteWrite(ctx, neo4j.ManagedTransactionWork(txFunc))
 	if err != nil {
 		return nil, err
 	}
@@ -106,18 +211,18 @@ func (manager *TransactionManager) writeTransaction(txFunc TransactionFunction)
 	return result, nil
 }
 
-func (manager *TransactionManager) readTransaction(txFunc TransactionFunction) (interface{}, error) {
-	session := manager.driver.NewSession(neo4j.SessionConfig{
+func (manager *TransactionManager) readTransaction(ctx context.Context, txFunc TransactionFunction) (interface{}, error) {
+	session := manager.driver.NewSession(ctx, neo4j.SessionConfig{
 		AccessMode:   neo4j.AccessModeRead,
 		DatabaseName: manager.dbName})
-	defer func(session neo4j.Session) {
-		err := session.Close()
+	defer func(session neo4j.SessionWithContext) {
+		err := session.Close(ctx)
 		if err != nil {
 			log.Println(err)
 		}
 	}(session)
 
-	result, err := session.ReadTransaction(neo4j.TransactionWork(txFunc))
+	result, err := session.ExecuteRead(ctx, neo4j.ManagedTransactionWork(txFunc))
 	if err != nil {
 		return nil, err
 	}
@@ -126,7 +231,7 @@ func (manager *TransactionManager) readTransaction(txFunc TransactionFunction) (
 }
 
 func (manager *TransactionManager) Stop() {
-	err := manager.driver.Close()
+	err := manager.driver.Close(context.Background())
 	if err != nil {
 		log.Println("error while closing neo4j conn: ", err)
 	}
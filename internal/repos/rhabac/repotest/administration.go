@@ -0,0 +1,213 @@
+package repotest
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/c12s/oort/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var combiningAlgorithms = []domain.CombiningAlgorithm{
+	domain.CombiningAlgorithmDenyOverrides,
+	domain.CombiningAlgorithmPermitOverrides,
+	domain.CombiningAlgorithmFirstApplicable,
+	domain.CombiningAlgorithmDenyUnlessPermit,
+	domain.CombiningAlgorithmPermitUnlessDeny,
+}
+
+func administrationScenarios() []scenario {
+	scenarios := make([]scenario, 0)
+	for _, algorithm := range combiningAlgorithms {
+		a := algorithm
+		scenarios = append(scenarios, scenario{
+			description: fmt.Sprintf("set combining algorithm %s", a),
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				setCombiningAlgorithm(t, repo, "read", domain.CombiningAlgorithmPermitOverrides)
+				setCombiningAlgorithm(t, repo, "read", a)
+				assert.Equal(t, a, combiningAlgorithm(t, repo, "read"))
+				assert.Equal(t, domain.DefaultCombiningAlgorithm, combiningAlgorithm(t, repo, "write"))
+			},
+		})
+	}
+	return append(scenarios,
+		scenario{
+			description: "default combining algorithm",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				assert.Equal(t, domain.DefaultCombiningAlgorithm, combiningAlgorithm(t, repo, "read"))
+			},
+		},
+		scenario{
+			description: "attribute schema",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				putSchema(t, repo, "user", "age", domain.Int64, true, nil)
+				putSchema(t, repo, "ns", "tier", domain.String, false, "standard")
+				schema := attributeSchema(t, repo, "ns")
+				require.NotNil(t, schema)
+				assert.Equal(t, "ns", schema.ResourceKind())
+				definition, ok := schema.Definition("tier")
+				require.True(t, ok)
+				assert.Equal(t, domain.String, definition.Kind())
+				assert.False(t, definition.Required())
+				defaultAttr, ok := definition.Default()
+				require.True(t, ok)
+				assert.Equal(t, "standard", defaultAttr.Value())
+			},
+		},
+		scenario{
+			description: "attribute schema is replaced",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				putSchema(t, repo, "user", "age", domain.Int64, true, nil)
+				putSchema(t, repo, "user", "name", domain.String, true, nil)
+				schema := attributeSchema(t, repo, "user")
+				require.NotNil(t, schema)
+				require.Len(t, schema.Definitions(), 1)
+				assert.Equal(t, "name", schema.Definitions()[0].Name())
+			},
+		},
+		scenario{
+			description: "unknown attribute schema",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				putSchema(t, repo, "user", "age", domain.Int64, true, nil)
+				assert.Nil(t, attributeSchema(t, repo, "ns"))
+			},
+		},
+		scenario{
+			description: "deleted attribute schema",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				putSchema(t, repo, "user", "age", domain.Int64, true, nil)
+				resp := repo.DeleteAttributeSchema(ctx, domain.DeleteAttributeSchemaReq{ResourceKind: "user"})
+				require.NoError(t, resp.Error)
+				assert.Nil(t, attributeSchema(t, repo, "user"))
+			},
+		},
+		scenario{
+			description: "implication cycle",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				createImplication(t, repo, "admin", "write")
+				createImplication(t, repo, "write", "read")
+				resp := repo.CreatePermissionImplication(ctx, domain.CreatePermissionImplicationReq{Implication: implication(t, "read", "admin")})
+				assert.ErrorIs(t, resp.Error, domain.ErrImplicationCycle)
+			},
+		},
+		scenario{
+			description: "implication is created once",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				createImplication(t, repo, "admin", "read")
+				createImplication(t, repo, "admin", "read")
+				deleteImplication(t, repo, "admin", "read")
+				allow(t, repo, "user/u", "ns/n", "admin")
+				assert.Empty(t, levels(t, repo, "user/u", "ns/n", "read"))
+			},
+		},
+		scenario{
+			description: "active break glass",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				now := time.Now().Truncate(time.Millisecond)
+				createBreakGlass(t, repo, "first", "user/u", "ns/n", "read", now.Add(-time.Minute), time.Hour)
+				createBreakGlass(t, repo, "latest", "user/u", "ns/n", "read", now.Add(-time.Minute), 2*time.Hour)
+				createBreakGlass(t, repo, "expired", "user/u", "ns/n", "read", now.Add(-3*time.Hour), 2*time.Hour)
+				createBreakGlass(t, repo, "other", "user/u", "ns/n", "write", now, 3*time.Hour)
+				active := activeBreakGlass(t, repo, "user/u", "ns/n", "read", now)
+				require.NotNil(t, active)
+				assert.Equal(t, "latest", active.Id())
+				assert.Equal(t, "user/u", active.Subject().Name())
+				assert.Equal(t, "ns/n", active.Object().Name())
+				assert.True(t, now.Add(-time.Minute+2*time.Hour).Equal(active.ExpiresAt()))
+			},
+		},
+		scenario{
+			description: "no active break glass",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				now := time.Now()
+				createBreakGlass(t, repo, "expired", "user/u", "ns/n", "read", now.Add(-2*time.Hour), time.Hour)
+				createBreakGlass(t, repo, "other", "user/other", "ns/n", "read", now, time.Hour)
+				assert.Nil(t, activeBreakGlass(t, repo, "user/u", "ns/n", "read", now))
+				assert.Nil(t, activeBreakGlass(t, repo, "user/u", "ns/other", "read", now))
+			},
+		},
+		scenario{
+			description: "break glass outlives its resources",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				now := time.Now()
+				createResource(t, repo, "user/u")
+				createBreakGlass(t, repo, "bg", "user/u", "ns/n", "read", now, time.Hour)
+				deleteResource(t, repo, "user/u")
+				assert.NotNil(t, activeBreakGlass(t, repo, "user/u", "ns/n", "read", now))
+			},
+		},
+		scenario{
+			description: "break glass uses",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				now := time.Now().Truncate(time.Millisecond)
+				createBreakGlass(t, repo, "bg", "user/u", "ns/n", "read", now, time.Hour)
+				createBreakGlass(t, repo, "other", "user/u", "ns/n", "write", now, time.Hour)
+				useBreakGlass(t, repo, "bg", now.Add(2*time.Minute))
+				useBreakGlass(t, repo, "bg", now.Add(time.Minute))
+				useBreakGlass(t, repo, "bg", now.Add(time.Minute))
+				useBreakGlass(t, repo, "other", now)
+				uses := breakGlassUses(t, repo, "bg")
+				require.Len(t, uses, 3)
+				assert.True(t, now.Add(time.Minute).Equal(uses[0].UsedAt()))
+				assert.True(t, now.Add(time.Minute).Equal(uses[1].UsedAt()))
+				assert.True(t, now.Add(2*time.Minute).Equal(uses[2].UsedAt()))
+				for _, use := range uses {
+					assert.Equal(t, "bg", use.BreakGlassId())
+				}
+				assert.Empty(t, breakGlassUses(t, repo, "unknown"))
+			},
+		},
+	)
+}
+
+func combiningAlgorithm(t *testing.T, repo domain.RHABACRepo, permName string) domain.CombiningAlgorithm {
+	resp := repo.GetCombiningAlgorithm(ctx, domain.GetCombiningAlgorithmReq{PermissionName: permName})
+	require.NoError(t, resp.Error)
+	return resp.Algorithm
+}
+
+func putSchema(t *testing.T, repo domain.RHABACRepo, resourceKind, attrName string, kind domain.AttributeKind, required bool, defaultValue interface{}) {
+	definition, err := domain.NewAttributeDefinition(attrName, kind, required, defaultValue)
+	require.NoError(t, err)
+	schema, err := domain.NewAttributeSchema(resourceKind, []domain.AttributeDefinition{*definition})
+	require.NoError(t, err)
+	resp := repo.PutAttributeSchema(ctx, domain.PutAttributeSchemaReq{Schema: *schema})
+	require.NoError(t, resp.Error)
+}
+
+func attributeSchema(t *testing.T, repo domain.RHABACRepo, resourceKind string) *domain.AttributeSchema {
+	resp := repo.GetAttributeSchema(ctx, domain.GetAttributeSchemaReq{ResourceKind: resourceKind})
+	require.NoError(t, resp.Error)
+	return resp.Schema
+}
+
+func createBreakGlass(t *testing.T, repo domain.RHABACRepo, id, sub, obj, permName string, grantedAt time.Time, duration time.Duration) {
+	breakGlass, err := domain.NewBreakGlass(id, resource(t, sub), resource(t, obj), permName, "incident", grantedAt, duration)
+	require.NoError(t, err)
+	resp := repo.CreateBreakGlass(ctx, domain.CreateBreakGlassReq{BreakGlass: *breakGlass})
+	require.NoError(t, resp.Error)
+}
+
+func activeBreakGlass(t *testing.T, repo domain.RHABACRepo, sub, obj, permName string, now time.Time) *domain.BreakGlass {
+	resp := repo.GetActiveBreakGlass(ctx, domain.GetActiveBreakGlassReq{
+		Subject:        resource(t, sub),
+		Object:         resource(t, obj),
+		PermissionName: permName,
+		Now:            now,
+	})
+	require.NoError(t, resp.Error)
+	return resp.BreakGlass
+}
+
+func useBreakGlass(t *testing.T, repo domain.RHABACRepo, id string, usedAt time.Time) {
+	resp := repo.CreateBreakGlassUse(ctx, domain.CreateBreakGlassUseReq{Use: domain.NewBreakGlassUse(id, usedAt)})
+	require.NoError(t, resp.Error)
+}
+
+func breakGlassUses(t *testing.T, repo domain.RHABACRepo, id string) []domain.BreakGlassUse {
+	resp := repo.GetBreakGlassUses(ctx, domain.GetBreakGlassUsesReq{BreakGlassId: id})
+	require.NoError(t, resp.Error)
+	return resp.Uses
+}
@@ -0,0 +1,185 @@
+package repotest
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/c12s/oort/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// attributeValues holds two distinct values of every attribute kind, the second one replaces the first one
+var attributeValues = []struct {
+	kind   domain.AttributeKind
+	first  interface{}
+	second interface{}
+}{
+	{kind: domain.Int64, first: int64(1), second: int64(-42)},
+	{kind: domain.Float64, first: 1.5, second: -0.25},
+	{kind: domain.String, first: "first", second: "second value"},
+	{kind: domain.Bool, first: true, second: false},
+	{kind: domain.StringList, first: []string{"a", "b"}, second: []string{"c"}},
+	{kind: domain.Int64List, first: []int64{1, 2, 3}, second: []int64{4}},
+	{kind: domain.Timestamp, first: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), second: time.Date(2025, 6, 7, 8, 9, 10, 0, time.UTC)},
+	{kind: domain.Duration, first: time.Minute, second: 90 * time.Second},
+}
+
+func attributeScenarios() []scenario {
+	scenarios := make([]scenario, 0)
+	for _, values := range attributeValues {
+		v := values
+		scenarios = append(scenarios,
+			scenario{
+				description: fmt.Sprintf("put %s attribute", v.kind),
+				run: func(t *testing.T, repo domain.RHABACRepo) {
+					putAttribute(t, repo, "user/u", attribute(t, "attr", v.kind, v.first))
+					attrs := attributes(t, repo, "user/u")
+					require.Contains(t, attrs, "attr")
+					assert.Equal(t, v.kind, attrs["attr"].Kind())
+					requireValue(t, v.first, attrs["attr"].Value())
+				},
+			},
+			scenario{
+				description: fmt.Sprintf("update %s attribute", v.kind),
+				run: func(t *testing.T, repo domain.RHABACRepo) {
+					putAttribute(t, repo, "user/u", attribute(t, "attr", v.kind, v.first))
+					putAttribute(t, repo, "user/u", attribute(t, "attr", v.kind, v.second))
+					attrs := attributes(t, repo, "user/u")
+					require.Len(t, attrs, 1)
+					requireValue(t, v.second, attrs["attr"].Value())
+				},
+			},
+			scenario{
+				description: fmt.Sprintf("change the kind of an attribute to %s", v.kind),
+				run: func(t *testing.T, repo domain.RHABACRepo) {
+					putAttribute(t, repo, "user/u", attribute(t, "attr", domain.String, "previous"))
+					putAttribute(t, repo, "user/u", attribute(t, "attr", v.kind, v.second))
+					attrs := attributes(t, repo, "user/u")
+					require.Len(t, attrs, 1)
+					assert.Equal(t, v.kind, attrs["attr"].Kind())
+					requireValue(t, v.second, attrs["attr"].Value())
+				},
+			},
+			scenario{
+				description: fmt.Sprintf("delete %s attribute", v.kind),
+				run: func(t *testing.T, repo domain.RHABACRepo) {
+					putAttribute(t, repo, "user/u", attribute(t, "attr", v.kind, v.first))
+					putAttribute(t, repo, "user/u", attribute(t, "other", domain.Int64, int64(1)))
+					deleteAttribute(t, repo, "user/u", "attr")
+					attrs := attributes(t, repo, "user/u")
+					assert.NotContains(t, attrs, "attr")
+					assert.Contains(t, attrs, "other")
+				},
+			},
+			scenario{
+				description: fmt.Sprintf("ancestor sees the updated %s attribute", v.kind),
+				run: func(t *testing.T, repo domain.RHABACRepo) {
+					inherit(t, repo, "org/o", "user/u")
+					putAttribute(t, repo, "org/o", attribute(t, "attr", v.kind, v.first))
+					putAttribute(t, repo, "org/o", attribute(t, "attr", v.kind, v.second))
+					resp := repo.GetAncestorAttributes(ctx, domain.GetAncestorAttributesReq{Resource: resource(t, "user/u")})
+					require.NoError(t, resp.Error)
+					for _, ancestor := range resp.Ancestors {
+						if ancestor.Ancestor.Name() != "org/o" {
+							continue
+						}
+						require.Len(t, ancestor.Attributes, 1)
+						requireValue(t, v.second, ancestor.Attributes[0].Value())
+						return
+					}
+					t.Fatal("ancestor org/o not found")
+				},
+			},
+		)
+	}
+	for depth := 1; depth <= 10; depth++ {
+		d := depth
+		scenarios = append(scenarios, scenario{
+			description: fmt.Sprintf("ancestors of a chain of depth %d", d),
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				names := chain(t, repo, "group", d)
+				expected := map[string]int{rootName(): 1}
+				for i := 0; i < d; i++ {
+					expected[names[i]] = d - i
+				}
+				assert.Equal(t, expected, ancestors(t, repo, names[d]))
+			},
+		})
+	}
+	return append(scenarios,
+		scenario{
+			description: "put attribute creates the resource",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				putAttribute(t, repo, "user/u", attribute(t, "age", domain.Int64, int64(30)))
+				assert.Equal(t, map[string]int{rootName(): 1}, ancestors(t, repo, "user/u"))
+			},
+		},
+		scenario{
+			description: "attributes of different resources are independent",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				putAttribute(t, repo, "user/a", attribute(t, "age", domain.Int64, int64(1)))
+				putAttribute(t, repo, "user/b", attribute(t, "age", domain.Int64, int64(2)))
+				deleteAttribute(t, repo, "user/a", "age")
+				assert.Empty(t, attributes(t, repo, "user/a"))
+				requireValue(t, int64(2), attributes(t, repo, "user/b")["age"].Value())
+			},
+		},
+		scenario{
+			description: "delete unknown attribute",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				createResource(t, repo, "user/u")
+				id, err := domain.NewAttributeId("unknown")
+				require.NoError(t, err)
+				resp := repo.DeleteAttribute(ctx, domain.DeleteAttributeReq{Resource: resource(t, "user/u"), AttributeId: *id})
+				assert.NoError(t, resp.Error)
+			},
+		},
+		scenario{
+			description: "inheritable flag",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				inheritable := attribute(t, "region", domain.String, "eu")
+				inheritable.SetInheritable(true)
+				putAttribute(t, repo, "org/o", inheritable)
+				putAttribute(t, repo, "org/o", attribute(t, "name", domain.String, "acme"))
+				attrs := attributes(t, repo, "org/o")
+				assert.True(t, attrs["region"].Inheritable())
+				assert.False(t, attrs["name"].Inheritable())
+			},
+		},
+		scenario{
+			description: "ancestors at the shortest distance",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				inherit(t, repo, "org/o", "group/g")
+				inherit(t, repo, "group/g", "user/u")
+				inherit(t, repo, "org/o", "user/u")
+				assert.Equal(t, map[string]int{"org/o": 1, "group/g": 1, rootName(): 1}, ancestors(t, repo, "user/u"))
+			},
+		},
+		scenario{
+			description: "ancestors don't include descendants",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				inherit(t, repo, "org/o", "user/u")
+				assert.Equal(t, map[string]int{rootName(): 1}, ancestors(t, repo, "org/o"))
+			},
+		},
+		scenario{
+			description: "ancestors of an unknown resource",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				createResource(t, repo, "user/u")
+				assert.Empty(t, ancestors(t, repo, "user/unknown"))
+			},
+		},
+		scenario{
+			description: "ancestors through relation types",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				putRelationType(t, repo, "MEMBER_OF", true, true)
+				putRelationType(t, repo, "OWNED_BY", true, false)
+				relate(t, repo, "group/g", "user/u", "MEMBER_OF")
+				relate(t, repo, "user/owner", "user/u", "OWNED_BY")
+				assert.Equal(t, map[string]int{"group/g": 1, rootName(): 1}, ancestors(t, repo, "user/u"))
+			},
+		},
+	)
+}
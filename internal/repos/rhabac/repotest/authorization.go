@@ -0,0 +1,115 @@
+package repotest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/c12s/oort/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func authorizationDataScenarios() []scenario {
+	return []scenario{
+		{
+			description: "authorization data matches the single purpose reads",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				now := time.Now()
+				inherit(t, repo, "org/o", "user/u")
+				inherit(t, repo, "cluster/c", "ns/n")
+				tier := attribute(t, "tier", domain.String, "gold")
+				tier.SetInheritable(true)
+				putAttribute(t, repo, "org/o", tier)
+				putAttribute(t, repo, "user/u", attribute(t, "age", domain.Int64, int64(30)))
+				putSchema(t, repo, "ns", "region", domain.String, false, "eu")
+				allow(t, repo, "org/o", "cluster/c", "read")
+				deny(t, repo, "user/u", "ns/n", "read")
+				setCombiningAlgorithm(t, repo, "read", domain.CombiningAlgorithmPermitOverrides)
+				for _, constraint := range []domain.SoDConstraint{
+					sodConstraint(t, "four-eyes", domain.SoDDynamic, "read", "write"),
+					sodConstraint(t, "other", domain.SoDDynamic, "submit", "approve"),
+					sodConstraint(t, "static", domain.SoDStatic, "read", "admin"),
+				} {
+					require.NoError(t, repo.CreateSoDConstraint(ctx, domain.CreateSoDConstraintReq{Constraint: constraint}).Error)
+				}
+				createBreakGlass(t, repo, "bg", "user/u", "ns/n", "read", now.Add(-time.Minute), time.Hour)
+
+				data := authorizationData(t, repo, "user/u", "ns/n", "read", now)
+				assert.Equal(t, levels(t, repo, "user/u", "ns/n", "read"), hierarchyLevels(data.Hierarchy))
+				assert.Equal(t, domain.CombiningAlgorithmPermitOverrides, data.Algorithm)
+
+				subAttrs := attributeNames(data.Subject.Resolve())
+				assert.Contains(t, subAttrs, "age")
+				assert.Contains(t, subAttrs, "tier")
+				assert.Contains(t, subAttrs, domain.ParentAttributeName("org", "tier"))
+				assert.Nil(t, data.Subject.Schema)
+				objAttrs := attributeNames(data.Object.Resolve())
+				assert.Contains(t, objAttrs, "region")
+				require.NotNil(t, data.Object.Schema)
+				assert.Equal(t, "ns", data.Object.Schema.ResourceKind())
+				assert.Empty(t, data.Object.Attributes)
+				assert.Equal(t, ancestors(t, repo, "ns/n"), ancestorDistances(data.Object.Ancestors))
+
+				constraints := make([]string, 0, len(data.SoDConstraints))
+				for _, constraint := range data.SoDConstraints {
+					constraints = append(constraints, constraint.Name())
+				}
+				assert.Equal(t, []string{"four-eyes"}, constraints)
+				require.NotNil(t, data.BreakGlass)
+				assert.Equal(t, "bg", data.BreakGlass.Id())
+			},
+		},
+		{
+			description: "authorization data without definitions",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				createResource(t, repo, "user/u")
+				createResource(t, repo, "ns/n")
+				createBreakGlass(t, repo, "expired", "user/u", "ns/n", "read", time.Now().Add(-2*time.Hour), time.Hour)
+
+				data := authorizationData(t, repo, "user/u", "ns/n", "read", time.Now())
+				assert.Empty(t, hierarchyLevels(data.Hierarchy))
+				assert.Equal(t, domain.DefaultCombiningAlgorithm, data.Algorithm)
+				assert.Nil(t, data.Subject.Schema)
+				assert.Nil(t, data.Object.Schema)
+				assert.Equal(t, map[string]int{rootName(): 1}, ancestorDistances(data.Subject.Ancestors))
+				assert.Empty(t, data.SoDConstraints)
+				assert.Nil(t, data.BreakGlass)
+			},
+		},
+		{
+			description: "authorization data of unknown resources",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				createResource(t, repo, "user/u")
+				createResource(t, repo, "ns/n")
+				for _, names := range [][2]string{{"user/unknown", "ns/n"}, {"user/u", "ns/unknown"}} {
+					resp := repo.GetAuthorizationData(ctx, domain.GetAuthorizationDataReq{
+						Subject:        resource(t, names[0]),
+						Object:         resource(t, names[1]),
+						PermissionName: "read",
+						Now:            time.Now(),
+					})
+					assert.Error(t, resp.Error, "%s on %s", names[0], names[1])
+				}
+			},
+		},
+	}
+}
+
+func authorizationData(t *testing.T, repo domain.RHABACRepo, sub, obj, permName string, now time.Time) domain.GetAuthorizationDataResp {
+	resp := repo.GetAuthorizationData(ctx, domain.GetAuthorizationDataReq{
+		Subject:        resource(t, sub),
+		Object:         resource(t, obj),
+		PermissionName: permName,
+		Now:            now,
+	})
+	require.NoError(t, resp.Error)
+	return resp
+}
+
+func attributeNames(attrs []domain.Attribute) []string {
+	names := make([]string, 0, len(attrs))
+	for _, attr := range attrs {
+		names = append(names, attr.Name())
+	}
+	return names
+}
@@ -0,0 +1,118 @@
+package repotest
+
+import (
+	"testing"
+
+	"github.com/c12s/oort/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func batchScenarios() []scenario {
+	return []scenario{
+		{
+			description: "empty batch",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				resp := repo.Batch(ctx, domain.BatchReq{})
+				require.NoError(t, resp.Error)
+				assert.Empty(t, resp.Results)
+			},
+		},
+		{
+			description: "all ops applied",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				resp := repo.Batch(ctx, domain.BatchReq{Ops: []domain.BatchOp{
+					domain.CreateResourceReq{Resource: resource(t, "user/u")},
+					createRelationReq(t, "group/g", "user/u", domain.InheritsFrom),
+					domain.PutAttributeReq{Resource: resource(t, "user/u"), Attribute: attribute(t, "age", domain.Int64, int64(30))},
+					allowReq(t, "group/g", "ns/n", "read"),
+				}})
+				require.NoError(t, resp.Error)
+				assert.Equal(t, []error{nil, nil, nil, nil}, batchErrors(resp))
+				assert.True(t, authorized(t, repo, "user/u", "ns/n", "read"))
+				assert.Contains(t, attributes(t, repo, "user/u"), "age")
+			},
+		},
+		{
+			description: "failed op rolls back the earlier ops",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				createResource(t, repo, "user/u")
+				resp := repo.Batch(ctx, domain.BatchReq{Ops: []domain.BatchOp{
+					createRelationReq(t, "group/g", "user/u", domain.InheritsFrom),
+					allowReq(t, "group/g", "ns/n", "read"),
+					// the earlier ops are visible, so this one closes a cycle
+					createRelationReq(t, "user/u", "group/g", domain.InheritsFrom),
+					allowReq(t, "user/u", "ns/n", "write"),
+				}})
+				assert.ErrorIs(t, resp.Error, domain.ErrInheritanceCycle)
+				errs := batchErrors(resp)
+				require.Len(t, errs, 4)
+				assert.ErrorIs(t, errs[0], domain.ErrBatchAborted)
+				assert.ErrorIs(t, errs[1], domain.ErrBatchAborted)
+				assert.ErrorIs(t, errs[2], domain.ErrInheritanceCycle)
+				assert.ErrorIs(t, errs[3], domain.ErrBatchAborted)
+				assert.Equal(t, map[string]int{rootName(): 1}, ancestors(t, repo, "user/u"))
+				assert.Empty(t, applicable(t, repo, "user/u"))
+				assert.Error(t, repo.GetResource(ctx, domain.GetResourceReq{Resource: resource(t, "group/g")}).Error)
+			},
+		},
+		{
+			description: "failed op rolls back attributes and implications",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				createImplication(t, repo, "admin", "read")
+				resp := repo.Batch(ctx, domain.BatchReq{Ops: []domain.BatchOp{
+					domain.PutAttributeReq{Resource: resource(t, "ns/n"), Attribute: attribute(t, "tier", domain.String, "gold")},
+					domain.CreatePermissionImplicationReq{Implication: implication(t, "admin", "write")},
+					domain.CreatePermissionImplicationReq{Implication: implication(t, "read", "admin")},
+				}})
+				assert.ErrorIs(t, resp.Error, domain.ErrImplicationCycle)
+				assert.Error(t, repo.GetResource(ctx, domain.GetResourceReq{Resource: resource(t, "ns/n")}).Error)
+				allow(t, repo, "user/u", "ns/n", "admin")
+				assert.Empty(t, levels(t, repo, "user/u", "ns/n", "write"))
+			},
+		},
+		{
+			description: "static constraint violated by policies of the batch",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				resp := repo.Batch(ctx, domain.BatchReq{Ops: []domain.BatchOp{
+					allowReq(t, "user/u", "ns/n", "approve"),
+					allowReq(t, "user/u", "ns/n", "submit"),
+					domain.CreateSoDConstraintReq{Constraint: sodConstraint(t, "four-eyes", domain.SoDStatic, "approve", "submit")},
+				}})
+				requireSoDViolation(t, resp.Error, "four-eyes", "user/u", "ns/n")
+				assert.Empty(t, applicable(t, repo, "user/u"))
+				assert.Empty(t, sodConstraints(t, repo, domain.SoDStatic, ""))
+			},
+		},
+		{
+			description: "relation type used by a relation of the batch",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				relationType, err := domain.NewRelationType("MEMBER_OF", true, false)
+				require.NoError(t, err)
+				resp := repo.Batch(ctx, domain.BatchReq{Ops: []domain.BatchOp{
+					domain.PutRelationTypeReq{RelationType: *relationType},
+					createRelationReq(t, "group/g", "user/u", "MEMBER_OF"),
+					domain.DeleteRelationTypeReq{Name: "MEMBER_OF"},
+				}})
+				assert.ErrorIs(t, resp.Error, domain.ErrRelationTypeInUse)
+				assert.Equal(t, []string{"INHERITS_FROM true true"}, relationTypes(t, repo))
+			},
+		},
+	}
+}
+
+func allowReq(t *testing.T, sub, obj, permName string) domain.CreatePolicyReq {
+	return domain.CreatePolicyReq{
+		SubjectScope: resource(t, sub),
+		ObjectScope:  resource(t, obj),
+		Permission:   permission(t, permName, domain.PermissionKindAllow),
+	}
+}
+
+func batchErrors(resp domain.BatchResp) []error {
+	errs := make([]error, len(resp.Results))
+	for i, result := range resp.Results {
+		errs[i] = result.Error
+	}
+	return errs
+}
@@ -0,0 +1,376 @@
+package repotest
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/c12s/oort/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Priorities are the negated lengths of the shortest paths from the subject and the object
+// to the resources the policy was created on, a resource holds its own policies at priority 0.
+func hierarchyScenarios() []scenario {
+	scenarios := make([]scenario, 0)
+	for depth := 0; depth <= 20; depth++ {
+		d := depth
+		scenarios = append(scenarios,
+			scenario{
+				description: fmt.Sprintf("subject chain of depth %d", d),
+				run: func(t *testing.T, repo domain.RHABACRepo) {
+					subs := chain(t, repo, "group", d)
+					allow(t, repo, subs[0], "ns/n", "read")
+					expected := map[level][]string{{sub: domain.PermissionPriority(-d), obj: 0}: {"allow read"}}
+					assert.Equal(t, expected, levels(t, repo, subs[d], "ns/n", "read"))
+				},
+			},
+			scenario{
+				description: fmt.Sprintf("object chain of depth %d", d),
+				run: func(t *testing.T, repo domain.RHABACRepo) {
+					objs := chain(t, repo, "ns", d)
+					allow(t, repo, "user/u", objs[0], "read")
+					expected := map[level][]string{{sub: 0, obj: domain.PermissionPriority(-d)}: {"allow read"}}
+					assert.Equal(t, expected, levels(t, repo, "user/u", objs[d], "read"))
+				},
+			},
+		)
+	}
+	for subDepth := 1; subDepth <= 5; subDepth++ {
+		for objDepth := 1; objDepth <= 5; objDepth++ {
+			sd, od := subDepth, objDepth
+			scenarios = append(scenarios, scenario{
+				description: fmt.Sprintf("policies along a subject chain of depth %d and an object chain of depth %d", sd, od),
+				run: func(t *testing.T, repo domain.RHABACRepo) {
+					subs := chain(t, repo, "group", sd)
+					objs := chain(t, repo, "ns", od)
+					expected := make(map[level][]string)
+					// a policy from every subject to the object at the same position, as far as both chains go
+					for i := 0; i <= sd && i <= od; i++ {
+						allow(t, repo, subs[i], objs[i], "read")
+						expected[level{sub: domain.PermissionPriority(i - sd), obj: domain.PermissionPriority(i - od)}] = []string{"allow read"}
+					}
+					assert.Equal(t, expected, levels(t, repo, subs[sd], objs[od], "read"))
+				},
+			})
+		}
+	}
+	for width := 1; width <= 10; width++ {
+		w := width
+		scenarios = append(scenarios,
+			scenario{
+				description: fmt.Sprintf("subject diamond of width %d", w),
+				run: func(t *testing.T, repo domain.RHABACRepo) {
+					for i := 0; i < w; i++ {
+						middle := fmt.Sprintf("group/%d", i)
+						inherit(t, repo, "org/top", middle)
+						inherit(t, repo, middle, "user/u")
+					}
+					allow(t, repo, "org/top", "ns/n", "read")
+					expected := map[level][]string{{sub: -2, obj: 0}: {"allow read"}}
+					assert.Equal(t, expected, levels(t, repo, "user/u", "ns/n", "read"))
+					assert.True(t, authorized(t, repo, "user/u", "ns/n", "read"))
+				},
+			},
+			scenario{
+				description: fmt.Sprintf("object diamond of width %d", w),
+				run: func(t *testing.T, repo domain.RHABACRepo) {
+					for i := 0; i < w; i++ {
+						middle := fmt.Sprintf("ns/%d", i)
+						inherit(t, repo, "cluster/top", middle)
+						inherit(t, repo, middle, "app/a")
+					}
+					deny(t, repo, "user/u", "cluster/top", "read")
+					expected := map[level][]string{{sub: 0, obj: -2}: {"deny read"}}
+					assert.Equal(t, expected, levels(t, repo, "user/u", "app/a", "read"))
+					assert.False(t, authorized(t, repo, "user/u", "app/a", "read"))
+				},
+			},
+			scenario{
+				description: fmt.Sprintf("uneven diamond with a shortcut and %d long paths", w),
+				run: func(t *testing.T, repo domain.RHABACRepo) {
+					for i := 0; i < w; i++ {
+						middle := fmt.Sprintf("group/%d", i)
+						inherit(t, repo, "org/top", middle)
+						inherit(t, repo, middle, "user/u")
+					}
+					inherit(t, repo, "org/top", "user/u")
+					allow(t, repo, "org/top", "ns/n", "read")
+					expected := map[level][]string{{sub: -1, obj: 0}: {"allow read"}}
+					assert.Equal(t, expected, levels(t, repo, "user/u", "ns/n", "read"))
+				},
+			},
+		)
+	}
+	for subDistance := 0; subDistance <= 4; subDistance++ {
+		for objDistance := 0; objDistance <= 4; objDistance++ {
+			sd, od := subDistance, objDistance
+			scenarios = append(scenarios, scenario{
+				description: fmt.Sprintf("deny and allow at subject distance %d and object distance %d", sd, od),
+				run: func(t *testing.T, repo domain.RHABACRepo) {
+					subs := chain(t, repo, "group", sd)
+					objs := chain(t, repo, "ns", od)
+					allow(t, repo, subs[0], objs[0], "read")
+					deny(t, repo, subs[0], objs[0], "read")
+					expected := map[level][]string{
+						{sub: domain.PermissionPriority(-sd), obj: domain.PermissionPriority(-od)}: {"allow read", "deny read"},
+					}
+					assert.Equal(t, expected, levels(t, repo, subs[sd], objs[od], "read"))
+					assert.False(t, authorized(t, repo, subs[sd], objs[od], "read"))
+					setCombiningAlgorithm(t, repo, "read", domain.CombiningAlgorithmPermitOverrides)
+					assert.True(t, authorized(t, repo, subs[sd], objs[od], "read"))
+				},
+			})
+		}
+	}
+	for distance := 1; distance <= 4; distance++ {
+		d := distance
+		scenarios = append(scenarios,
+			scenario{
+				description: fmt.Sprintf("nearer allow overrides a deny %d relations farther", d),
+				run: func(t *testing.T, repo domain.RHABACRepo) {
+					subs := chain(t, repo, "group", d)
+					deny(t, repo, subs[0], "ns/n", "read")
+					allow(t, repo, subs[d], "ns/n", "read")
+					expected := map[level][]string{
+						{sub: 0, obj: 0}: {"allow read"},
+						{sub: domain.PermissionPriority(-d), obj: 0}: {"deny read"},
+					}
+					assert.Equal(t, expected, levels(t, repo, subs[d], "ns/n", "read"))
+					assert.True(t, authorized(t, repo, subs[d], "ns/n", "read"))
+				},
+			},
+			scenario{
+				description: fmt.Sprintf("nearer deny overrides an allow %d relations farther", d),
+				run: func(t *testing.T, repo domain.RHABACRepo) {
+					objs := chain(t, repo, "ns", d)
+					allow(t, repo, "user/u", objs[0], "read")
+					deny(t, repo, "user/u", objs[d], "read")
+					assert.False(t, authorized(t, repo, "user/u", objs[d], "read"))
+					assert.True(t, authorized(t, repo, "user/u", objs[0], "read"))
+				},
+			},
+		)
+	}
+	return append(scenarios,
+		scenario{
+			description: "unknown subject",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				allow(t, repo, rootName(), rootName(), "read")
+				assert.Empty(t, levels(t, repo, "user/unknown", rootName(), "read"))
+			},
+		},
+		scenario{
+			description: "unknown object",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				allow(t, repo, rootName(), rootName(), "read")
+				createResource(t, repo, "user/u")
+				assert.Empty(t, levels(t, repo, "user/u", "ns/unknown", "read"))
+			},
+		},
+		scenario{
+			description: "empty graph",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				assert.Empty(t, levels(t, repo, "user/u", "ns/n", "read"))
+				assert.False(t, authorized(t, repo, "user/u", "ns/n", "read"))
+			},
+		},
+		scenario{
+			description: "unrelated subject",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				allow(t, repo, "group/g", "ns/n", "read")
+				createResource(t, repo, "user/u")
+				assert.Empty(t, levels(t, repo, "user/u", "ns/n", "read"))
+			},
+		},
+		scenario{
+			description: "policies aren't inherited upwards",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				inherit(t, repo, "group/g", "user/u")
+				inherit(t, repo, "ns/n", "app/a")
+				allow(t, repo, "user/u", "app/a", "read")
+				assert.Empty(t, levels(t, repo, "group/g", "app/a", "read"))
+				assert.Empty(t, levels(t, repo, "user/u", "ns/n", "read"))
+			},
+		},
+		scenario{
+			description: "root policy applies to every resource",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				inherit(t, repo, "group/g", "user/u")
+				createResource(t, repo, "ns/n")
+				allow(t, repo, rootName(), rootName(), "read")
+				assert.Equal(t, map[level][]string{{sub: -1, obj: -1}: {"allow read"}}, levels(t, repo, "user/u", "ns/n", "read"))
+				assert.Equal(t, map[level][]string{{sub: 0, obj: 0}: {"allow read"}}, levels(t, repo, rootName(), rootName(), "read"))
+			},
+		},
+		scenario{
+			description: "other permission names",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				allow(t, repo, "user/u", "ns/n", "write")
+				allow(t, repo, "user/u", "ns/n", "read.all")
+				allow(t, repo, "user/u", "ns/n", "read")
+				assert.Equal(t, map[level][]string{{sub: 0, obj: 0}: {"allow read"}}, levels(t, repo, "user/u", "ns/n", "read"))
+			},
+		},
+		scenario{
+			description: "wildcard permission names",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				allow(t, repo, "user/u", "ns/n", "cluster.node.list")
+				allow(t, repo, "user/u", "ns/n", "cluster.node.*")
+				deny(t, repo, "user/u", "ns/n", "cluster.**")
+				allow(t, repo, "user/u", "ns/n", "cluster.*")
+				allow(t, repo, "user/u", "ns/n", "cluster.node.get")
+				expected := map[level][]string{{sub: 0, obj: 0}: {"allow cluster.node.*", "allow cluster.node.list", "deny cluster.**"}}
+				assert.Equal(t, expected, levels(t, repo, "user/u", "ns/n", "cluster.node.list"))
+				assert.True(t, authorized(t, repo, "user/u", "ns/n", "cluster.node.list"))
+				assert.False(t, authorized(t, repo, "user/u", "ns/n", "cluster.node.delete.all"))
+			},
+		},
+		scenario{
+			description: "wildcards outside the break glass prefix don't match break glass permission names",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				allow(t, repo, "user/u", "ns/n", "**")
+				allow(t, repo, "user/u", "ns/n", "oort.**")
+				allow(t, repo, "user/u", "ns/n", "oort.breakglass.**")
+				name := domain.BreakGlassPermissionName("db.write")
+				expected := map[level][]string{{sub: 0, obj: 0}: {"allow oort.breakglass.**"}}
+				assert.Equal(t, expected, levels(t, repo, "user/u", "ns/n", name))
+			},
+		},
+		scenario{
+			description: "implying allow",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				createImplication(t, repo, "admin", "write")
+				createImplication(t, repo, "write", "read")
+				allow(t, repo, "user/u", "ns/n", "admin")
+				assert.Equal(t, map[level][]string{{sub: 0, obj: 0}: {"allow admin"}}, levels(t, repo, "user/u", "ns/n", "read"))
+				assert.True(t, authorized(t, repo, "user/u", "ns/n", "read"))
+			},
+		},
+		scenario{
+			description: "implying deny",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				createImplication(t, repo, "admin", "read")
+				deny(t, repo, "user/u", "ns/n", "admin")
+				assert.Empty(t, levels(t, repo, "user/u", "ns/n", "read"))
+			},
+		},
+		scenario{
+			description: "implied allow",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				createImplication(t, repo, "admin", "read")
+				allow(t, repo, "user/u", "ns/n", "read")
+				assert.Empty(t, levels(t, repo, "user/u", "ns/n", "admin"))
+			},
+		},
+		scenario{
+			description: "deleted implication",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				createImplication(t, repo, "admin", "read")
+				allow(t, repo, "user/u", "ns/n", "admin")
+				deleteImplication(t, repo, "admin", "read")
+				assert.Empty(t, levels(t, repo, "user/u", "ns/n", "read"))
+			},
+		},
+		scenario{
+			description: "policy validity",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				now := time.Now()
+				active := domain.Validity{NotBefore: now.Add(-time.Hour), NotAfter: now.Add(time.Hour)}
+				pending := domain.Validity{NotBefore: now.Add(time.Hour)}
+				expired := domain.Validity{NotAfter: now.Add(-time.Hour)}
+				createPolicy(t, repo, "user/u", "ns/n", permission(t, "read", domain.PermissionKindAllow), active)
+				createPolicy(t, repo, "user/u", "ns/n", permission(t, "read", domain.PermissionKindDeny), pending)
+				createPolicy(t, repo, "user/u", "ns/n", permission(t, "write", domain.PermissionKindAllow), expired)
+				assert.Equal(t, map[level][]string{{sub: 0, obj: 0}: {"allow read"}}, levels(t, repo, "user/u", "ns/n", "read"))
+				assert.Empty(t, levels(t, repo, "user/u", "ns/n", "write"))
+			},
+		},
+		scenario{
+			description: "deleted policy",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				allow(t, repo, "user/u", "ns/n", "read")
+				deny(t, repo, "user/u", "ns/n", "read")
+				deletePolicy(t, repo, "user/u", "ns/n", "read", domain.PermissionKindDeny)
+				assert.Equal(t, map[level][]string{{sub: 0, obj: 0}: {"allow read"}}, levels(t, repo, "user/u", "ns/n", "read"))
+			},
+		},
+		scenario{
+			description: "recreated policy replaces its condition",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				createPolicy(t, repo, "user/u", "ns/n", conditionalPermission(t, "read", domain.PermissionKindAllow, "sub_age > 18"), domain.Validity{})
+				createPolicy(t, repo, "user/u", "ns/n", conditionalPermission(t, "read", domain.PermissionKindAllow, "sub_age > 21"), domain.Validity{})
+				resp := repo.GetPermissionHierarchy(ctx, domain.GetPermissionHierarchyReq{
+					Subject:        resource(t, "user/u"),
+					Object:         resource(t, "ns/n"),
+					PermissionName: "read",
+				})
+				require.NoError(t, resp.Error)
+				require.Len(t, resp.Hierarchy[0][0], 1)
+				assert.Equal(t, "sub_age > 21", resp.Hierarchy[0][0][0].Condition().Expression())
+			},
+		},
+		scenario{
+			description: "deleted relation",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				inherit(t, repo, "group/g", "user/u")
+				allow(t, repo, "group/g", "ns/n", "read")
+				resp := repo.DeleteRelation(ctx, domain.DeleteRelationReq{From: resource(t, "group/g"), To: resource(t, "user/u"), Type: domain.InheritsFrom})
+				require.NoError(t, resp.Error)
+				assert.Empty(t, levels(t, repo, "user/u", "ns/n", "read"))
+			},
+		},
+		scenario{
+			description: "relation types propagating permissions",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				putRelationType(t, repo, "MEMBER_OF", true, false)
+				putRelationType(t, repo, "OWNED_BY", false, true)
+				relate(t, repo, "group/g", "user/u", "MEMBER_OF")
+				relate(t, repo, "user/owner", "user/u", "OWNED_BY")
+				allow(t, repo, "group/g", "ns/n", "read")
+				allow(t, repo, "user/owner", "ns/n", "write")
+				assert.Equal(t, map[level][]string{{sub: -1, obj: 0}: {"allow read"}}, levels(t, repo, "user/u", "ns/n", "read"))
+				assert.Empty(t, levels(t, repo, "user/u", "ns/n", "write"))
+			},
+		},
+		scenario{
+			description: "mixed relation types in one path",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				putRelationType(t, repo, "MEMBER_OF", true, false)
+				relate(t, repo, "group/g", "user/u", "MEMBER_OF")
+				inherit(t, repo, "org/o", "group/g")
+				allow(t, repo, "org/o", "ns/n", "read")
+				assert.Equal(t, map[level][]string{{sub: -2, obj: 0}: {"allow read"}}, levels(t, repo, "user/u", "ns/n", "read"))
+			},
+		},
+	)
+}
+
+func setCombiningAlgorithm(t *testing.T, repo domain.RHABACRepo, permName string, algorithm domain.CombiningAlgorithm) {
+	resp := repo.SetCombiningAlgorithm(ctx, domain.SetCombiningAlgorithmReq{PermissionName: permName, Algorithm: algorithm})
+	require.NoError(t, resp.Error)
+}
+
+func implication(t *testing.T, permName, impliedName string) domain.PermissionImplication {
+	i, err := domain.NewPermissionImplication(permName, impliedName)
+	require.NoError(t, err)
+	return *i
+}
+
+func createImplication(t *testing.T, repo domain.RHABACRepo, permName, impliedName string) {
+	resp := repo.CreatePermissionImplication(ctx, domain.CreatePermissionImplicationReq{Implication: implication(t, permName, impliedName)})
+	require.NoError(t, resp.Error)
+}
+
+func deleteImplication(t *testing.T, repo domain.RHABACRepo, permName, impliedName string) {
+	resp := repo.DeletePermissionImplication(ctx, domain.DeletePermissionImplicationReq{Implication: implication(t, permName, impliedName)})
+	require.NoError(t, resp.Error)
+}
+
+func putRelationType(t *testing.T, repo domain.RHABACRepo, name string, permissions, attributes bool) {
+	relationType, err := domain.NewRelationType(name, permissions, attributes)
+	require.NoError(t, err)
+	resp := repo.PutRelationType(ctx, domain.PutRelationTypeReq{RelationType: *relationType})
+	require.NoError(t, resp.Error)
+}
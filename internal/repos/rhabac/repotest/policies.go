@@ -0,0 +1,165 @@
+package repotest
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/c12s/oort/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func policyScenarios() []scenario {
+	scenarios := make([]scenario, 0)
+	// the policies of a deleted resource don't come back when it is created again
+	for _, deleted := range [][]string{{"group/g"}, {"ns/n"}, {"group/g", "ns/n"}} {
+		names := deleted
+		scenarios = append(scenarios, scenario{
+			description: fmt.Sprintf("policies after deleting %v", names),
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				inherit(t, repo, "group/g", "user/u")
+				inherit(t, repo, "ns/n", "app/a")
+				allow(t, repo, "group/g", "ns/n", "read")
+				deny(t, repo, "group/g", "ns/n", "write")
+				allow(t, repo, "user/u", "app/a", "list")
+				for _, name := range names {
+					deleteResource(t, repo, name)
+				}
+				assert.Empty(t, levels(t, repo, "user/u", "app/a", "read"))
+				assert.Empty(t, levels(t, repo, "user/u", "app/a", "write"))
+				assert.Equal(t, []string{"list@app/a"}, applicable(t, repo, "user/u"))
+				for _, name := range names {
+					createResource(t, repo, name)
+				}
+				inherit(t, repo, "group/g", "user/u")
+				inherit(t, repo, "ns/n", "app/a")
+				assert.Empty(t, levels(t, repo, "user/u", "app/a", "read"))
+				assert.Empty(t, levels(t, repo, "group/g", "ns/n", "write"))
+				assert.Equal(t, []string{"list@app/a"}, applicable(t, repo, "user/u"))
+			},
+		})
+	}
+	for depth := 0; depth <= 5; depth++ {
+		d := depth
+		scenarios = append(scenarios, scenario{
+			description: fmt.Sprintf("applicable policies on an object chain of depth %d", d),
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				objs := chain(t, repo, "ns", d)
+				allow(t, repo, "user/u", objs[0], "read")
+				expected := make([]string, 0, d+1)
+				for _, obj := range objs {
+					expected = append(expected, "read@"+obj)
+				}
+				assert.Equal(t, distinctSorted(expected), applicable(t, repo, "user/u"))
+			},
+		})
+	}
+	return append(scenarios,
+		scenario{
+			description: "applicable policies of an unknown subject",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				allow(t, repo, "user/u", "ns/n", "read")
+				assert.Empty(t, applicable(t, repo, "user/unknown"))
+			},
+		},
+		scenario{
+			description: "applicable policies of ancestors",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				inherit(t, repo, "org/o", "group/g")
+				inherit(t, repo, "group/g", "user/u")
+				allow(t, repo, "org/o", "ns/a", "read")
+				allow(t, repo, "group/g", "ns/b", "write")
+				allow(t, repo, "user/u", "ns/c", "list")
+				assert.Equal(t, []string{"list@ns/c", "read@ns/a", "write@ns/b"}, applicable(t, repo, "user/u"))
+				assert.Equal(t, []string{"read@ns/a", "write@ns/b"}, applicable(t, repo, "group/g"))
+			},
+		},
+		scenario{
+			description: "applicable policies are distinct",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				inherit(t, repo, "group/a", "user/u")
+				inherit(t, repo, "group/b", "user/u")
+				allow(t, repo, "group/a", "ns/n", "read")
+				allow(t, repo, "group/b", "ns/n", "read")
+				resp := repo.GetApplicablePolicies(ctx, domain.GetApplicablePoliciesReq{Subject: resource(t, "user/u")})
+				require.NoError(t, resp.Error)
+				assert.Len(t, resp.Policies, 1)
+			},
+		},
+		scenario{
+			description: "applicable policies include implied names of allows",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				createImplication(t, repo, "admin", "write")
+				createImplication(t, repo, "write", "read")
+				allow(t, repo, "user/u", "ns/a", "admin")
+				deny(t, repo, "user/u", "ns/b", "admin")
+				assert.Equal(t, []string{"admin@ns/a", "admin@ns/b", "read@ns/a", "write@ns/a"}, applicable(t, repo, "user/u"))
+			},
+		},
+		scenario{
+			description: "applicable policies are active",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				now := time.Now()
+				createPolicy(t, repo, "user/u", "ns/n", permission(t, "read", domain.PermissionKindAllow), domain.Validity{NotAfter: now.Add(-time.Minute)})
+				createPolicy(t, repo, "user/u", "ns/n", permission(t, "write", domain.PermissionKindAllow), domain.Validity{NotBefore: now.Add(time.Minute)})
+				createPolicy(t, repo, "user/u", "ns/n", permission(t, "list", domain.PermissionKindAllow), domain.Validity{NotAfter: now.Add(time.Minute)})
+				assert.Equal(t, []string{"list@ns/n"}, applicable(t, repo, "user/u"))
+			},
+		},
+		scenario{
+			description: "applicable policies through relation types",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				putRelationType(t, repo, "MEMBER_OF", true, false)
+				putRelationType(t, repo, "OWNED_BY", false, false)
+				relate(t, repo, "group/g", "user/u", "MEMBER_OF")
+				relate(t, repo, "user/owner", "user/u", "OWNED_BY")
+				relate(t, repo, "ns/n", "app/a", "MEMBER_OF")
+				relate(t, repo, "ns/n", "app/b", "OWNED_BY")
+				allow(t, repo, "group/g", "ns/n", "read")
+				allow(t, repo, "user/owner", "ns/n", "write")
+				assert.Equal(t, []string{"read@app/a", "read@ns/n"}, applicable(t, repo, "user/u"))
+			},
+		},
+		scenario{
+			description: "delete expired policies",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				now := time.Now()
+				createPolicy(t, repo, "user/u", "ns/n", permission(t, "read", domain.PermissionKindAllow), domain.Validity{NotAfter: now.Add(-time.Minute)})
+				createPolicy(t, repo, "user/u", "ns/n", permission(t, "write", domain.PermissionKindDeny), domain.Validity{NotAfter: now.Add(-time.Hour)})
+				createPolicy(t, repo, "user/u", "ns/n", permission(t, "list", domain.PermissionKindAllow), domain.Validity{NotAfter: now.Add(time.Minute)})
+				createPolicy(t, repo, "user/u", "ns/n", permission(t, "get", domain.PermissionKindAllow), domain.Validity{})
+				resp := repo.DeleteExpiredPolicies(ctx, domain.DeleteExpiredPoliciesReq{Now: now})
+				require.NoError(t, resp.Error)
+				expired := make([]string, 0, len(resp.Policies))
+				for _, p := range resp.Policies {
+					expired = append(expired, fmt.Sprintf("%s %s@%s", p.Subject.Name(), p.PermissionName, p.Object.Name()))
+				}
+				assert.ElementsMatch(t, []string{"user/u read@ns/n", "user/u write@ns/n"}, expired)
+				assert.Equal(t, []string{"get@ns/n", "list@ns/n"}, applicable(t, repo, "user/u"))
+				resp = repo.DeleteExpiredPolicies(ctx, domain.DeleteExpiredPoliciesReq{Now: now})
+				require.NoError(t, resp.Error)
+				assert.Empty(t, resp.Policies)
+			},
+		},
+		scenario{
+			description: "delete unknown policy",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				allow(t, repo, "user/u", "ns/n", "read")
+				deletePolicy(t, repo, "user/u", "ns/n", "read", domain.PermissionKindDeny)
+				deletePolicy(t, repo, "user/u", "ns/n", "write", domain.PermissionKindAllow)
+				deletePolicy(t, repo, "user/unknown", "ns/n", "read", domain.PermissionKindAllow)
+				assert.Equal(t, []string{"read@ns/n"}, applicable(t, repo, "user/u"))
+			},
+		},
+		scenario{
+			description: "create policy creates its resources",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				allow(t, repo, "user/u", "ns/n", "read")
+				assert.Empty(t, attributes(t, repo, "user/u"))
+				assert.Empty(t, attributes(t, repo, "ns/n"))
+				assert.Equal(t, map[string]int{rootName(): 1}, ancestors(t, repo, "ns/n"))
+			},
+		},
+	)
+}
@@ -0,0 +1,259 @@
+package repotest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/c12s/oort/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func relationScenarios() []scenario {
+	scenarios := make([]scenario, 0)
+	for length := 0; length <= 10; length++ {
+		l := length
+		scenarios = append(scenarios, scenario{
+			description: fmt.Sprintf("closing a chain of length %d into a cycle", l),
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				names := chain(t, repo, "group", l)
+				resp := repo.CreateRelation(ctx, createRelationReq(t, names[l], names[0], domain.InheritsFrom))
+				assert.ErrorIs(t, resp.Error, domain.ErrInheritanceCycle)
+				// the rejected relation isn't created
+				assert.NotContains(t, ancestors(t, repo, names[0]), names[l])
+				assertNoCycles(t, repo)
+			},
+		})
+	}
+	// a chain of n relations between resources, together with the relation of its first resource to the root,
+	// is n+1 relations deep
+	for length := 1; length <= 8; length++ {
+		l := length
+		scenarios = append(scenarios,
+			scenario{
+				description: fmt.Sprintf("chain of length %d within the maximum depth", l),
+				run: func(t *testing.T, repo domain.RHABACRepo) {
+					names := chain(t, repo, "group", l-1)
+					req := createRelationReq(t, names[l-1], "user/u", domain.InheritsFrom)
+					req.MaxDepth = l + 1
+					assert.NoError(t, repo.CreateRelation(ctx, req).Error)
+				},
+			},
+			scenario{
+				description: fmt.Sprintf("chain of length %d over the maximum depth", l),
+				run: func(t *testing.T, repo domain.RHABACRepo) {
+					names := chain(t, repo, "group", l-1)
+					req := createRelationReq(t, names[l-1], "user/u", domain.InheritsFrom)
+					req.MaxDepth = l
+					assert.ErrorIs(t, repo.CreateRelation(ctx, req).Error, domain.ErrInheritanceDepth)
+					assert.NotContains(t, ancestors(t, repo, "user/u"), names[l-1])
+				},
+			},
+		)
+	}
+	for depth := 1; depth <= 5; depth++ {
+		d := depth
+		scenarios = append(scenarios,
+			scenario{
+				description: fmt.Sprintf("related within depth %d", d),
+				run: func(t *testing.T, repo domain.RHABACRepo) {
+					putRelationType(t, repo, "MANAGES", false, false)
+					names := make([]string, d+1)
+					for i := range names {
+						names[i] = fmt.Sprintf("user/%d", i)
+					}
+					for i := 0; i < d; i++ {
+						relate(t, repo, names[i+1], names[i], "MANAGES")
+					}
+					within := domain.GraphPredicate{Function: domain.RelatedFunction, From: domain.GraphOperandSubject, To: domain.GraphOperandObject, Argument: "MANAGES", MaxDepth: d}
+					short := domain.GraphPredicate{Function: domain.RelatedFunction, From: domain.GraphOperandSubject, To: domain.GraphOperandObject, Argument: "MANAGES", MaxDepth: d - 1}
+					backwards := domain.GraphPredicate{Function: domain.RelatedFunction, From: domain.GraphOperandObject, To: domain.GraphOperandSubject, Argument: "MANAGES", MaxDepth: d}
+					relations := resolve(t, repo, names[0], names[d], within, short, backwards)
+					assert.Equal(t, domain.GraphRelations{within: true, short: false, backwards: false}, relations)
+				},
+			},
+		)
+	}
+	return append(scenarios,
+		scenario{
+			description: "self relation",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				createResource(t, repo, "user/u")
+				resp := repo.CreateRelation(ctx, createRelationReq(t, "user/u", "user/u", domain.InheritsFrom))
+				assert.ErrorIs(t, resp.Error, domain.ErrInheritanceCycle)
+			},
+		},
+		scenario{
+			description: "cycle through another relation type",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				putRelationType(t, repo, "MEMBER_OF", true, false)
+				relate(t, repo, "group/g", "user/u", "MEMBER_OF")
+				resp := repo.CreateRelation(ctx, createRelationReq(t, "user/u", "group/g", domain.InheritsFrom))
+				assert.ErrorIs(t, resp.Error, domain.ErrInheritanceCycle)
+			},
+		},
+		scenario{
+			description: "relation to the root",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				createResource(t, repo, "user/u")
+				resp := repo.CreateRelation(ctx, createRelationReq(t, "user/u", rootName(), domain.InheritsFrom))
+				assert.ErrorIs(t, resp.Error, domain.ErrInheritanceCycle)
+			},
+		},
+		scenario{
+			description: "unknown relation type",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				resp := repo.CreateRelation(ctx, createRelationReq(t, "group/g", "user/u", "UNKNOWN"))
+				assert.ErrorIs(t, resp.Error, domain.ErrUnknownRelationType)
+			},
+		},
+		scenario{
+			description: "invalid relation type",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				resp := repo.CreateRelation(ctx, createRelationReq(t, "group/g", "user/u", "MEMBER_OF]->(r) DETACH DELETE r//"))
+				assert.Error(t, resp.Error)
+			},
+		},
+		scenario{
+			description: "no inheritance cycles",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				for i := 0; i < 5; i++ {
+					inherit(t, repo, "org/o", fmt.Sprintf("group/%d", i))
+					inherit(t, repo, fmt.Sprintf("group/%d", i), "user/u")
+				}
+				assertNoCycles(t, repo)
+			},
+		},
+		scenario{
+			description: "deleted relation is no longer followed",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				inherit(t, repo, "org/o", "group/g")
+				inherit(t, repo, "group/g", "user/u")
+				resp := repo.DeleteRelation(ctx, domain.DeleteRelationReq{From: resource(t, "org/o"), To: resource(t, "group/g"), Type: domain.InheritsFrom})
+				require.NoError(t, resp.Error)
+				assert.Equal(t, map[string]int{"group/g": 1, rootName(): 1}, ancestors(t, repo, "user/u"))
+				inherit(t, repo, "user/u", "org/o")
+			},
+		},
+		scenario{
+			description: "delete relation of another type",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				putRelationType(t, repo, "MEMBER_OF", true, true)
+				inherit(t, repo, "group/g", "user/u")
+				resp := repo.DeleteRelation(ctx, domain.DeleteRelationReq{From: resource(t, "group/g"), To: resource(t, "user/u"), Type: "MEMBER_OF"})
+				require.NoError(t, resp.Error)
+				assert.Contains(t, ancestors(t, repo, "user/u"), "group/g")
+			},
+		},
+		scenario{
+			description: "share ancestor",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				inherit(t, repo, "org/o", "group/a")
+				inherit(t, repo, "org/o", "group/b")
+				inherit(t, repo, "group/a", "user/a")
+				inherit(t, repo, "group/b", "user/b")
+				inherit(t, repo, "group/a", "user/c")
+				org := domain.GraphPredicate{Function: domain.ShareAncestorFunction, From: domain.GraphOperandSubject, To: domain.GraphOperandObject, Argument: "org"}
+				group := domain.GraphPredicate{Function: domain.ShareAncestorFunction, From: domain.GraphOperandSubject, To: domain.GraphOperandObject, Argument: "group"}
+				ns := domain.GraphPredicate{Function: domain.ShareAncestorFunction, From: domain.GraphOperandSubject, To: domain.GraphOperandObject, Argument: "ns"}
+				assert.Equal(t, domain.GraphRelations{org: true, group: false, ns: false}, resolve(t, repo, "user/a", "user/b", org, group, ns))
+				assert.Equal(t, domain.GraphRelations{org: true, group: true, ns: false}, resolve(t, repo, "user/a", "user/c", org, group, ns))
+			},
+		},
+		scenario{
+			description: "share ancestor along relations that propagate permissions",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				putRelationType(t, repo, "MEMBER_OF", true, false)
+				putRelationType(t, repo, "OWNED_BY", false, true)
+				relate(t, repo, "team/t", "user/a", "MEMBER_OF")
+				relate(t, repo, "team/t", "user/b", "MEMBER_OF")
+				relate(t, repo, "org/o", "user/a", "OWNED_BY")
+				relate(t, repo, "org/o", "user/b", "OWNED_BY")
+				team := domain.GraphPredicate{Function: domain.ShareAncestorFunction, From: domain.GraphOperandSubject, To: domain.GraphOperandObject, Argument: "team"}
+				org := domain.GraphPredicate{Function: domain.ShareAncestorFunction, From: domain.GraphOperandSubject, To: domain.GraphOperandObject, Argument: "org"}
+				assert.Equal(t, domain.GraphRelations{team: true, org: false}, resolve(t, repo, "user/a", "user/b", team, org))
+			},
+		},
+		scenario{
+			description: "graph predicates of unknown resources",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				putRelationType(t, repo, "MANAGES", false, false)
+				relate(t, repo, "user/b", "user/a", "MANAGES")
+				related := domain.GraphPredicate{Function: domain.RelatedFunction, From: domain.GraphOperandSubject, To: domain.GraphOperandObject, Argument: "MANAGES", MaxDepth: 1}
+				root := domain.GraphPredicate{Function: domain.ShareAncestorFunction, From: domain.GraphOperandSubject, To: domain.GraphOperandObject, Argument: "root"}
+				// every resource shares the root
+				assert.Equal(t, domain.GraphRelations{related: true, root: true}, resolve(t, repo, "user/a", "user/b", related, root))
+				assert.Equal(t, domain.GraphRelations{related: false, root: false}, resolve(t, repo, "user/a", "user/unknown", related, root))
+				assert.Equal(t, domain.GraphRelations{related: false, root: false}, resolve(t, repo, "user/unknown", "user/b", related, root))
+			},
+		},
+		scenario{
+			description: "relation types",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				putRelationType(t, repo, "OWNED_BY", false, true)
+				putRelationType(t, repo, "MEMBER_OF", true, false)
+				putRelationType(t, repo, "DEPLOYED_IN", false, false)
+				putRelationType(t, repo, "MEMBER_OF", true, true)
+				assert.Equal(t, []string{"INHERITS_FROM true true", "DEPLOYED_IN false false", "MEMBER_OF true true", "OWNED_BY false true"}, relationTypes(t, repo))
+			},
+		},
+		scenario{
+			description: "delete relation type",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				putRelationType(t, repo, "MEMBER_OF", true, false)
+				putRelationType(t, repo, "OWNED_BY", true, false)
+				resp := repo.DeleteRelationType(ctx, domain.DeleteRelationTypeReq{Name: "OWNED_BY"})
+				require.NoError(t, resp.Error)
+				assert.Equal(t, []string{"INHERITS_FROM true true", "MEMBER_OF true false"}, relationTypes(t, repo))
+			},
+		},
+		scenario{
+			description: "delete unknown relation type",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				resp := repo.DeleteRelationType(ctx, domain.DeleteRelationTypeReq{Name: "UNKNOWN"})
+				assert.NoError(t, resp.Error)
+				assert.Equal(t, []string{"INHERITS_FROM true true"}, relationTypes(t, repo))
+			},
+		},
+		scenario{
+			description: "delete relation type in use",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				putRelationType(t, repo, "MEMBER_OF", true, false)
+				relate(t, repo, "group/g", "user/u", "MEMBER_OF")
+				resp := repo.DeleteRelationType(ctx, domain.DeleteRelationTypeReq{Name: "MEMBER_OF"})
+				assert.ErrorIs(t, resp.Error, domain.ErrRelationTypeInUse)
+				resp = repo.DeleteRelation(ctx, domain.DeleteRelationReq{From: resource(t, "group/g"), To: resource(t, "user/u"), Type: "MEMBER_OF"})
+				require.NoError(t, resp.Error)
+				resp = repo.DeleteRelationType(ctx, domain.DeleteRelationTypeReq{Name: "MEMBER_OF"})
+				assert.NoError(t, resp.Error)
+			},
+		},
+	)
+}
+
+func assertNoCycles(t *testing.T, repo domain.RHABACRepo) {
+	resp := repo.GetInheritanceCycles(ctx, domain.GetInheritanceCyclesReq{})
+	require.NoError(t, resp.Error)
+	assert.Empty(t, resp.Cycles)
+}
+
+func resolve(t *testing.T, repo domain.RHABACRepo, sub, obj string, predicates ...domain.GraphPredicate) domain.GraphRelations {
+	resp := repo.ResolveGraphPredicates(ctx, domain.ResolveGraphPredicatesReq{
+		Subject:    resource(t, sub),
+		Object:     resource(t, obj),
+		Predicates: predicates,
+	})
+	require.NoError(t, resp.Error)
+	return resp.Relations
+}
+
+// relationTypes returns the relation types in the order the repo returns them
+func relationTypes(t *testing.T, repo domain.RHABACRepo) []string {
+	resp := repo.GetRelationTypes(ctx, domain.GetRelationTypesReq{})
+	require.NoError(t, resp.Error)
+	relationTypes := make([]string, 0, len(resp.RelationTypes))
+	for _, relationType := range resp.RelationTypes {
+		relationTypes = append(relationTypes, fmt.Sprintf("%s %t %t", relationType.Name(), relationType.PropagatesPermissions(), relationType.PropagatesAttributes()))
+	}
+	return relationTypes
+}
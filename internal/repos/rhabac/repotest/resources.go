@@ -0,0 +1,147 @@
+package repotest
+
+import (
+	"testing"
+
+	"github.com/c12s/oort/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var unknownNames = []string{"user/unknown", "org/unknown", "ns/a/b", "root/"}
+
+func resourceScenarios() []scenario {
+	scenarios := make([]scenario, 0)
+	for _, name := range unknownNames {
+		n := name
+		scenarios = append(scenarios, scenario{
+			description: "get unknown resource " + n + " from an empty graph",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				resp := repo.GetResource(ctx, domain.GetResourceReq{Resource: resource(t, n)})
+				assert.Error(t, resp.Error)
+			},
+		}, scenario{
+			description: "get unknown resource " + n + " from a populated graph",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				createResource(t, repo, "user/known")
+				createResource(t, repo, "org/known")
+				resp := repo.GetResource(ctx, domain.GetResourceReq{Resource: resource(t, n)})
+				if n == rootName() {
+					// every resource inherits from the root, so it exists once any resource does
+					assert.NoError(t, resp.Error)
+					return
+				}
+				assert.Error(t, resp.Error)
+			},
+		})
+	}
+	return append(scenarios,
+		scenario{
+			description: "get created resource",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				createResource(t, repo, "user/u")
+				assert.Empty(t, attributes(t, repo, "user/u"))
+			},
+		},
+		scenario{
+			description: "created resource has its attributes",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				r := resource(t, "user/u")
+				r.Attributes = []domain.Attribute{
+					attribute(t, "age", domain.Int64, int64(30)),
+					attribute(t, "tier", domain.String, "gold"),
+				}
+				require.NoError(t, repo.CreateResource(ctx, domain.CreateResourceReq{Resource: r}).Error)
+				attrs := attributes(t, repo, "user/u")
+				require.Len(t, attrs, 2)
+				assert.Equal(t, int64(30), attrs["age"].Value())
+				assert.Equal(t, "gold", attrs["tier"].Value())
+			},
+		},
+		scenario{
+			description: "create resource twice",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				createResource(t, repo, "user/u")
+				createResource(t, repo, "user/u")
+				allow(t, repo, rootName(), rootName(), "read")
+				assert.Equal(t, map[level][]string{{sub: -1, obj: -1}: {"allow read"}}, levels(t, repo, "user/u", "user/u", "read"))
+			},
+		},
+		scenario{
+			description: "created resource inherits from the root",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				createResource(t, repo, "user/u")
+				createResource(t, repo, "ns/n")
+				assert.Equal(t, map[string]int{rootName(): 1}, ancestors(t, repo, "user/u"))
+			},
+		},
+		scenario{
+			description: "deleted resource isn't found",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				createResource(t, repo, "user/u")
+				deleteResource(t, repo, "user/u")
+				resp := repo.GetResource(ctx, domain.GetResourceReq{Resource: resource(t, "user/u")})
+				assert.Error(t, resp.Error)
+			},
+		},
+		scenario{
+			description: "delete unknown resource",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				resp := repo.DeleteResource(ctx, domain.DeleteResourceReq{Resource: resource(t, "user/unknown")})
+				assert.NoError(t, resp.Error)
+			},
+		},
+		scenario{
+			description: "deleted resource loses its attributes",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				putAttribute(t, repo, "user/u", attribute(t, "age", domain.Int64, int64(30)))
+				deleteResource(t, repo, "user/u")
+				createResource(t, repo, "user/u")
+				assert.Empty(t, attributes(t, repo, "user/u"))
+			},
+		},
+		scenario{
+			description: "deleted resource keeps its children",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				inherit(t, repo, "org/o", "user/u")
+				allow(t, repo, rootName(), "ns/n", "read")
+				deleteResource(t, repo, "org/o")
+				assert.Empty(t, attributes(t, repo, "user/u"))
+				assert.Equal(t, map[string]int{rootName(): 1}, ancestors(t, repo, "user/u"))
+				assert.Equal(t, map[level][]string{{sub: -1, obj: 0}: {"allow read"}}, levels(t, repo, "user/u", "ns/n", "read"))
+			},
+		},
+		scenario{
+			description: "deleted resource keeps the resources related to it",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				inherit(t, repo, "user/u", "org/o")
+				deleteResource(t, repo, "org/o")
+				assert.Empty(t, attributes(t, repo, "user/u"))
+				assert.Equal(t, map[string]int{rootName(): 1}, ancestors(t, repo, "user/u"))
+			},
+		},
+		scenario{
+			description: "deleted resource is no longer inherited from",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				inherit(t, repo, "org/o", "user/u")
+				allow(t, repo, "org/o", "ns/n", "read")
+				deleteResource(t, repo, "org/o")
+				createResource(t, repo, "org/o")
+				assert.Equal(t, map[string]int{rootName(): 1}, ancestors(t, repo, "user/u"))
+				assert.Empty(t, levels(t, repo, "user/u", "ns/n", "read"))
+			},
+		},
+	)
+}
+
+func putAttribute(t *testing.T, repo domain.RHABACRepo, name string, attr domain.Attribute) {
+	resp := repo.PutAttribute(ctx, domain.PutAttributeReq{Resource: resource(t, name), Attribute: attr})
+	require.NoError(t, resp.Error)
+}
+
+func deleteAttribute(t *testing.T, repo domain.RHABACRepo, name, attrName string) {
+	id, err := domain.NewAttributeId(attrName)
+	require.NoError(t, err)
+	resp := repo.DeleteAttribute(ctx, domain.DeleteAttributeReq{Resource: resource(t, name), AttributeId: *id})
+	require.NoError(t, resp.Error)
+}
@@ -0,0 +1,182 @@
+package repotest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/c12s/oort/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func sodScenarios() []scenario {
+	return []scenario{
+		{
+			description: "static constraint violated by existing policies",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				inherit(t, repo, "group/g", "user/u")
+				allow(t, repo, "group/g", "ns/n", "approve")
+				allow(t, repo, "user/u", "ns/n", "submit")
+				resp := repo.CreateSoDConstraint(ctx, domain.CreateSoDConstraintReq{Constraint: sodConstraint(t, "four-eyes", domain.SoDStatic, "approve", "submit")})
+				requireSoDViolation(t, resp.Error, "four-eyes", "user/u", "ns/n")
+				assert.Empty(t, sodConstraints(t, repo, domain.SoDStatic, ""))
+			},
+		},
+		{
+			description: "static constraint violated through implications",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				createImplication(t, repo, "admin", "approve")
+				allow(t, repo, "user/u", "ns/n", "admin")
+				allow(t, repo, "user/u", "ns/n", "submit")
+				resp := repo.CreateSoDConstraint(ctx, domain.CreateSoDConstraintReq{Constraint: sodConstraint(t, "four-eyes", domain.SoDStatic, "approve", "submit")})
+				requireSoDViolation(t, resp.Error, "four-eyes", "user/u", "ns/n")
+			},
+		},
+		{
+			description: "static constraint isn't violated by denies or expired policies",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				allow(t, repo, "user/u", "ns/n", "approve")
+				deny(t, repo, "user/u", "ns/n", "submit")
+				createPolicy(t, repo, "user/u", "ns/n", permission(t, "submit", domain.PermissionKindAllow), domain.Validity{NotAfter: time.Now().Add(-time.Minute)})
+				allow(t, repo, "user/u", "ns/other", "submit")
+				resp := repo.CreateSoDConstraint(ctx, domain.CreateSoDConstraintReq{Constraint: sodConstraint(t, "four-eyes", domain.SoDStatic, "approve", "submit")})
+				assert.NoError(t, resp.Error)
+			},
+		},
+		{
+			description: "policy violating a static constraint",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				constraint := sodConstraint(t, "four-eyes", domain.SoDStatic, "approve", "submit")
+				inherit(t, repo, "group/g", "user/u")
+				allow(t, repo, "user/u", "ns/n", "submit")
+				resp := repo.CreatePolicy(ctx, domain.CreatePolicyReq{
+					SubjectScope:   resource(t, "group/g"),
+					ObjectScope:    resource(t, "ns/n"),
+					Permission:     permission(t, "approve", domain.PermissionKindAllow),
+					SoDConstraints: []domain.SoDConstraint{constraint},
+				})
+				requireSoDViolation(t, resp.Error, "four-eyes", "user/u", "ns/n")
+				// the rejected policy isn't created
+				assert.Equal(t, []string{"submit@ns/n"}, applicable(t, repo, "user/u"))
+			},
+		},
+		{
+			description: "relation violating a static constraint",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				constraint := sodConstraint(t, "four-eyes", domain.SoDStatic, "approve", "submit")
+				allow(t, repo, "group/g", "ns/n", "approve")
+				allow(t, repo, "user/u", "ns/n", "submit")
+				req := createRelationReq(t, "group/g", "user/u", domain.InheritsFrom)
+				req.SoDConstraints = []domain.SoDConstraint{constraint}
+				resp := repo.CreateRelation(ctx, req)
+				requireSoDViolation(t, resp.Error, "four-eyes", "user/u", "ns/n")
+				// the rejected relation isn't created
+				assert.Equal(t, map[string]int{rootName(): 1}, ancestors(t, repo, "user/u"))
+			},
+		},
+		{
+			description: "relation violating a static constraint on objects",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				constraint := sodConstraint(t, "four-eyes", domain.SoDStatic, "approve", "submit")
+				allow(t, repo, "user/u", "ns/n", "approve")
+				allow(t, repo, "user/u", "app/a", "submit")
+				req := createRelationReq(t, "ns/n", "app/a", domain.InheritsFrom)
+				req.SoDConstraints = []domain.SoDConstraint{constraint}
+				resp := repo.CreateRelation(ctx, req)
+				requireSoDViolation(t, resp.Error, "four-eyes", "user/u", "app/a")
+			},
+		},
+		{
+			description: "get sod constraints",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				for _, constraint := range []domain.SoDConstraint{
+					sodConstraint(t, "b", domain.SoDStatic, "approve", "submit"),
+					sodConstraint(t, "a", domain.SoDStatic, "read", "write"),
+					sodConstraint(t, "c", domain.SoDDynamic, "approve", "audit"),
+				} {
+					resp := repo.CreateSoDConstraint(ctx, domain.CreateSoDConstraintReq{Constraint: constraint})
+					require.NoError(t, resp.Error)
+				}
+				assert.ElementsMatch(t, []string{"a", "b"}, sodConstraints(t, repo, domain.SoDStatic, ""))
+				assert.ElementsMatch(t, []string{"b"}, sodConstraints(t, repo, domain.SoDStatic, "approve"))
+				assert.ElementsMatch(t, []string{"c"}, sodConstraints(t, repo, domain.SoDDynamic, ""))
+				assert.Empty(t, sodConstraints(t, repo, domain.SoDDynamic, "read"))
+				resp := repo.DeleteSoDConstraint(ctx, domain.DeleteSoDConstraintReq{Name: "b"})
+				require.NoError(t, resp.Error)
+				assert.ElementsMatch(t, []string{"a"}, sodConstraints(t, repo, domain.SoDStatic, ""))
+			},
+		},
+		{
+			description: "exercise conflicting permissions",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				constraints := []domain.SoDConstraint{sodConstraint(t, "four-eyes", domain.SoDDynamic, "approve", "submit")}
+				assert.NoError(t, exercise(t, repo, "user/u", "ns/n", "submit", constraints))
+				assert.NoError(t, exercise(t, repo, "user/u", "ns/n", "submit", constraints))
+				requireSoDViolation(t, exercise(t, repo, "user/u", "ns/n", "approve", constraints), "four-eyes", "user/u", "ns/n")
+				assert.NoError(t, exercise(t, repo, "user/u", "ns/other", "approve", constraints))
+				assert.NoError(t, exercise(t, repo, "user/other", "ns/n", "approve", constraints))
+			},
+		},
+		{
+			description: "exercise without constraints isn't recorded",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				constraints := []domain.SoDConstraint{sodConstraint(t, "four-eyes", domain.SoDDynamic, "approve", "submit")}
+				assert.NoError(t, exercise(t, repo, "user/u", "ns/n", "submit", nil))
+				assert.NoError(t, exercise(t, repo, "user/u", "ns/n", "approve", constraints))
+			},
+		},
+		{
+			description: "dry run exercise isn't recorded",
+			run: func(t *testing.T, repo domain.RHABACRepo) {
+				constraints := []domain.SoDConstraint{sodConstraint(t, "four-eyes", domain.SoDDynamic, "approve", "submit")}
+				assert.NoError(t, dryRunExercise(t, repo, "user/u", "ns/n", "submit", constraints))
+				assert.NoError(t, exercise(t, repo, "user/u", "ns/n", "approve", constraints))
+				requireSoDViolation(t, dryRunExercise(t, repo, "user/u", "ns/n", "submit", constraints), "four-eyes", "user/u", "ns/n")
+				assert.NoError(t, dryRunExercise(t, repo, "user/u", "ns/n", "approve", constraints))
+			},
+		},
+	}
+}
+
+func sodConstraint(t *testing.T, name string, kind domain.SoDKind, permissions ...string) domain.SoDConstraint {
+	constraint, err := domain.NewSoDConstraint(name, kind, permissions)
+	require.NoError(t, err)
+	return *constraint
+}
+
+func sodConstraints(t *testing.T, repo domain.RHABACRepo, kind domain.SoDKind, permName string) []string {
+	resp := repo.GetSoDConstraints(ctx, domain.GetSoDConstraintsReq{Kind: kind, PermissionName: permName})
+	require.NoError(t, resp.Error)
+	names := make([]string, 0, len(resp.Constraints))
+	for _, constraint := range resp.Constraints {
+		names = append(names, constraint.Name())
+	}
+	return names
+}
+
+func exercise(t *testing.T, repo domain.RHABACRepo, sub, obj, permName string, constraints []domain.SoDConstraint) error {
+	return repo.ExercisePermission(ctx, domain.ExercisePermissionReq{
+		Subject:        resource(t, sub),
+		Object:         resource(t, obj),
+		PermissionName: permName,
+		SoDConstraints: constraints,
+	}).Error
+}
+
+func dryRunExercise(t *testing.T, repo domain.RHABACRepo, sub, obj, permName string, constraints []domain.SoDConstraint) error {
+	return repo.ExercisePermission(ctx, domain.ExercisePermissionReq{
+		Subject:        resource(t, sub),
+		Object:         resource(t, obj),
+		PermissionName: permName,
+		SoDConstraints: constraints,
+		DryRun:         true,
+	}).Error
+}
+
+func requireSoDViolation(t *testing.T, err error, constraint, sub, obj string) {
+	var violation domain.SoDViolation
+	require.ErrorAs(t, err, &violation)
+	assert.Equal(t, constraint, violation.Constraint)
+	assert.Equal(t, sub, violation.Subject.Name())
+	assert.Equal(t, obj, violation.Object.Name())
+}
@@ -0,0 +1,276 @@
+// Package repotest is a conformance suite for domain.RHABACRepo implementations.
+// It pins down what the services rely on a repo for, such as how priorities are derived from the graph,
+// what is returned for unknown resources and what deleting a resource does to its policies,
+// so that every backend can be checked against the same expectations.
+package repotest
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/c12s/oort/internal/domain"
+	"github.com/stretchr/testify/require"
+)
+
+// NewRepo returns an empty repo, it is called once for every scenario.
+// Scenarios don't run in parallel, so a constructor may clear and reuse a shared database.
+type NewRepo func(t *testing.T) domain.RHABACRepo
+
+type scenario struct {
+	description string
+	run         func(t *testing.T, repo domain.RHABACRepo)
+}
+
+// Run runs every scenario of the suite against repos created by newRepo
+func Run(t *testing.T, newRepo NewRepo) {
+	groups := []struct {
+		name      string
+		scenarios []scenario
+	}{
+		{name: "resources", scenarios: resourceScenarios()},
+		{name: "attributes", scenarios: attributeScenarios()},
+		{name: "hierarchy", scenarios: hierarchyScenarios()},
+		{name: "policies", scenarios: policyScenarios()},
+		{name: "relations", scenarios: relationScenarios()},
+		{name: "administration", scenarios: administrationScenarios()},
+		{name: "separation of duty", scenarios: sodScenarios()},
+		{name: "batches", scenarios: batchScenarios()},
+		{name: "authorization data", scenarios: authorizationDataScenarios()},
+	}
+	for _, group := range groups {
+		g := group
+		t.Run(g.name, func(t *testing.T) {
+			for _, s := range g.scenarios {
+				sc := s
+				t.Run(sc.description, func(t *testing.T) {
+					sc.run(t, newRepo(t))
+				})
+			}
+		})
+	}
+}
+
+var ctx = context.Background()
+
+// maxDepth doesn't limit any graph the scenarios build, unless they test the limit
+const maxDepth = domain.DefaultMaxInheritanceDepth
+
+func resource(t *testing.T, name string) domain.Resource {
+	r, err := domain.NewResourceFromName(name)
+	require.NoError(t, err)
+	return *r
+}
+
+func permission(t *testing.T, name string, kind domain.PermissionKind) domain.Permission {
+	return conditionalPermission(t, name, kind, "")
+}
+
+func conditionalPermission(t *testing.T, name string, kind domain.PermissionKind, condition string) domain.Permission {
+	cond, err := domain.NewCondition(condition)
+	require.NoError(t, err)
+	p, err := domain.NewPermission(name, kind, *cond, domain.ConditionErrorSkip, nil)
+	require.NoError(t, err)
+	return *p
+}
+
+func attribute(t *testing.T, name string, kind domain.AttributeKind, value interface{}) domain.Attribute {
+	id, err := domain.NewAttributeId(name)
+	require.NoError(t, err)
+	attr, err := domain.NewAttribute(*id, kind, value)
+	require.NoError(t, err)
+	return *attr
+}
+
+func createResource(t *testing.T, repo domain.RHABACRepo, name string) {
+	resp := repo.CreateResource(ctx, domain.CreateResourceReq{Resource: resource(t, name)})
+	require.NoError(t, resp.Error)
+}
+
+// inherit makes the child inherit from the parent
+func inherit(t *testing.T, repo domain.RHABACRepo, parent, child string) {
+	relate(t, repo, parent, child, domain.InheritsFrom)
+}
+
+func relate(t *testing.T, repo domain.RHABACRepo, from, to, relType string) {
+	resp := repo.CreateRelation(ctx, createRelationReq(t, from, to, relType))
+	require.NoError(t, resp.Error)
+}
+
+func createRelationReq(t *testing.T, from, to, relType string) domain.CreateRelationReq {
+	return domain.CreateRelationReq{
+		From:     resource(t, from),
+		To:       resource(t, to),
+		Type:     relType,
+		MaxDepth: maxDepth,
+	}
+}
+
+// chain creates resources prefix/0 to prefix/length, each one inheriting from the previous one,
+// and returns their names
+func chain(t *testing.T, repo domain.RHABACRepo, prefix string, length int) []string {
+	names := []string{fmt.Sprintf("%s/0", prefix)}
+	createResource(t, repo, names[0])
+	for i := 1; i <= length; i++ {
+		names = append(names, fmt.Sprintf("%s/%d", prefix, i))
+		inherit(t, repo, names[i-1], names[i])
+	}
+	return names
+}
+
+func allow(t *testing.T, repo domain.RHABACRepo, sub, obj, permName string) {
+	createPolicy(t, repo, sub, obj, permission(t, permName, domain.PermissionKindAllow), domain.Validity{})
+}
+
+func deny(t *testing.T, repo domain.RHABACRepo, sub, obj, permName string) {
+	createPolicy(t, repo, sub, obj, permission(t, permName, domain.PermissionKindDeny), domain.Validity{})
+}
+
+func createPolicy(t *testing.T, repo domain.RHABACRepo, sub, obj string, p domain.Permission, validity domain.Validity) {
+	resp := repo.CreatePolicy(ctx, domain.CreatePolicyReq{
+		SubjectScope: resource(t, sub),
+		ObjectScope:  resource(t, obj),
+		Permission:   p,
+		Validity:     validity,
+	})
+	require.NoError(t, resp.Error)
+}
+
+func deletePolicy(t *testing.T, repo domain.RHABACRepo, sub, obj, permName string, kind domain.PermissionKind) {
+	resp := repo.DeletePolicy(ctx, domain.DeletePolicyReq{
+		SubjectScope: resource(t, sub),
+		ObjectScope:  resource(t, obj),
+		Permission:   permission(t, permName, kind),
+	})
+	require.NoError(t, resp.Error)
+}
+
+func deleteResource(t *testing.T, repo domain.RHABACRepo, name string) {
+	resp := repo.DeleteResource(ctx, domain.DeleteResourceReq{Resource: resource(t, name)})
+	require.NoError(t, resp.Error)
+}
+
+// level identifies the subject and object priorities a permission was found at
+type level struct {
+	sub,
+	obj domain.PermissionPriority
+}
+
+func policyString(p domain.Permission) string {
+	if p.Kind() == domain.PermissionKindDeny {
+		return "deny " + p.Name()
+	}
+	return "allow " + p.Name()
+}
+
+// levels returns the hierarchy as sorted policy strings per level.
+// A repo may list a permission reached through several paths more than once,
+// which doesn't change any decision, so every level only holds distinct policies.
+func levels(t *testing.T, repo domain.RHABACRepo, sub, obj, permName string) map[level][]string {
+	resp := repo.GetPermissionHierarchy(ctx, domain.GetPermissionHierarchyReq{
+		Subject:        resource(t, sub),
+		Object:         resource(t, obj),
+		PermissionName: permName,
+	})
+	require.NoError(t, resp.Error)
+	return hierarchyLevels(resp.Hierarchy)
+}
+
+// hierarchyLevels returns the hierarchy as sorted policy strings per level, every level only holds distinct policies
+func hierarchyLevels(hierarchy domain.PermissionHierarchy) map[level][]string {
+	levels := make(map[level][]string)
+	for subPriority, objHierarchy := range hierarchy {
+		for objPriority, permissions := range objHierarchy {
+			policies := make([]string, 0, len(permissions))
+			for _, p := range permissions {
+				policies = append(policies, policyString(p))
+			}
+			if len(policies) > 0 {
+				levels[level{sub: subPriority, obj: objPriority}] = distinctSorted(policies)
+			}
+		}
+	}
+	return levels
+}
+
+// authorized evaluates the hierarchy with the combining algorithm stored for the permission name
+func authorized(t *testing.T, repo domain.RHABACRepo, sub, obj, permName string) bool {
+	hierarchy := repo.GetPermissionHierarchy(ctx, domain.GetPermissionHierarchyReq{
+		Subject:        resource(t, sub),
+		Object:         resource(t, obj),
+		PermissionName: permName,
+	})
+	require.NoError(t, hierarchy.Error)
+	algorithm := repo.GetCombiningAlgorithm(ctx, domain.GetCombiningAlgorithmReq{PermissionName: permName})
+	require.NoError(t, algorithm.Error)
+	decision := hierarchy.Hierarchy.Eval(domain.PermissionEvalRequest{Algorithm: algorithm.Algorithm})
+	return decision.Result == domain.EvalResultAllowed
+}
+
+// applicable returns the sorted, distinct applicable policies of the subject as permission@object strings
+func applicable(t *testing.T, repo domain.RHABACRepo, sub string) []string {
+	resp := repo.GetApplicablePolicies(ctx, domain.GetApplicablePoliciesReq{Subject: resource(t, sub)})
+	require.NoError(t, resp.Error)
+	policies := make([]string, 0, len(resp.Policies))
+	for _, p := range resp.Policies {
+		policies = append(policies, p.PermissionName+"@"+p.Object.Name())
+	}
+	return distinctSorted(policies)
+}
+
+// attributes returns the attributes of the resource by name
+func attributes(t *testing.T, repo domain.RHABACRepo, name string) map[string]domain.Attribute {
+	resp := repo.GetResource(ctx, domain.GetResourceReq{Resource: resource(t, name)})
+	require.NoError(t, resp.Error)
+	require.NotNil(t, resp.Resource)
+	attrs := make(map[string]domain.Attribute, len(resp.Resource.Attributes))
+	for _, attr := range resp.Resource.Attributes {
+		attrs[attr.Name()] = attr
+	}
+	return attrs
+}
+
+// ancestors returns the distance of every ancestor of the resource
+func ancestors(t *testing.T, repo domain.RHABACRepo, name string) map[string]int {
+	resp := repo.GetAncestorAttributes(ctx, domain.GetAncestorAttributesReq{Resource: resource(t, name)})
+	require.NoError(t, resp.Error)
+	return ancestorDistances(resp.Ancestors)
+}
+
+func ancestorDistances(ancestors []domain.AncestorAttributes) map[string]int {
+	distances := make(map[string]int, len(ancestors))
+	for _, ancestor := range ancestors {
+		distances[ancestor.Ancestor.Name()] = ancestor.Distance
+	}
+	return distances
+}
+
+// requireValue compares values by instant for timestamps, which backends may return in another location
+func requireValue(t *testing.T, expected interface{}, actual interface{}) {
+	if ts, ok := expected.(time.Time); ok {
+		actualTs, ok := actual.(time.Time)
+		require.True(t, ok, "expected a timestamp, got %T", actual)
+		require.True(t, ts.Equal(actualTs), "expected %v, got %v", ts, actualTs)
+		return
+	}
+	require.Equal(t, expected, actual)
+}
+
+func distinctSorted(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	distinct := make([]string, 0, len(values))
+	for _, value := range values {
+		if !seen[value] {
+			seen[value] = true
+			distinct = append(distinct, value)
+		}
+	}
+	sort.Strings(distinct)
+	return distinct
+}
+
+func rootName() string {
+	return domain.RootResource.Name()
+}
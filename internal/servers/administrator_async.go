@@ -59,6 +59,7 @@ func (s *AdministratorAsyncServer) serve(
 	}
 
 	var domainResp domain.AdministrationResp
+	var batchResults []*api.BatchOpResult
 
 	switch adminReq.Kind {
 
@@ -135,7 +136,7 @@ func (s *AdministratorAsyncServer) serve(
 			return
 		}
 
-		domainResp = s.service.CreateInheritanceRel(ctx, *reqDomain)
+		domainResp = s.service.CreateRelation(ctx, *reqDomain)
 
 	case api.AdministrationAsyncReq_DeleteInheritanceRel:
 		req := &api.DeleteInheritanceRelReq{}
@@ -150,7 +151,7 @@ func (s *AdministratorAsyncServer) serve(
 			return
 		}
 
-		domainResp = s.service.DeleteInheritanceRel(ctx, *reqDomain)
+		domainResp = s.service.DeleteRelation(ctx, *reqDomain)
 
 	case api.AdministrationAsyncReq_CreatePolicy:
 		req := &api.CreatePolicyReq{}
@@ -182,6 +183,158 @@ func (s *AdministratorAsyncServer) serve(
 
 		domainResp = s.service.DeletePolicy(ctx, *reqDomain)
 
+	case api.AdministrationAsyncReq_PutAttributeSchema:
+		req := &api.PutAttributeSchemaReq{}
+		if err := req.Unmarshal(adminReq.ReqMarshalled); err != nil {
+			span.RecordError(err)
+			return
+		}
+
+		reqDomain, err := proto.PutAttributeSchemaReqToDomain(req)
+		if err != nil {
+			span.RecordError(err)
+			return
+		}
+
+		domainResp = s.service.PutAttributeSchema(ctx, *reqDomain)
+
+	case api.AdministrationAsyncReq_DeleteAttributeSchema:
+		req := &api.DeleteAttributeSchemaReq{}
+		if err := req.Unmarshal(adminReq.ReqMarshalled); err != nil {
+			span.RecordError(err)
+			return
+		}
+
+		reqDomain, err := proto.DeleteAttributeSchemaReqToDomain(req)
+		if err != nil {
+			span.RecordError(err)
+			return
+		}
+
+		domainResp = s.service.DeleteAttributeSchema(ctx, *reqDomain)
+
+	case api.AdministrationAsyncReq_SetCombiningAlgorithm:
+		req := &api.SetCombiningAlgorithmReq{}
+		if err := req.Unmarshal(adminReq.ReqMarshalled); err != nil {
+			span.RecordError(err)
+			return
+		}
+
+		reqDomain, err := proto.SetCombiningAlgorithmReqToDomain(req)
+		if err != nil {
+			span.RecordError(err)
+			return
+		}
+
+		domainResp = s.service.SetCombiningAlgorithm(ctx, *reqDomain)
+
+	case api.AdministrationAsyncReq_CreatePermissionImplication:
+		req := &api.CreatePermissionImplicationReq{}
+		if err := req.Unmarshal(adminReq.ReqMarshalled); err != nil {
+			span.RecordError(err)
+			return
+		}
+
+		reqDomain, err := proto.CreatePermissionImplicationReqToDomain(req)
+		if err != nil {
+			span.RecordError(err)
+			return
+		}
+
+		domainResp = s.service.CreatePermissionImplication(ctx, *reqDomain)
+
+	case api.AdministrationAsyncReq_DeletePermissionImplication:
+		req := &api.DeletePermissionImplicationReq{}
+		if err := req.Unmarshal(adminReq.ReqMarshalled); err != nil {
+			span.RecordError(err)
+			return
+		}
+
+		reqDomain, err := proto.DeletePermissionImplicationReqToDomain(req)
+		if err != nil {
+			span.RecordError(err)
+			return
+		}
+
+		domainResp = s.service.DeletePermissionImplication(ctx, *reqDomain)
+
+	case api.AdministrationAsyncReq_CreateSoDConstraint:
+		req := &api.CreateSoDConstraintReq{}
+		if err := req.Unmarshal(adminReq.ReqMarshalled); err != nil {
+			span.RecordError(err)
+			return
+		}
+
+		reqDomain, err := proto.CreateSoDConstraintReqToDomain(req)
+		if err != nil {
+			span.RecordError(err)
+			return
+		}
+
+		domainResp = s.service.CreateSoDConstraint(ctx, *reqDomain)
+
+	case api.AdministrationAsyncReq_DeleteSoDConstraint:
+		req := &api.DeleteSoDConstraintReq{}
+		if err := req.Unmarshal(adminReq.ReqMarshalled); err != nil {
+			span.RecordError(err)
+			return
+		}
+
+		reqDomain, err := proto.DeleteSoDConstraintReqToDomain(req)
+		if err != nil {
+			span.RecordError(err)
+			return
+		}
+
+		domainResp = s.service.DeleteSoDConstraint(ctx, *reqDomain)
+
+	case api.AdministrationAsyncReq_PutRelationType:
+		req := &api.PutRelationTypeReq{}
+		if err := req.Unmarshal(adminReq.ReqMarshalled); err != nil {
+			span.RecordError(err)
+			return
+		}
+
+		reqDomain, err := proto.PutRelationTypeReqToDomain(req)
+		if err != nil {
+			span.RecordError(err)
+			return
+		}
+
+		domainResp = s.service.PutRelationType(ctx, *reqDomain)
+
+	case api.AdministrationAsyncReq_DeleteRelationType:
+		req := &api.DeleteRelationTypeReq{}
+		if err := req.Unmarshal(adminReq.ReqMarshalled); err != nil {
+			span.RecordError(err)
+			return
+		}
+
+		reqDomain, err := proto.DeleteRelationTypeReqToDomain(req)
+		if err != nil {
+			span.RecordError(err)
+			return
+		}
+
+		domainResp = s.service.DeleteRelationType(ctx, *reqDomain)
+
+	case api.AdministrationAsyncReq_Batch:
+		req := &api.BatchReq{}
+		if err := req.Unmarshal(adminReq.ReqMarshalled); err != nil {
+			span.RecordError(err)
+			return
+		}
+
+		reqDomain, err := proto.BatchReqToDomain(req)
+		if err != nil {
+			span.RecordError(err)
+			return
+		}
+
+		batchResp := s.service.Batch(ctx, *reqDomain)
+		domainResp = domain.AdministrationResp{Error: batchResp.Error}
+		batchResults = batchOpResults(batchResp)
+
 	default:
 		span.SetStatus(codes.Error, "unknown administration request kind")
 		return
@@ -193,6 +346,8 @@ func (s *AdministratorAsyncServer) serve(
 		span.SetStatus(codes.Error, err.Error())
 		return
 	}
+	resp.Code = uint32(errorCode(domainResp.Error))
+	resp.BatchResults = batchResults
 
 	respMarshalled, err := resp.Marshal()
 	if err != nil {
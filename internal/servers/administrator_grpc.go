@@ -3,9 +3,12 @@ package servers
 import (
 	"context"
 
+	"github.com/c12s/oort/internal/domain"
 	"github.com/c12s/oort/internal/mappers/proto"
 	"github.com/c12s/oort/internal/services"
 	"github.com/c12s/oort/pkg/api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type oortAdministratorGrpcServer struct {
@@ -25,7 +28,7 @@ func (o *oortAdministratorGrpcServer) CreateResource(ctx context.Context, req *a
 		return nil, err
 	}
 	resp := o.service.CreateResource(ctx, *request)
-	return &api.AdministrationResp{}, resp.Error
+	return &api.AdministrationResp{}, statusError(resp.Error)
 }
 
 func (o *oortAdministratorGrpcServer) DeleteResource(ctx context.Context, req *api.DeleteResourceReq) (*api.AdministrationResp, error) {
@@ -34,7 +37,7 @@ func (o *oortAdministratorGrpcServer) DeleteResource(ctx context.Context, req *a
 		return nil, err
 	}
 	resp := o.service.DeleteResource(ctx, *request)
-	return &api.AdministrationResp{}, resp.Error
+	return &api.AdministrationResp{}, statusError(resp.Error)
 }
 
 func (o *oortAdministratorGrpcServer) CreateInheritanceRel(ctx context.Context, req *api.CreateInheritanceRelReq) (*api.AdministrationResp, error) {
@@ -42,8 +45,8 @@ func (o *oortAdministratorGrpcServer) CreateInheritanceRel(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	resp := o.service.CreateInheritanceRel(ctx, *request)
-	return &api.AdministrationResp{}, resp.Error
+	resp := o.service.CreateRelation(ctx, *request)
+	return &api.AdministrationResp{}, statusError(resp.Error)
 }
 
 func (o *oortAdministratorGrpcServer) DeleteInheritanceRel(ctx context.Context, req *api.DeleteInheritanceRelReq) (*api.AdministrationResp, error) {
@@ -51,8 +54,8 @@ func (o *oortAdministratorGrpcServer) DeleteInheritanceRel(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	resp := o.service.DeleteInheritanceRel(ctx, *request)
-	return &api.AdministrationResp{}, resp.Error
+	resp := o.service.DeleteRelation(ctx, *request)
+	return &api.AdministrationResp{}, statusError(resp.Error)
 }
 
 func (o *oortAdministratorGrpcServer) PutAttribute(ctx context.Context, req *api.PutAttributeReq) (*api.AdministrationResp, error) {
@@ -61,7 +64,7 @@ func (o *oortAdministratorGrpcServer) PutAttribute(ctx context.Context, req *api
 		return nil, err
 	}
 	resp := o.service.PutAttribute(ctx, *request)
-	return &api.AdministrationResp{}, resp.Error
+	return &api.AdministrationResp{}, statusError(resp.Error)
 }
 
 func (o *oortAdministratorGrpcServer) DeleteAttribute(ctx context.Context, req *api.DeleteAttributeReq) (*api.AdministrationResp, error) {
@@ -70,7 +73,7 @@ func (o *oortAdministratorGrpcServer) DeleteAttribute(ctx context.Context, req *
 		return nil, err
 	}
 	resp := o.service.DeleteAttribute(ctx, *request)
-	return &api.AdministrationResp{}, resp.Error
+	return &api.AdministrationResp{}, statusError(resp.Error)
 }
 
 func (o *oortAdministratorGrpcServer) CreatePolicy(ctx context.Context, req *api.CreatePolicyReq) (*api.AdministrationResp, error) {
@@ -79,7 +82,7 @@ func (o *oortAdministratorGrpcServer) CreatePolicy(ctx context.Context, req *api
 		return nil, err
 	}
 	resp := o.service.CreatePolicy(ctx, *request)
-	return &api.AdministrationResp{}, resp.Error
+	return &api.AdministrationResp{}, statusError(resp.Error)
 }
 
 func (o *oortAdministratorGrpcServer) DeletePolicy(ctx context.Context, req *api.DeletePolicyReq) (*api.AdministrationResp, error) {
@@ -88,5 +91,147 @@ func (o *oortAdministratorGrpcServer) DeletePolicy(ctx context.Context, req *api
 		return nil, err
 	}
 	resp := o.service.DeletePolicy(ctx, *request)
-	return &api.AdministrationResp{}, resp.Error
+	return &api.AdministrationResp{}, statusError(resp.Error)
+}
+
+func (o *oortAdministratorGrpcServer) PutAttributeSchema(ctx context.Context, req *api.PutAttributeSchemaReq) (*api.AdministrationResp, error) {
+	request, err := proto.PutAttributeSchemaReqToDomain(req)
+	if err != nil {
+		return nil, err
+	}
+	resp := o.service.PutAttributeSchema(ctx, *request)
+	return &api.AdministrationResp{}, statusError(resp.Error)
+}
+
+func (o *oortAdministratorGrpcServer) DeleteAttributeSchema(ctx context.Context, req *api.DeleteAttributeSchemaReq) (*api.AdministrationResp, error) {
+	request, err := proto.DeleteAttributeSchemaReqToDomain(req)
+	if err != nil {
+		return nil, err
+	}
+	resp := o.service.DeleteAttributeSchema(ctx, *request)
+	return &api.AdministrationResp{}, statusError(resp.Error)
+}
+
+func (o *oortAdministratorGrpcServer) SetCombiningAlgorithm(ctx context.Context, req *api.SetCombiningAlgorithmReq) (*api.AdministrationResp, error) {
+	request, err := proto.SetCombiningAlgorithmReqToDomain(req)
+	if err != nil {
+		return nil, err
+	}
+	resp := o.service.SetCombiningAlgorithm(ctx, *request)
+	return &api.AdministrationResp{}, statusError(resp.Error)
+}
+
+func (o *oortAdministratorGrpcServer) CreatePermissionImplication(ctx context.Context, req *api.CreatePermissionImplicationReq) (*api.AdministrationResp, error) {
+	request, err := proto.CreatePermissionImplicationReqToDomain(req)
+	if err != nil {
+		return nil, err
+	}
+	resp := o.service.CreatePermissionImplication(ctx, *request)
+	return &api.AdministrationResp{}, statusError(resp.Error)
+}
+
+func (o *oortAdministratorGrpcServer) DeletePermissionImplication(ctx context.Context, req *api.DeletePermissionImplicationReq) (*api.AdministrationResp, error) {
+	request, err := proto.DeletePermissionImplicationReqToDomain(req)
+	if err != nil {
+		return nil, err
+	}
+	resp := o.service.DeletePermissionImplication(ctx, *request)
+	return &api.AdministrationResp{}, statusError(resp.Error)
+}
+
+func (o *oortAdministratorGrpcServer) CreateSoDConstraint(ctx context.Context, req *api.CreateSoDConstraintReq) (*api.AdministrationResp, error) {
+	request, err := proto.CreateSoDConstraintReqToDomain(req)
+	if err != nil {
+		return nil, err
+	}
+	resp := o.service.CreateSoDConstraint(ctx, *request)
+	return &api.AdministrationResp{}, statusError(resp.Error)
+}
+
+func (o *oortAdministratorGrpcServer) DeleteSoDConstraint(ctx context.Context, req *api.DeleteSoDConstraintReq) (*api.AdministrationResp, error) {
+	request, err := proto.DeleteSoDConstraintReqToDomain(req)
+	if err != nil {
+		return nil, err
+	}
+	resp := o.service.DeleteSoDConstraint(ctx, *request)
+	return &api.AdministrationResp{}, statusError(resp.Error)
+}
+
+func (o *oortAdministratorGrpcServer) GetInheritanceCycles(ctx context.Context, req *api.GetInheritanceCyclesReq) (*api.GetInheritanceCyclesResp, error) {
+	request, err := proto.GetInheritanceCyclesReqToDomain(req)
+	if err != nil {
+		return nil, err
+	}
+	resp := o.service.GetInheritanceCycles(ctx, *request)
+	if resp.Error != nil {
+		return nil, resp.Error
+	}
+	return proto.GetInheritanceCyclesRespFromDomain(&resp)
+}
+
+func (o *oortAdministratorGrpcServer) PutRelationType(ctx context.Context, req *api.PutRelationTypeReq) (*api.AdministrationResp, error) {
+	request, err := proto.PutRelationTypeReqToDomain(req)
+	if err != nil {
+		return nil, err
+	}
+	resp := o.service.PutRelationType(ctx, *request)
+	return &api.AdministrationResp{}, statusError(resp.Error)
+}
+
+func (o *oortAdministratorGrpcServer) DeleteRelationType(ctx context.Context, req *api.DeleteRelationTypeReq) (*api.AdministrationResp, error) {
+	request, err := proto.DeleteRelationTypeReqToDomain(req)
+	if err != nil {
+		return nil, err
+	}
+	resp := o.service.DeleteRelationType(ctx, *request)
+	return &api.AdministrationResp{}, statusError(resp.Error)
+}
+
+func (o *oortAdministratorGrpcServer) GetRelationTypes(ctx context.Context, req *api.GetRelationTypesReq) (*api.GetRelationTypesResp, error) {
+	request, err := proto.GetRelationTypesReqToDomain(req)
+	if err != nil {
+		return nil, err
+	}
+	resp := o.service.GetRelationTypes(ctx, *request)
+	if resp.Error != nil {
+		return nil, resp.Error
+	}
+	return proto.GetRelationTypesRespFromDomain(&resp)
+}
+
+// Batch reports the failure of an operation in the results, so the response is only an error if the request is malformed
+func (o *oortAdministratorGrpcServer) Batch(ctx context.Context, req *api.BatchReq) (*api.BatchResp, error) {
+	request, err := proto.BatchReqToDomain(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	resp := o.service.Batch(ctx, *request)
+	return &api.BatchResp{
+		Results: batchOpResults(resp),
+		Applied: resp.Error == nil,
+	}, nil
+}
+
+// batchOpResults maps the results of a batch together with the codes of their errors
+func batchOpResults(resp domain.BatchResp) []*api.BatchOpResult {
+	results := proto.BatchOpResultsFromDomain(resp.Results)
+	for i, result := range resp.Results {
+		results[i].Code = uint32(errorCode(result.Error))
+	}
+	return results
+}
+
+func (o *oortAdministratorGrpcServer) GetAttributeSchema(ctx context.Context, req *api.GetAttributeSchemaReq) (*api.GetAttributeSchemaResp, error) {
+	request, err := proto.GetAttributeSchemaReqToDomain(req)
+	if err != nil {
+		return nil, err
+	}
+	resp := o.service.GetAttributeSchema(ctx, *request)
+	if resp.Error != nil {
+		return nil, resp.Error
+	}
+	if resp.Schema == nil {
+		return nil, status.Errorf(codes.NotFound, "attribute schema for resource kind %q not found", req.ResourceKind)
+	}
+	return proto.GetAttributeSchemaRespFromDomain(&resp)
 }
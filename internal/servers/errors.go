@@ -0,0 +1,43 @@
+package servers
+
+import (
+	"errors"
+
+	"github.com/c12s/oort/internal/domain"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errorCode maps the domain errors callers are expected to handle to dedicated gRPC codes
+func errorCode(err error) codes.Code {
+	switch {
+	case err == nil:
+		return codes.OK
+	case errors.Is(err, domain.ErrInheritanceCycle),
+		errors.Is(err, domain.ErrImplicationCycle),
+		errors.Is(err, domain.ErrSoDViolation),
+		errors.Is(err, domain.ErrUnknownRelationType),
+		errors.Is(err, domain.ErrRelationTypeInUse):
+		return codes.FailedPrecondition
+	case errors.Is(err, domain.ErrInvalidRelationTypeName),
+		errors.Is(err, domain.ErrReservedRelationType),
+		errors.Is(err, domain.ErrUnknownBatchOp):
+		return codes.InvalidArgument
+	case errors.Is(err, domain.ErrInheritanceDepth):
+		return codes.OutOfRange
+	case errors.Is(err, domain.ErrBreakGlassNotPermitted):
+		return codes.PermissionDenied
+	case errors.Is(err, domain.ErrBatchAborted):
+		return codes.Aborted
+	default:
+		return codes.Unknown
+	}
+}
+
+func statusError(err error) error {
+	code := errorCode(err)
+	if code == codes.OK || code == codes.Unknown {
+		return err
+	}
+	return status.Error(code, err.Error())
+}
@@ -2,20 +2,28 @@ package servers
 
 import (
 	"context"
+	"log"
 
 	"github.com/c12s/oort/internal/mappers/proto"
 	"github.com/c12s/oort/internal/services"
 	"github.com/c12s/oort/pkg/api"
+	"github.com/c12s/oort/pkg/messaging"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type oortEvaluatorGrpcServer struct {
-	service services.EvaluationService
+	service   services.EvaluationService
+	publisher messaging.Publisher
 	api.UnimplementedOortEvaluatorServer
 }
 
-func NewOortEvaluatorGrpcServer(service services.EvaluationService) (api.OortEvaluatorServer, error) {
+func NewOortEvaluatorGrpcServer(service services.EvaluationService, publisher messaging.Publisher) (api.OortEvaluatorServer, error) {
 	return &oortEvaluatorGrpcServer{
-		service: service,
+		service:   service,
+		publisher: publisher,
 	}, nil
 }
 
@@ -25,7 +33,10 @@ func (o *oortEvaluatorGrpcServer) Authorize(ctx context.Context, req *api.Author
 		return nil, err
 	}
 	resp := o.service.Authorize(ctx, *reqDomain)
-	return &api.AuthorizationResp{Authorized: resp.Authorized}, resp.Error
+	if resp.Error != nil {
+		return &api.AuthorizationResp{Authorized: resp.Authorized}, resp.Error
+	}
+	return proto.AuthorizationRespFromDomain(&resp)
 }
 
 func (o *oortEvaluatorGrpcServer) GetGrantedPermissions(ctx context.Context, req *api.GetGrantedPermissionsReq) (*api.GetGrantedPermissionsResp, error) {
@@ -39,3 +50,47 @@ func (o *oortEvaluatorGrpcServer) GetGrantedPermissions(ctx context.Context, req
 	}
 	return proto.GetGrantedPermissionsRespFromDomain(&resp)
 }
+
+func (o *oortEvaluatorGrpcServer) BreakGlass(ctx context.Context, req *api.BreakGlassReq) (*api.BreakGlassResp, error) {
+	reqDomain, err := proto.BreakGlassReqToDomain(req)
+	if err != nil {
+		return nil, err
+	}
+	resp := o.service.BreakGlass(ctx, *reqDomain)
+	if resp.Error != nil {
+		return nil, statusError(resp.Error)
+	}
+	breakGlass, err := proto.BreakGlassFromDomain(*resp.BreakGlass)
+	if err != nil {
+		return nil, err
+	}
+	o.alert(ctx, breakGlass)
+	return &api.BreakGlassResp{BreakGlass: breakGlass}, nil
+}
+
+// alert publishes the break glass to everyone watching for overrides,
+// the audit entry is already recorded, so a failed alert doesn't revoke it
+func (o *oortEvaluatorGrpcServer) alert(ctx context.Context, breakGlass *api.BreakGlass) {
+	tracer := otel.Tracer("oort-evaluator-grpc-server")
+
+	ctx, span := tracer.Start(
+		ctx,
+		"Publish Break Glass Alert",
+		trace.WithAttributes(
+			attribute.String("messaging.system", "nats"),
+			attribute.String("messaging.destination", api.BreakGlassSubject),
+			attribute.String("break_glass.id", breakGlass.Id),
+		),
+	)
+	defer span.End()
+
+	alertMarshalled, err := breakGlass.Marshal()
+	if err == nil {
+		err = o.publisher.Publish(ctx, alertMarshalled, api.BreakGlassSubject)
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		log.Printf("break glass %s alert not published: %v", breakGlass.Id, err)
+	}
+}
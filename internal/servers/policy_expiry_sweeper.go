@@ -0,0 +1,108 @@
+package servers
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/c12s/oort/internal/domain"
+	"github.com/c12s/oort/internal/mappers/proto"
+	"github.com/c12s/oort/internal/services"
+	"github.com/c12s/oort/pkg/api"
+	"github.com/c12s/oort/pkg/messaging"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// PolicyExpirySweeper periodically removes expired policies
+// and publishes a notification listing the removed ones
+type PolicyExpirySweeper struct {
+	service   services.AdministrationService
+	publisher messaging.Publisher
+	interval  time.Duration
+	stop      chan struct{}
+	stopped   chan struct{}
+}
+
+func NewPolicyExpirySweeper(publisher messaging.Publisher, service services.AdministrationService, interval time.Duration) (*PolicyExpirySweeper, error) {
+	if interval <= 0 {
+		return nil, errors.New("policy sweep interval must be positive")
+	}
+	return &PolicyExpirySweeper{
+		service:   service,
+		publisher: publisher,
+		interval:  interval,
+		stop:      make(chan struct{}),
+		stopped:   make(chan struct{}),
+	}, nil
+}
+
+func (s *PolicyExpirySweeper) Serve() error {
+	go func() {
+		defer close(s.stopped)
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-s.stop:
+				return
+			case now := <-ticker.C:
+				s.sweep(context.Background(), now)
+			}
+		}
+	}()
+	return nil
+}
+
+func (s *PolicyExpirySweeper) sweep(ctx context.Context, now time.Time) {
+	tracer := otel.Tracer("oort-policy-expiry-sweeper")
+
+	ctx, span := tracer.Start(
+		ctx,
+		"Sweep Expired Policies",
+		trace.WithAttributes(
+			attribute.String("messaging.system", "nats"),
+			attribute.String("messaging.destination", api.PoliciesExpiredSubject),
+		),
+	)
+	defer span.End()
+
+	resp := s.service.DeleteExpiredPolicies(ctx, domain.DeleteExpiredPoliciesReq{Now: now})
+	if resp.Error != nil {
+		span.RecordError(resp.Error)
+		span.SetStatus(codes.Error, resp.Error.Error())
+		return
+	}
+	span.SetAttributes(attribute.Int("policies.expired", len(resp.Policies)))
+	if len(resp.Policies) == 0 {
+		return
+	}
+
+	notification, err := proto.PoliciesExpiredNotificationFromDomain(resp.Policies)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return
+	}
+
+	notificationMarshalled, err := notification.Marshal()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return
+	}
+
+	if err := s.publisher.Publish(ctx, notificationMarshalled, api.PoliciesExpiredSubject); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		log.Println(err)
+	}
+}
+
+func (s *PolicyExpirySweeper) GracefulStop() {
+	close(s.stop)
+	<-s.stopped
+}
@@ -7,55 +7,337 @@ import (
 )
 
 type AdministrationService struct {
-	repo domain.RHABACRepo
+	repo                domain.RHABACRepo
+	maxInheritanceDepth int
 }
 
-func NewAdministrationService(repo domain.RHABACRepo) (*AdministrationService, error) {
+func NewAdministrationService(repo domain.RHABACRepo, maxInheritanceDepth int) (*AdministrationService, error) {
+	if maxInheritanceDepth <= 0 {
+		maxInheritanceDepth = domain.DefaultMaxInheritanceDepth
+	}
 	return &AdministrationService{
-		repo: repo,
+		repo:                repo,
+		maxInheritanceDepth: maxInheritanceDepth,
 	}, nil
 }
 
 func (h AdministrationService) CreateResource(ctx context.Context, req domain.CreateResourceReq) domain.AdministrationResp {
+	if err := h.validateCreateResource(ctx, req); err != nil {
+		return domain.AdministrationResp{Error: err}
+	}
 	return h.repo.CreateResource(ctx, req)
 }
 
+// validateCreateResource rejects resources that lack attributes their schema requires,
+// the attributes an existing resource already has count as supplied
+func (h AdministrationService) validateCreateResource(ctx context.Context, req domain.CreateResourceReq) error {
+	for _, attr := range req.Resource.Attributes {
+		if err := attr.Validate(); err != nil {
+			return err
+		}
+	}
+	schemaResp := h.repo.GetAttributeSchema(ctx, domain.GetAttributeSchemaReq{ResourceKind: req.Resource.Kind()})
+	if schemaResp.Error != nil {
+		return schemaResp.Error
+	}
+	if schemaResp.Schema == nil {
+		return nil
+	}
+	var stored []domain.Attribute
+	// a resource that doesn't exist yet has no stored attributes
+	if resourceResp := h.repo.GetResource(ctx, domain.GetResourceReq{Resource: req.Resource}); resourceResp.Error == nil && resourceResp.Resource != nil {
+		stored = resourceResp.Resource.Attributes
+	}
+	return schemaResp.Schema.ValidateCreate(req.Resource.Attributes, stored)
+}
+
 func (h AdministrationService) DeleteResource(ctx context.Context, req domain.DeleteResourceReq) domain.AdministrationResp {
 	return h.repo.DeleteResource(ctx, req)
 }
 
 func (h AdministrationService) PutAttribute(ctx context.Context, req domain.PutAttributeReq) domain.AdministrationResp {
+	if err := h.validatePutAttribute(ctx, req); err != nil {
+		return domain.AdministrationResp{Error: err}
+	}
 	return h.repo.PutAttribute(ctx, req)
 }
 
+func (h AdministrationService) validatePutAttribute(ctx context.Context, req domain.PutAttributeReq) error {
+	if err := req.Attribute.Validate(); err != nil {
+		return err
+	}
+	schemaResp := h.repo.GetAttributeSchema(ctx, domain.GetAttributeSchemaReq{ResourceKind: req.Resource.Kind()})
+	if schemaResp.Error != nil {
+		return schemaResp.Error
+	}
+	// resource kinds without a schema accept any attribute
+	if schemaResp.Schema != nil {
+		return schemaResp.Schema.ValidatePut(req.Attribute)
+	}
+	return nil
+}
+
 func (h AdministrationService) DeleteAttribute(ctx context.Context, req domain.DeleteAttributeReq) domain.AdministrationResp {
+	if err := h.validateDeleteAttribute(ctx, req); err != nil {
+		return domain.AdministrationResp{Error: err}
+	}
 	return h.repo.DeleteAttribute(ctx, req)
 }
 
-func (h AdministrationService) CreateInheritanceRel(ctx context.Context, req domain.CreateInheritanceRelReq) domain.AdministrationResp {
-	return h.repo.CreateInheritanceRel(ctx, req)
+func (h AdministrationService) validateDeleteAttribute(ctx context.Context, req domain.DeleteAttributeReq) error {
+	schemaResp := h.repo.GetAttributeSchema(ctx, domain.GetAttributeSchemaReq{ResourceKind: req.Resource.Kind()})
+	if schemaResp.Error != nil {
+		return schemaResp.Error
+	}
+	if schemaResp.Schema != nil {
+		return schemaResp.Schema.ValidateDelete(req.AttributeId)
+	}
+	return nil
+}
+
+func (h AdministrationService) CreateRelation(ctx context.Context, req domain.CreateRelationReq) domain.AdministrationResp {
+	req, err := h.prepareCreateRelation(ctx, req)
+	if err != nil {
+		return domain.AdministrationResp{Error: err}
+	}
+	return h.repo.CreateRelation(ctx, req)
+}
+
+func (h AdministrationService) prepareCreateRelation(ctx context.Context, req domain.CreateRelationReq) (domain.CreateRelationReq, error) {
+	if req.Type == "" {
+		req.Type = domain.InheritsFrom
+	}
+	if err := domain.ValidateRelationTypeName(req.Type); err != nil {
+		return req, err
+	}
+	constraints, err := h.staticSoDConstraints(ctx)
+	if err != nil {
+		return req, err
+	}
+	req.SoDConstraints = constraints
+	req.MaxDepth = h.maxInheritanceDepth
+	return req, nil
+}
+
+func (h AdministrationService) DeleteRelation(ctx context.Context, req domain.DeleteRelationReq) domain.AdministrationResp {
+	return h.repo.DeleteRelation(ctx, prepareDeleteRelation(req))
+}
+
+func prepareDeleteRelation(req domain.DeleteRelationReq) domain.DeleteRelationReq {
+	if req.Type == "" {
+		req.Type = domain.InheritsFrom
+	}
+	return req
+}
+
+func (h AdministrationService) PutRelationType(ctx context.Context, req domain.PutRelationTypeReq) domain.AdministrationResp {
+	return h.repo.PutRelationType(ctx, req)
+}
+
+func (h AdministrationService) DeleteRelationType(ctx context.Context, req domain.DeleteRelationTypeReq) domain.AdministrationResp {
+	if err := validateDeleteRelationType(req); err != nil {
+		return domain.AdministrationResp{Error: err}
+	}
+	return h.repo.DeleteRelationType(ctx, req)
+}
+
+func validateDeleteRelationType(req domain.DeleteRelationTypeReq) error {
+	if req.Name == domain.InheritsFrom {
+		return domain.ErrReservedRelationType
+	}
+	return nil
 }
 
-func (h AdministrationService) DeleteInheritanceRel(ctx context.Context, req domain.DeleteInheritanceRelReq) domain.AdministrationResp {
-	return h.repo.DeleteInheritanceRel(ctx, req)
+func (h AdministrationService) GetRelationTypes(ctx context.Context, req domain.GetRelationTypesReq) domain.GetRelationTypesResp {
+	return h.repo.GetRelationTypes(ctx, req)
 }
 
 func (h AdministrationService) CreatePolicy(ctx context.Context, req domain.CreatePolicyReq) domain.AdministrationResp {
+	req, err := h.prepareCreatePolicy(ctx, req)
+	if err != nil {
+		return domain.AdministrationResp{Error: err}
+	}
+	return h.repo.CreatePolicy(ctx, req)
+}
+
+func (h AdministrationService) prepareCreatePolicy(ctx context.Context, req domain.CreatePolicyReq) (domain.CreatePolicyReq, error) {
 	if req.SubjectScope.Name() == "" {
 		req.SubjectScope = domain.RootResource
 	}
 	if req.ObjectScope.Name() == "" {
 		req.ObjectScope = domain.RootResource
 	}
-	return h.repo.CreatePolicy(ctx, req)
+	if err := req.Validity.Validate(); err != nil {
+		return req, err
+	}
+	if condition := req.Permission.Condition(); !condition.IsEmpty() {
+		subjectScope, err := h.conditionScope(ctx, req.SubjectScope)
+		if err != nil {
+			return req, err
+		}
+		objectScope, err := h.conditionScope(ctx, req.ObjectScope)
+		if err != nil {
+			return req, err
+		}
+		env := domain.ConditionTypeEnv{Subject: subjectScope, Object: objectScope}
+		if err := condition.TypeCheck(env); err != nil {
+			return req, err
+		}
+	}
+	// only allow policies grant permissions, so denies can't violate separation of duty
+	if req.Permission.Kind() == domain.PermissionKindAllow {
+		constraints, err := h.staticSoDConstraints(ctx)
+		if err != nil {
+			return req, err
+		}
+		req.SoDConstraints = constraints
+	}
+	return req, nil
+}
+
+// staticSoDConstraints returns all static constraints, since wildcards and implications
+// let a policy grant permissions other than the one it is named after
+func (h AdministrationService) staticSoDConstraints(ctx context.Context) ([]domain.SoDConstraint, error) {
+	resp := h.repo.GetSoDConstraints(ctx, domain.GetSoDConstraintsReq{Kind: domain.SoDStatic})
+	return resp.Constraints, resp.Error
+}
+
+// conditionScope collects the attribute kinds known for a policy scope,
+// the scope is strict only if its resource kind has an attribute schema
+func (h AdministrationService) conditionScope(ctx context.Context, resource domain.Resource) (domain.ConditionScope, error) {
+	scope := domain.ConditionScope{Kinds: make(map[string]domain.AttributeKind)}
+	if resource.Name() == domain.RootResource.Name() {
+		return scope, nil
+	}
+	schemaResp := h.repo.GetAttributeSchema(ctx, domain.GetAttributeSchemaReq{ResourceKind: resource.Kind()})
+	if schemaResp.Error != nil {
+		return scope, schemaResp.Error
+	}
+	if schemaResp.Schema != nil {
+		scope.Strict = true
+		for _, definition := range schemaResp.Schema.Definitions() {
+			scope.Kinds[definition.Name()] = definition.Kind()
+		}
+	}
+	// scopes that don't exist yet contribute no attributes
+	resourceResp := h.repo.GetResource(ctx, domain.GetResourceReq{Resource: resource})
+	if resourceResp.Error == nil && resourceResp.Resource != nil {
+		attrs := resourceResp.Resource.Attributes
+		ancestorsResp := h.repo.GetAncestorAttributes(ctx, domain.GetAncestorAttributesReq{Resource: resource})
+		if ancestorsResp.Error == nil {
+			attrs = domain.ResolveAttributes(attrs, ancestorsResp.Ancestors)
+		}
+		for _, attr := range attrs {
+			scope.Kinds[attr.Name()] = attr.Kind()
+		}
+	}
+	return scope, nil
 }
 
 func (h AdministrationService) DeletePolicy(ctx context.Context, req domain.DeletePolicyReq) domain.AdministrationResp {
+	return h.repo.DeletePolicy(ctx, prepareDeletePolicy(req))
+}
+
+func prepareDeletePolicy(req domain.DeletePolicyReq) domain.DeletePolicyReq {
 	if req.SubjectScope.Name() == "" {
 		req.SubjectScope = domain.RootResource
 	}
 	if req.ObjectScope.Name() == "" {
 		req.ObjectScope = domain.RootResource
 	}
-	return h.repo.DeletePolicy(ctx, req)
+	return req
+}
+
+func (h AdministrationService) PutAttributeSchema(ctx context.Context, req domain.PutAttributeSchemaReq) domain.AdministrationResp {
+	return h.repo.PutAttributeSchema(ctx, req)
+}
+
+func (h AdministrationService) DeleteAttributeSchema(ctx context.Context, req domain.DeleteAttributeSchemaReq) domain.AdministrationResp {
+	return h.repo.DeleteAttributeSchema(ctx, req)
+}
+
+func (h AdministrationService) SetCombiningAlgorithm(ctx context.Context, req domain.SetCombiningAlgorithmReq) domain.AdministrationResp {
+	if err := req.Algorithm.Validate(); err != nil {
+		return domain.AdministrationResp{Error: err}
+	}
+	return h.repo.SetCombiningAlgorithm(ctx, req)
+}
+
+func (h AdministrationService) CreatePermissionImplication(ctx context.Context, req domain.CreatePermissionImplicationReq) domain.AdministrationResp {
+	return h.repo.CreatePermissionImplication(ctx, req)
+}
+
+func (h AdministrationService) DeletePermissionImplication(ctx context.Context, req domain.DeletePermissionImplicationReq) domain.AdministrationResp {
+	return h.repo.DeletePermissionImplication(ctx, req)
+}
+
+func (h AdministrationService) GetAttributeSchema(ctx context.Context, req domain.GetAttributeSchemaReq) domain.GetAttributeSchemaResp {
+	return h.repo.GetAttributeSchema(ctx, req)
+}
+
+func (h AdministrationService) DeleteExpiredPolicies(ctx context.Context, req domain.DeleteExpiredPoliciesReq) domain.DeleteExpiredPoliciesResp {
+	return h.repo.DeleteExpiredPolicies(ctx, req)
+}
+
+func (h AdministrationService) CreateSoDConstraint(ctx context.Context, req domain.CreateSoDConstraintReq) domain.AdministrationResp {
+	return h.repo.CreateSoDConstraint(ctx, req)
+}
+
+func (h AdministrationService) DeleteSoDConstraint(ctx context.Context, req domain.DeleteSoDConstraintReq) domain.AdministrationResp {
+	if err := validateDeleteSoDConstraint(req); err != nil {
+		return domain.AdministrationResp{Error: err}
+	}
+	return h.repo.DeleteSoDConstraint(ctx, req)
+}
+
+func validateDeleteSoDConstraint(req domain.DeleteSoDConstraintReq) error {
+	if req.Name == "" {
+		return domain.ErrSoDConstraintNameEmpty
+	}
+	return nil
+}
+
+func (h AdministrationService) GetInheritanceCycles(ctx context.Context, req domain.GetInheritanceCyclesReq) domain.GetInheritanceCyclesResp {
+	return h.repo.GetInheritanceCycles(ctx, req)
+}
+
+// Batch applies the ops all or nothing. Each op is validated and completed like its own request would be,
+// against the graph as it is before the batch, so an op can't rely on the schemas or constraints an earlier op of the batch changes.
+func (h AdministrationService) Batch(ctx context.Context, req domain.BatchReq) domain.BatchResp {
+	ops := make([]domain.BatchOp, len(req.Ops))
+	for i, op := range req.Ops {
+		prepared, err := h.prepareBatchOp(ctx, op)
+		if err != nil {
+			return domain.NewBatchResp(len(req.Ops), i, err)
+		}
+		ops[i] = prepared
+	}
+	return h.repo.Batch(ctx, domain.BatchReq{Ops: ops})
+}
+
+func (h AdministrationService) prepareBatchOp(ctx context.Context, op domain.BatchOp) (domain.BatchOp, error) {
+	switch req := op.(type) {
+	case domain.CreateResourceReq:
+		return req, h.validateCreateResource(ctx, req)
+	case domain.PutAttributeReq:
+		return req, h.validatePutAttribute(ctx, req)
+	case domain.DeleteAttributeReq:
+		return req, h.validateDeleteAttribute(ctx, req)
+	case domain.CreateRelationReq:
+		return h.prepareCreateRelation(ctx, req)
+	case domain.DeleteRelationReq:
+		return prepareDeleteRelation(req), nil
+	case domain.DeleteRelationTypeReq:
+		return req, validateDeleteRelationType(req)
+	case domain.CreatePolicyReq:
+		return h.prepareCreatePolicy(ctx, req)
+	case domain.DeletePolicyReq:
+		return prepareDeletePolicy(req), nil
+	case domain.SetCombiningAlgorithmReq:
+		return req, req.Algorithm.Validate()
+	case domain.DeleteSoDConstraintReq:
+		return req, validateDeleteSoDConstraint(req)
+	default:
+		return op, nil
+	}
 }
@@ -0,0 +1,122 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/c12s/oort/internal/domain"
+	"github.com/c12s/oort/internal/repos/rhabac/memory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var ctx = context.Background()
+
+// newTestServices returns services that share an empty in-memory repo
+func newTestServices(t *testing.T) (*AdministrationService, *EvaluationService) {
+	repo := memory.NewRHABACRepo()
+	administration, err := NewAdministrationService(repo, 0)
+	require.NoError(t, err)
+	evaluation, err := NewEvaluationService(repo)
+	require.NoError(t, err)
+	return administration, evaluation
+}
+
+func resource(t *testing.T, name string) domain.Resource {
+	r, err := domain.NewResourceFromName(name)
+	require.NoError(t, err)
+	return *r
+}
+
+func policyReq(t *testing.T, sub, obj, permName string, kind domain.PermissionKind, expression string) domain.CreatePolicyReq {
+	condition, err := domain.NewCondition(expression)
+	require.NoError(t, err)
+	permission, err := domain.NewPermission(permName, kind, *condition, domain.ConditionErrorSkip, nil)
+	require.NoError(t, err)
+	return domain.CreatePolicyReq{
+		SubjectScope: resource(t, sub),
+		ObjectScope:  resource(t, obj),
+		Permission:   *permission,
+	}
+}
+
+func createSoDConstraint(t *testing.T, administration *AdministrationService, name string, kind domain.SoDKind, permissions ...string) {
+	constraint, err := domain.NewSoDConstraint(name, kind, permissions)
+	require.NoError(t, err)
+	resp := administration.CreateSoDConstraint(ctx, domain.CreateSoDConstraintReq{Constraint: *constraint})
+	require.NoError(t, resp.Error)
+}
+
+func TestCreatePolicy(t *testing.T) {
+	testCases := []struct {
+		setup       func(t *testing.T, administration *AdministrationService)
+		req         func(t *testing.T) domain.CreatePolicyReq
+		err         error
+		description string
+	}{
+		{
+			setup: func(t *testing.T, administration *AdministrationService) {},
+			req: func(t *testing.T) domain.CreatePolicyReq {
+				return policyReq(t, "user/u", "ns/n", "read", domain.PermissionKindAllow, "")
+			},
+			description: "allow policy",
+		},
+		{
+			setup: func(t *testing.T, administration *AdministrationService) {},
+			req: func(t *testing.T) domain.CreatePolicyReq {
+				req := policyReq(t, "user/u", "ns/n", "read", domain.PermissionKindAllow, "")
+				req.SubjectScope = domain.Resource{}
+				req.ObjectScope = domain.Resource{}
+				return req
+			},
+			description: "empty scopes default to the root",
+		},
+		{
+			setup: func(t *testing.T, administration *AdministrationService) {
+				createSoDConstraint(t, administration, "four-eyes", domain.SoDStatic, "approve", "submit")
+				resp := administration.CreatePolicy(ctx, policyReq(t, "user/u", "ns/n", "submit", domain.PermissionKindAllow, ""))
+				require.NoError(t, resp.Error)
+			},
+			req: func(t *testing.T) domain.CreatePolicyReq {
+				return policyReq(t, "user/u", "ns/n", "approve", domain.PermissionKindAllow, "")
+			},
+			err:         domain.ErrSoDViolation,
+			description: "allow policy violating a static constraint",
+		},
+		{
+			setup: func(t *testing.T, administration *AdministrationService) {
+				createSoDConstraint(t, administration, "four-eyes", domain.SoDStatic, "approve", "submit")
+				resp := administration.CreatePolicy(ctx, policyReq(t, "user/u", "ns/n", "submit", domain.PermissionKindAllow, ""))
+				require.NoError(t, resp.Error)
+			},
+			req: func(t *testing.T) domain.CreatePolicyReq {
+				return policyReq(t, "user/u", "ns/n", "approve", domain.PermissionKindDeny, "")
+			},
+			description: "deny policy can't violate a static constraint",
+		},
+	}
+	for _, testCase := range testCases {
+		c := testCase
+		t.Run(c.description, func(t *testing.T) {
+			t.Parallel()
+			administration, _ := newTestServices(t)
+			c.setup(t, administration)
+			resp := administration.CreatePolicy(ctx, c.req(t))
+			assert.ErrorIs(t, resp.Error, c.err)
+		})
+	}
+}
+
+func TestCreatePolicyConditionTypeCheck(t *testing.T) {
+	administration, _ := newTestServices(t)
+	level, err := domain.NewAttributeDefinition("level", domain.Int64, false, nil)
+	require.NoError(t, err)
+	schema, err := domain.NewAttributeSchema("user", []domain.AttributeDefinition{*level})
+	require.NoError(t, err)
+	require.NoError(t, administration.PutAttributeSchema(ctx, domain.PutAttributeSchemaReq{Schema: *schema}).Error)
+
+	resp := administration.CreatePolicy(ctx, policyReq(t, "user/u", "ns/n", "read", domain.PermissionKindAllow, "sub_level > 2"))
+	assert.NoError(t, resp.Error)
+	resp = administration.CreatePolicy(ctx, policyReq(t, "user/u", "ns/n", "read", domain.PermissionKindAllow, "sub_clearance > 2"))
+	assert.Error(t, resp.Error)
+}
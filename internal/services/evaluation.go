@@ -2,10 +2,15 @@ package services
 
 import (
 	"context"
+	"errors"
 	"log"
+	"time"
 
 	"github.com/c12s/oort/internal/domain"
+	"github.com/google/uuid"
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type EvaluationService struct {
@@ -23,10 +28,13 @@ func (h EvaluationService) Authorize(ctx context.Context, req domain.Authorizati
 	ctx, span := tracer.Start(ctx, "EvaluationService.Authorize")
 	defer span.End()
 
-	resp := h.repo.GetPermissionHierarchy(ctx, domain.GetPermissionHierarchyReq{
+	// the check reads everything in one repo call, only the graph predicates the hierarchy uses and the writes need more
+	now := time.Now()
+	resp := h.repo.GetAuthorizationData(ctx, domain.GetAuthorizationDataReq{
 		Subject:        req.Subject,
 		Object:         req.Object,
 		PermissionName: req.PermissionName,
+		Now:            now,
 	})
 	if resp.Error != nil {
 		return domain.AuthorizationResp{
@@ -35,14 +43,7 @@ func (h EvaluationService) Authorize(ctx context.Context, req domain.Authorizati
 		}
 	}
 
-	subAttrs, err := h.getAttributes(ctx, req.Subject)
-	if err != nil {
-		return domain.AuthorizationResp{
-			Authorized: false,
-			Error:      err,
-		}
-	}
-	objAttrs, err := h.getAttributes(ctx, req.Object)
+	relations, err := h.resolveRelations(ctx, resp.Hierarchy, req.Subject, req.Object)
 	if err != nil {
 		return domain.AuthorizationResp{
 			Authorized: false,
@@ -51,20 +52,93 @@ func (h EvaluationService) Authorize(ctx context.Context, req domain.Authorizati
 	}
 
 	evalReq := domain.PermissionEvalRequest{
-		Subject: subAttrs,
-		Object:  objAttrs,
-		Env:     req.Env,
+		Subject:   resp.Subject.Resolve(),
+		Object:    resp.Object.Resolve(),
+		Env:       req.Env,
+		Relations: relations,
+		Explain:   req.Explain,
+		Algorithm: resp.Algorithm,
 	}
-	evalResult := resp.Hierarchy.Eval(evalReq)
+	decision := resp.Hierarchy.Eval(evalReq)
+	recordConditionErrors(span, decision.ConditionErrors)
 
 	checkResp := domain.AuthorizationResp{
-		Authorized: authorized(evalResult),
-		Error:      nil,
+		Authorized:      authorized(decision.Result),
+		ConditionErrors: decision.ConditionErrors,
+		Obligations:     decision.Obligations,
+		Explanation:     decision.Explanation,
+		Error:           nil,
+	}
+
+	if checkResp.Authorized {
+		violation, err := h.exercise(ctx, req.Subject, req.Object, req.PermissionName, resp.SoDConstraints, !req.Exercise)
+		if err != nil {
+			return domain.AuthorizationResp{
+				Authorized: false,
+				Error:      err,
+			}
+		}
+		if violation != nil {
+			span.SetAttributes(attribute.String("sod.constraint", violation.Constraint))
+			checkResp.Authorized = false
+			checkResp.SoDViolation = violation
+		}
+	}
+
+	// only a policy deny can be overridden by breaking glass, a separation of duty violation always wins,
+	// and break glass permissions themselves can't be overridden either
+	if !authorized(decision.Result) && !domain.IsBreakGlassPermissionName(req.PermissionName) && resp.BreakGlass != nil {
+		span.SetAttributes(attribute.String("break_glass.id", resp.BreakGlass.Id()))
+		// every use is audited, the override isn't granted if it can't be recorded
+		useResp := h.repo.CreateBreakGlassUse(ctx, domain.CreateBreakGlassUseReq{
+			Use: domain.NewBreakGlassUse(resp.BreakGlass.Id(), now),
+		})
+		if useResp.Error != nil {
+			return domain.AuthorizationResp{
+				Authorized: false,
+				Error:      useResp.Error,
+			}
+		}
+		checkResp.Authorized = true
+		checkResp.BreakGlass = resp.BreakGlass
 	}
 
 	return checkResp
 }
 
+// BreakGlass records an emergency allow for the requested permission,
+// the subject must be authorized for the matching break glass permission on the object
+func (h EvaluationService) BreakGlass(ctx context.Context, req domain.BreakGlassReq) domain.BreakGlassResp {
+	tracer := otel.Tracer("oort.service.evaluation")
+	ctx, span := tracer.Start(ctx, "EvaluationService.BreakGlass")
+	defer span.End()
+
+	breakGlass, err := domain.NewBreakGlass(uuid.NewString(), req.Subject, req.Object, req.PermissionName, req.Justification, time.Now(), req.Duration)
+	if err != nil {
+		return domain.BreakGlassResp{Error: err}
+	}
+
+	authResp := h.Authorize(ctx, domain.AuthorizationReq{
+		Subject:        req.Subject,
+		Object:         req.Object,
+		PermissionName: domain.BreakGlassPermissionName(req.PermissionName),
+		Env:            req.Env,
+	})
+	if authResp.Error != nil {
+		return domain.BreakGlassResp{Error: authResp.Error}
+	}
+	if !authResp.Authorized {
+		return domain.BreakGlassResp{Error: domain.ErrBreakGlassNotPermitted}
+	}
+
+	resp := h.repo.CreateBreakGlass(ctx, domain.CreateBreakGlassReq{BreakGlass: *breakGlass})
+	if resp.Error != nil {
+		return domain.BreakGlassResp{Error: resp.Error}
+	}
+	span.SetAttributes(attribute.String("break_glass.id", breakGlass.Id()))
+	return domain.BreakGlassResp{BreakGlass: breakGlass}
+}
+
 func (h EvaluationService) GetGrantedPermissions(ctx context.Context, req domain.GetGrantedPermissionsReq) domain.GetGrantedPermissionsResp {
 	// dobavi sve politike koje su subjektno direktno dodeljene ili ih je nasledio
 	// svaka ukljucuje naziv dozvole i objekat nad kojim vazi
@@ -78,50 +152,60 @@ func (h EvaluationService) GetGrantedPermissions(ctx context.Context, req domain
 	if resp.Error != nil {
 		return domain.GetGrantedPermissionsResp{Error: resp.Error}
 	}
+	// the attributes of the subject are read with the data of every policy, but an unknown subject is still an error
+	subResp := h.repo.GetResource(ctx, domain.GetResourceReq{Resource: req.Subject})
+	if subResp.Error != nil {
+		return domain.GetGrantedPermissionsResp{Error: subResp.Error}
+	}
 
 	granted := make([]domain.GrantedPermission, 0)
-
-	subAttrs, err := h.getAttributes(ctx, req.Subject)
-	if err != nil {
-		return domain.GetGrantedPermissionsResp{Error: resp.Error}
-	}
-	// proveravamo nad vise objekata, svaki objekat je element u mapi
-	objAttrMap := make(map[string][]domain.Attribute)
+	now := time.Now()
 
 	// za svaki policy proveri da li trenutno daje dozvolu subjektu
 	for _, policy := range resp.Policies {
-		objAttrs, ok := objAttrMap[policy.Object.Name()]
-		if !ok {
-			objAttrs, err = h.getAttributes(ctx, policy.Object)
-			if err != nil {
-				log.Println(err)
-				continue
-			}
-			objAttrMap[policy.Object.Name()] = objAttrs
-		}
-
-		hierarchyResp := h.repo.GetPermissionHierarchy(ctx, domain.GetPermissionHierarchyReq{
+		dataResp := h.repo.GetAuthorizationData(ctx, domain.GetAuthorizationDataReq{
 			Subject:        req.Subject,
 			Object:         policy.Object,
 			PermissionName: policy.PermissionName,
+			Now:            now,
 		})
-		if hierarchyResp.Error != nil {
-			log.Println(hierarchyResp.Error)
+		if dataResp.Error != nil {
+			log.Println(dataResp.Error)
+			continue
+		}
+
+		relations, err := h.resolveRelations(ctx, dataResp.Hierarchy, req.Subject, policy.Object)
+		if err != nil {
+			log.Println(err)
 			continue
 		}
 
 		evalReq := domain.PermissionEvalRequest{
-			Subject: subAttrs,
-			Object:  objAttrs,
-			Env:     req.Env,
+			Subject:   dataResp.Subject.Resolve(),
+			Object:    dataResp.Object.Resolve(),
+			Env:       req.Env,
+			Relations: relations,
+			Algorithm: dataResp.Algorithm,
+		}
+		decision := dataResp.Hierarchy.Eval(evalReq)
+		recordConditionErrors(span, decision.ConditionErrors)
+		if !authorized(decision.Result) {
+			continue
+		}
+		// listing the permissions doesn't exercise them
+		violation, err := h.exercise(ctx, req.Subject, policy.Object, policy.PermissionName, dataResp.SoDConstraints, true)
+		if err != nil {
+			log.Println(err)
+			continue
 		}
-		evalResp := hierarchyResp.Hierarchy.Eval(evalReq)
-		if authorized(evalResp) {
-			granted = append(granted, domain.GrantedPermission{
-				PermissionName: policy.PermissionName,
-				Object:         policy.Object,
-			})
+		if violation != nil {
+			continue
 		}
+		granted = append(granted, domain.GrantedPermission{
+			PermissionName: policy.PermissionName,
+			Object:         policy.Object,
+			Obligations:    decision.Obligations,
+		})
 	}
 
 	return domain.GetGrantedPermissionsResp{
@@ -130,16 +214,47 @@ func (h EvaluationService) GetGrantedPermissions(ctx context.Context, req domain
 	}
 }
 
-func (h EvaluationService) getAttributes(ctx context.Context, resource domain.Resource) ([]domain.Attribute, error) {
-	tracer := otel.Tracer("oort.service.evaluation")
-	ctx, span := tracer.Start(ctx, "EvaluationService.getAttributes")
-	defer span.End()
+// exercise records an allowed permission that is part of the dynamic separation of duty constraints,
+// it returns the violated constraint if the subject already exercised a conflicting permission on the object.
+// A dry run only checks the constraints without recording the exercise.
+func (h EvaluationService) exercise(ctx context.Context, subject, object domain.Resource, permissionName string, constraints []domain.SoDConstraint, dryRun bool) (*domain.SoDViolation, error) {
+	if len(constraints) == 0 {
+		return nil, nil
+	}
+	resp := h.repo.ExercisePermission(ctx, domain.ExercisePermissionReq{
+		Subject:        subject,
+		Object:         object,
+		PermissionName: permissionName,
+		SoDConstraints: constraints,
+		DryRun:         dryRun,
+	})
+	var violation domain.SoDViolation
+	if errors.As(resp.Error, &violation) {
+		return &violation, nil
+	}
+	return nil, resp.Error
+}
+
+// resolveRelations resolves all graph predicates used by the hierarchy in a single repo call
+func (h EvaluationService) resolveRelations(ctx context.Context, hierarchy domain.PermissionHierarchy, subject, object domain.Resource) (domain.GraphRelations, error) {
+	predicates := hierarchy.GraphPredicates()
+	if len(predicates) == 0 {
+		return nil, nil
+	}
+	resp := h.repo.ResolveGraphPredicates(ctx, domain.ResolveGraphPredicatesReq{
+		Subject:    subject,
+		Object:     object,
+		Predicates: predicates,
+	})
+	return resp.Relations, resp.Error
+}
 
-	res := h.repo.GetResource(ctx, domain.GetResourceReq{Resource: resource})
-	if res.Error != nil {
-		return nil, res.Error
+func recordConditionErrors(span trace.Span, condErrs []domain.ConditionError) {
+	for _, condErr := range condErrs {
+		span.RecordError(condErr, trace.WithAttributes(
+			attribute.String("permission", condErr.Permission),
+			attribute.String("missing_attribute", condErr.MissingAttribute)))
 	}
-	return res.Resource.Attributes, nil
 }
 
 func authorized(result domain.EvalResult) bool {
@@ -0,0 +1,291 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/c12s/oort/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func authorizationReq(t *testing.T, sub, obj, permName string) domain.AuthorizationReq {
+	return domain.AuthorizationReq{
+		Subject:        resource(t, sub),
+		Object:         resource(t, obj),
+		PermissionName: permName,
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	testCases := []struct {
+		setup       func(t *testing.T, administration *AdministrationService)
+		req         domain.AuthorizationReq
+		authorized  bool
+		description string
+	}{
+		{
+			setup:       func(t *testing.T, administration *AdministrationService) {},
+			req:         authorizationReq(t, "user/u", "ns/n", "read"),
+			authorized:  false,
+			description: "no policy",
+		},
+		{
+			setup: func(t *testing.T, administration *AdministrationService) {
+				require.NoError(t, administration.CreatePolicy(ctx, policyReq(t, "user/u", "ns/n", "read", domain.PermissionKindAllow, "")).Error)
+			},
+			req:         authorizationReq(t, "user/u", "ns/n", "read"),
+			authorized:  true,
+			description: "allow policy",
+		},
+		{
+			setup: func(t *testing.T, administration *AdministrationService) {
+				require.NoError(t, administration.CreatePolicy(ctx, policyReq(t, "group/g", "ns/n", "read", domain.PermissionKindAllow, "")).Error)
+				require.NoError(t, administration.CreateRelation(ctx, domain.CreateRelationReq{From: resource(t, "group/g"), To: resource(t, "user/u")}).Error)
+			},
+			req:         authorizationReq(t, "user/u", "ns/n", "read"),
+			authorized:  true,
+			description: "inherited allow policy",
+		},
+		{
+			setup: func(t *testing.T, administration *AdministrationService) {
+				require.NoError(t, administration.CreatePolicy(ctx, policyReq(t, "group/g", "ns/n", "read", domain.PermissionKindAllow, "")).Error)
+				require.NoError(t, administration.CreateRelation(ctx, domain.CreateRelationReq{From: resource(t, "group/g"), To: resource(t, "user/u")}).Error)
+				require.NoError(t, administration.CreatePolicy(ctx, policyReq(t, "user/u", "ns/n", "read", domain.PermissionKindDeny, "")).Error)
+			},
+			req:         authorizationReq(t, "user/u", "ns/n", "read"),
+			authorized:  false,
+			description: "deny policy overrides an inherited allow",
+		},
+		{
+			setup: func(t *testing.T, administration *AdministrationService) {
+				require.NoError(t, administration.PutAttribute(ctx, domain.PutAttributeReq{Resource: resource(t, "user/u"), Attribute: int64Attr(t, "clearance", 3)}).Error)
+				require.NoError(t, administration.CreatePolicy(ctx, policyReq(t, "user/u", "ns/n", "read", domain.PermissionKindAllow, "sub_clearance > 5")).Error)
+			},
+			req:         authorizationReq(t, "user/u", "ns/n", "read"),
+			authorized:  false,
+			description: "unsatisfied condition",
+		},
+	}
+	for _, testCase := range testCases {
+		c := testCase
+		t.Run(c.description, func(t *testing.T) {
+			t.Parallel()
+			administration, evaluation := newTestServices(t)
+			require.NoError(t, administration.CreateResource(ctx, domain.CreateResourceReq{Resource: resource(t, "user/u")}).Error)
+			require.NoError(t, administration.CreateResource(ctx, domain.CreateResourceReq{Resource: resource(t, "ns/n")}).Error)
+			c.setup(t, administration)
+			resp := evaluation.Authorize(ctx, c.req)
+			require.NoError(t, resp.Error)
+			assert.Equal(t, c.authorized, resp.Authorized)
+		})
+	}
+}
+
+func TestAuthorizeDynamicSoD(t *testing.T) {
+	administration, evaluation := newTestServices(t)
+	require.NoError(t, administration.CreatePolicy(ctx, policyReq(t, "user/u", "ns/n", "submit", domain.PermissionKindAllow, "")).Error)
+	require.NoError(t, administration.CreatePolicy(ctx, policyReq(t, "user/u", "ns/n", "approve", domain.PermissionKindAllow, "")).Error)
+	createSoDConstraint(t, administration, "four-eyes", domain.SoDDynamic, "approve", "submit")
+
+	// checks that don't exercise the permission leave the other one allowed
+	resp := evaluation.Authorize(ctx, authorizationReq(t, "user/u", "ns/n", "submit"))
+	require.True(t, resp.Authorized)
+	explain := authorizationReq(t, "user/u", "ns/n", "submit")
+	explain.Explain = true
+	resp = evaluation.Authorize(ctx, explain)
+	require.True(t, resp.Authorized)
+	assert.Len(t, granted(t, evaluation, "user/u"), 2)
+
+	// explaining an exercised check records it all the same
+	exercise := authorizationReq(t, "user/u", "ns/n", "submit")
+	exercise.Exercise = true
+	exercise.Explain = true
+	resp = evaluation.Authorize(ctx, exercise)
+	require.True(t, resp.Authorized)
+	assert.NotNil(t, resp.Explanation)
+	assert.Equal(t, []string{"submit"}, granted(t, evaluation, "user/u"))
+
+	resp = evaluation.Authorize(ctx, authorizationReq(t, "user/u", "ns/n", "approve"))
+	require.NoError(t, resp.Error)
+	assert.False(t, resp.Authorized)
+	require.NotNil(t, resp.SoDViolation)
+	assert.Equal(t, "four-eyes", resp.SoDViolation.Constraint)
+}
+
+// readRecordingRepo records the reads the services make, the writes aren't recorded
+type readRecordingRepo struct {
+	domain.RHABACRepo
+	reads []string
+}
+
+func (r *readRecordingRepo) GetResource(ctx context.Context, req domain.GetResourceReq) domain.GetResourceResp {
+	r.reads = append(r.reads, "GetResource")
+	return r.RHABACRepo.GetResource(ctx, req)
+}
+
+func (r *readRecordingRepo) GetPermissionHierarchy(ctx context.Context, req domain.GetPermissionHierarchyReq) domain.GetPermissionHierarchyResp {
+	r.reads = append(r.reads, "GetPermissionHierarchy")
+	return r.RHABACRepo.GetPermissionHierarchy(ctx, req)
+}
+
+func (r *readRecordingRepo) GetAuthorizationData(ctx context.Context, req domain.GetAuthorizationDataReq) domain.GetAuthorizationDataResp {
+	r.reads = append(r.reads, "GetAuthorizationData")
+	return r.RHABACRepo.GetAuthorizationData(ctx, req)
+}
+
+func (r *readRecordingRepo) GetAttributeSchema(ctx context.Context, req domain.GetAttributeSchemaReq) domain.GetAttributeSchemaResp {
+	r.reads = append(r.reads, "GetAttributeSchema")
+	return r.RHABACRepo.GetAttributeSchema(ctx, req)
+}
+
+func (r *readRecordingRepo) GetCombiningAlgorithm(ctx context.Context, req domain.GetCombiningAlgorithmReq) domain.GetCombiningAlgorithmResp {
+	r.reads = append(r.reads, "GetCombiningAlgorithm")
+	return r.RHABACRepo.GetCombiningAlgorithm(ctx, req)
+}
+
+func (r *readRecordingRepo) GetActiveBreakGlass(ctx context.Context, req domain.GetActiveBreakGlassReq) domain.GetActiveBreakGlassResp {
+	r.reads = append(r.reads, "GetActiveBreakGlass")
+	return r.RHABACRepo.GetActiveBreakGlass(ctx, req)
+}
+
+func (r *readRecordingRepo) GetSoDConstraints(ctx context.Context, req domain.GetSoDConstraintsReq) domain.GetSoDConstraintsResp {
+	r.reads = append(r.reads, "GetSoDConstraints")
+	return r.RHABACRepo.GetSoDConstraints(ctx, req)
+}
+
+func (r *readRecordingRepo) GetAncestorAttributes(ctx context.Context, req domain.GetAncestorAttributesReq) domain.GetAncestorAttributesResp {
+	r.reads = append(r.reads, "GetAncestorAttributes")
+	return r.RHABACRepo.GetAncestorAttributes(ctx, req)
+}
+
+func (r *readRecordingRepo) ResolveGraphPredicates(ctx context.Context, req domain.ResolveGraphPredicatesReq) domain.ResolveGraphPredicatesResp {
+	r.reads = append(r.reads, "ResolveGraphPredicates")
+	return r.RHABACRepo.ResolveGraphPredicates(ctx, req)
+}
+
+func TestAuthorizeReadsOnce(t *testing.T) {
+	testCases := []struct {
+		expression  string
+		reads       []string
+		description string
+	}{
+		{
+			expression:  "sub_clearance > 2",
+			reads:       []string{"GetAuthorizationData"},
+			description: "attribute condition",
+		},
+		{
+			expression:  `shareAncestor(sub, obj, "root") || sub_clearance > 2`,
+			reads:       []string{"GetAuthorizationData", "ResolveGraphPredicates"},
+			description: "graph predicate condition",
+		},
+	}
+	for _, testCase := range testCases {
+		c := testCase
+		t.Run(c.description, func(t *testing.T) {
+			t.Parallel()
+			administration, evaluation := newTestServices(t)
+			require.NoError(t, administration.PutAttribute(ctx, domain.PutAttributeReq{Resource: resource(t, "user/u"), Attribute: int64Attr(t, "clearance", 3)}).Error)
+			require.NoError(t, administration.CreateResource(ctx, domain.CreateResourceReq{Resource: resource(t, "ns/n")}).Error)
+			require.NoError(t, administration.CreatePolicy(ctx, policyReq(t, "user/u", "ns/n", "read", domain.PermissionKindAllow, c.expression)).Error)
+			createSoDConstraint(t, administration, "four-eyes", domain.SoDDynamic, "read", "write")
+			repo := &readRecordingRepo{RHABACRepo: evaluation.repo}
+			evaluation.repo = repo
+
+			resp := evaluation.Authorize(ctx, authorizationReq(t, "user/u", "ns/n", "read"))
+			require.NoError(t, resp.Error)
+			assert.Equal(t, c.reads, repo.reads)
+		})
+	}
+}
+
+func TestGetGrantedPermissionsUnknownSubject(t *testing.T) {
+	_, evaluation := newTestServices(t)
+	resp := evaluation.GetGrantedPermissions(ctx, domain.GetGrantedPermissionsReq{Subject: resource(t, "user/unknown")})
+	assert.Error(t, resp.Error)
+	assert.Empty(t, resp.Permissions)
+}
+
+func TestBreakGlassPermission(t *testing.T) {
+	administration, evaluation := newTestServices(t)
+	require.NoError(t, administration.CreatePolicy(ctx, policyReq(t, "user/u", "ns/n", "**", domain.PermissionKindAllow, "")).Error)
+	breakGlassReq := domain.BreakGlassReq{
+		Subject:        resource(t, "user/u"),
+		Object:         resource(t, "ns/n"),
+		PermissionName: "db.write",
+		Justification:  "incident 42",
+	}
+	resp := evaluation.BreakGlass(ctx, breakGlassReq)
+	assert.ErrorIs(t, resp.Error, domain.ErrBreakGlassNotPermitted)
+
+	breakGlassPermission := domain.BreakGlassPermissionName("db.write")
+	require.NoError(t, administration.CreatePolicy(ctx, policyReq(t, "user/u", "ns/n", breakGlassPermission, domain.PermissionKindAllow, "")).Error)
+	resp = evaluation.BreakGlass(ctx, breakGlassReq)
+	require.NoError(t, resp.Error)
+	assert.NotNil(t, resp.BreakGlass)
+}
+
+func TestAuthorizeBreakGlass(t *testing.T) {
+	administration, evaluation := newTestServices(t)
+	require.NoError(t, administration.CreatePolicy(ctx, policyReq(t, "user/u", "ns/n", "oort.breakglass.**", domain.PermissionKindAllow, "")).Error)
+	require.NoError(t, administration.CreatePolicy(ctx, policyReq(t, "user/u", "ns/n", "submit", domain.PermissionKindAllow, "")).Error)
+	require.NoError(t, administration.CreatePolicy(ctx, policyReq(t, "user/u", "ns/n", "approve", domain.PermissionKindAllow, "")).Error)
+	createSoDConstraint(t, administration, "four-eyes", domain.SoDDynamic, "approve", "submit")
+	breakGlass := func(permName string) domain.BreakGlass {
+		resp := evaluation.BreakGlass(ctx, domain.BreakGlassReq{
+			Subject:        resource(t, "user/u"),
+			Object:         resource(t, "ns/n"),
+			PermissionName: permName,
+			Justification:  "incident 42",
+		})
+		require.NoError(t, resp.Error)
+		return *resp.BreakGlass
+	}
+	uses := func(breakGlass domain.BreakGlass) []domain.BreakGlassUse {
+		resp := evaluation.repo.GetBreakGlassUses(ctx, domain.GetBreakGlassUsesReq{BreakGlassId: breakGlass.Id()})
+		require.NoError(t, resp.Error)
+		return resp.Uses
+	}
+
+	// the override of a policy deny is recorded on every use
+	write := breakGlass("db.write")
+	for i := 1; i <= 2; i++ {
+		resp := evaluation.Authorize(ctx, authorizationReq(t, "user/u", "ns/n", "db.write"))
+		require.NoError(t, resp.Error)
+		assert.True(t, resp.Authorized)
+		require.NotNil(t, resp.BreakGlass)
+		assert.Equal(t, write.Id(), resp.BreakGlass.Id())
+		assert.Len(t, uses(write), i)
+	}
+
+	// a separation of duty violation isn't overridden
+	approve := breakGlass("approve")
+	exercise := authorizationReq(t, "user/u", "ns/n", "submit")
+	exercise.Exercise = true
+	require.True(t, evaluation.Authorize(ctx, exercise).Authorized)
+	resp := evaluation.Authorize(ctx, authorizationReq(t, "user/u", "ns/n", "approve"))
+	require.NoError(t, resp.Error)
+	assert.False(t, resp.Authorized)
+	assert.NotNil(t, resp.SoDViolation)
+	assert.Nil(t, resp.BreakGlass)
+	assert.Empty(t, uses(approve))
+}
+
+func int64Attr(t *testing.T, name string, value int64) domain.Attribute {
+	id, err := domain.NewAttributeId(name)
+	require.NoError(t, err)
+	attr, err := domain.NewAttribute(*id, domain.Int64, value)
+	require.NoError(t, err)
+	return *attr
+}
+
+func granted(t *testing.T, evaluation *EvaluationService, sub string) []string {
+	resp := evaluation.GetGrantedPermissions(ctx, domain.GetGrantedPermissionsReq{Subject: resource(t, sub)})
+	require.NoError(t, resp.Error)
+	names := make([]string, 0, len(resp.Permissions))
+	for _, permission := range resp.Permissions {
+		names = append(names, permission.PermissionName)
+	}
+	return names
+}
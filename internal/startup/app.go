@@ -11,7 +11,6 @@ import (
 
 	"github.com/c12s/oort/internal/configs"
 	"github.com/c12s/oort/internal/domain"
-	"github.com/c12s/oort/internal/repos/rhabac/neo4j"
 	"github.com/c12s/oort/internal/servers"
 	"github.com/c12s/oort/internal/services"
 	"github.com/c12s/oort/pkg/api"
@@ -27,6 +26,7 @@ type app struct {
 	config                    configs.Config
 	grpcServer                *grpc.Server
 	administratorAsyncServer  *servers.AdministratorAsyncServer
+	policyExpirySweeper       *servers.PolicyExpirySweeper
 	administratorGrpcServer   api.OortAdministratorServer
 	evaluatorGrpcServer       api.OortEvaluatorServer
 	administrationService     *services.AdministrationService
@@ -63,6 +63,10 @@ func (a *app) Start() error {
 	if err != nil {
 		return err
 	}
+	err = a.startPolicyExpirySweeper()
+	if err != nil {
+		return err
+	}
 	return a.startGrpcServer()
 }
 
@@ -105,26 +109,16 @@ func (a *app) init() {
 		natsConn.Close()
 	})
 
-	manager, err := neo4j.NewTransactionManager(
-		a.config.Neo4j().Uri(),
-		a.config.Neo4j().DbName())
-	if err != nil {
-		log.Fatalln(err)
-	}
-	a.shutdownProcesses = append(a.shutdownProcesses, func() {
-		log.Println("closing neo4j conn")
-		manager.Stop()
-	})
-
 	a.initNatsPublisher(natsConn)
 	a.initAdministrationNatsSubscriber(natsConn)
 
-	a.initRhabacNeo4jRepo(manager)
+	a.initRhabacRepo()
 
 	a.initAdministratorService()
 	a.initEvaluatorService()
 
 	a.initAdministratorAsyncServer()
+	a.initPolicyExpirySweeper()
 	a.initAdministratorGrpcServer()
 	a.initEvaluatorGrpcServer()
 	a.initGrpcServer()
@@ -161,7 +155,10 @@ func (a *app) initEvaluatorGrpcServer() {
 	if a.evaluationService == nil {
 		log.Fatalln("eval service is nil")
 	}
-	server, err := servers.NewOortEvaluatorGrpcServer(*a.evaluationService)
+	if a.publisher == nil {
+		log.Fatalln("publisher is nil")
+	}
+	server, err := servers.NewOortEvaluatorGrpcServer(*a.evaluationService, a.publisher)
 	if err != nil {
 		log.Fatalln(err)
 	}
@@ -185,6 +182,20 @@ func (a *app) initAdministratorAsyncServer() {
 	a.administratorAsyncServer = server
 }
 
+func (a *app) initPolicyExpirySweeper() {
+	if a.administrationService == nil {
+		log.Fatalln("admin service is nil")
+	}
+	if a.publisher == nil {
+		log.Fatalln("publisher is nil")
+	}
+	sweeper, err := servers.NewPolicyExpirySweeper(a.publisher, *a.administrationService, a.config.Server().PolicySweepInterval())
+	if err != nil {
+		log.Fatalln(err)
+	}
+	a.policyExpirySweeper = sweeper
+}
+
 func (a *app) initEvaluatorService() {
 	if a.rhabacRepo == nil {
 		log.Fatalln("rhabac repo is nil")
@@ -200,7 +211,7 @@ func (a *app) initAdministratorService() {
 	if a.rhabacRepo == nil {
 		log.Fatalln("rhabac repo is nil")
 	}
-	administratorService, err := services.NewAdministrationService(a.rhabacRepo)
+	administratorService, err := services.NewAdministrationService(a.rhabacRepo, a.config.Server().MaxInheritanceDepth())
 	if err != nil {
 		log.Fatalln(err)
 	}
@@ -223,8 +234,17 @@ func (a *app) initAdministrationNatsSubscriber(conn *natsgo.Conn) {
 	a.administratorSubscriber = administrationSubscriber
 }
 
-func (a *app) initRhabacNeo4jRepo(manager *neo4j.TransactionManager) {
-	a.rhabacRepo = neo4j.NewRHABACRepo(manager, neo4j.NewSimpleCypherFactory())
+func (a *app) initRhabacRepo() {
+	factory, ok := rhabacRepoFactories[a.config.Server().Repo()]
+	if !ok {
+		log.Fatalf("unknown repo: %s", a.config.Server().Repo())
+	}
+	repo, shutdown, err := factory(a.config)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	a.shutdownProcesses = append(a.shutdownProcesses, shutdown)
+	a.rhabacRepo = repo
 }
 
 func (a *app) startAdministratorAsyncServer() error {
@@ -240,6 +260,19 @@ func (a *app) startAdministratorAsyncServer() error {
 	return nil
 }
 
+func (a *app) startPolicyExpirySweeper() error {
+	err := a.policyExpirySweeper.Serve()
+	if err != nil {
+		return err
+	}
+	a.gracefulShutdownProcesses = append(a.gracefulShutdownProcesses, func(wg *sync.WaitGroup) {
+		a.policyExpirySweeper.GracefulStop()
+		log.Println("policy expiry sweeper gracefully stopped")
+		wg.Done()
+	})
+	return nil
+}
+
 func (a *app) startGrpcServer() error {
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%s", a.config.Server().Port()))
 	if err != nil {
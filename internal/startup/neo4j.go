@@ -0,0 +1,109 @@
+package startup
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+
+	neo4jconfig "github.com/c12s/oort/internal/configs/neo4j"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/auth"
+)
+
+func newNeo4jAuth(config neo4jconfig.Config) (neo4j.AuthToken, error) {
+	switch config.AuthScheme() {
+	case neo4jconfig.AuthNone:
+		return neo4j.NoAuth(), nil
+	case neo4jconfig.AuthBasic:
+		return neo4j.BasicAuth(config.Username(), config.Password(), ""), nil
+	case neo4jconfig.AuthBearer:
+		if config.BearerToken() == "" {
+			return neo4j.AuthToken{}, errors.New("neo4j bearer auth needs a token")
+		}
+		return neo4j.BearerAuth(config.BearerToken()), nil
+	default:
+		return neo4j.AuthToken{}, fmt.Errorf("neo4j auth scheme unknown: %s", config.AuthScheme())
+	}
+}
+
+var encryptedSchemes = map[string]bool{
+	"neo4j+s":   true,
+	"bolt+s":    true,
+	"neo4j+ssc": true,
+	"bolt+ssc":  true,
+}
+
+// the driver skips verifying the server certificate for these schemes
+var selfSignedSchemes = map[string]bool{
+	"neo4j+ssc": true,
+	"bolt+ssc":  true,
+}
+
+// newNeo4jConfigurer loads the certificates up front, so that a misconfigured TLS setup fails at startup
+func newNeo4jConfigurer(config neo4jconfig.Config) (func(*neo4j.Config), error) {
+	var rootCAs *x509.CertPool
+	var clientCert auth.ClientCertificateProvider
+	if config.CaCertPath() != "" || config.ClientCertPath() != "" || config.ClientKeyPath() != "" {
+		// the driver ignores TLS settings for unencrypted schemes
+		uri, err := url.Parse(config.Uri())
+		if err != nil {
+			return nil, err
+		}
+		if !encryptedSchemes[uri.Scheme] {
+			return nil, fmt.Errorf("neo4j TLS settings need an encrypted scheme, got %q", uri.Scheme)
+		}
+		if config.CaCertPath() != "" && selfSignedSchemes[uri.Scheme] {
+			return nil, fmt.Errorf("neo4j CA certificate needs a verifying scheme, %q trusts any certificate", uri.Scheme)
+		}
+	}
+	if config.CaCertPath() != "" {
+		pem, err := os.ReadFile(config.CaCertPath())
+		if err != nil {
+			return nil, err
+		}
+		rootCAs = x509.NewCertPool()
+		if !rootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in %s", config.CaCertPath())
+		}
+	}
+	if config.ClientCertPath() != "" || config.ClientKeyPath() != "" {
+		provider, err := auth.NewStaticClientCertificateProvider(auth.ClientCertificate{
+			CertFile: config.ClientCertPath(),
+			KeyFile:  config.ClientKeyPath(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		clientCert = provider
+	}
+	return func(c *neo4j.Config) {
+		if rootCAs != nil {
+			c.TlsConfig = &tls.Config{RootCAs: rootCAs, MinVersion: tls.VersionTLS12}
+		}
+		if clientCert != nil {
+			c.ClientCertificateProvider = clientCert
+		}
+		if config.MaxConnectionPoolSize() > 0 {
+			c.MaxConnectionPoolSize = config.MaxConnectionPoolSize()
+		}
+		if config.MaxConnectionLifetime() > 0 {
+			c.MaxConnectionLifetime = config.MaxConnectionLifetime()
+		}
+		if config.ConnectionAcquisitionTimeout() > 0 {
+			c.ConnectionAcquisitionTimeout = config.ConnectionAcquisitionTimeout()
+		}
+		if config.ConnectionLivenessCheckTimeout() > 0 {
+			c.ConnectionLivenessCheckTimeout = config.ConnectionLivenessCheckTimeout()
+		}
+		if config.SocketConnectTimeout() > 0 {
+			c.SocketConnectTimeout = config.SocketConnectTimeout()
+		}
+		if config.MaxTransactionRetryTime() > 0 {
+			c.MaxTransactionRetryTime = config.MaxTransactionRetryTime()
+		}
+		c.SocketKeepalive = config.SocketKeepalive()
+	}, nil
+}
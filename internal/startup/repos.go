@@ -0,0 +1,65 @@
+package startup
+
+import (
+	"log"
+
+	"github.com/c12s/oort/internal/configs"
+	"github.com/c12s/oort/internal/configs/server"
+	"github.com/c12s/oort/internal/domain"
+	"github.com/c12s/oort/internal/repos/rhabac/bolt"
+	"github.com/c12s/oort/internal/repos/rhabac/memory"
+	"github.com/c12s/oort/internal/repos/rhabac/neo4j"
+)
+
+// rhabacRepoFactory creates a repo together with the function that releases its resources
+type rhabacRepoFactory func(config configs.Config) (domain.RHABACRepo, func(), error)
+
+var rhabacRepoFactories = map[string]rhabacRepoFactory{
+	server.RepoNeo4j:  newRhabacNeo4jRepo,
+	server.RepoMemory: newRhabacMemoryRepo,
+	server.RepoBolt:   newRhabacBoltRepo,
+}
+
+func newRhabacNeo4jRepo(config configs.Config) (domain.RHABACRepo, func(), error) {
+	auth, err := newNeo4jAuth(config.Neo4j())
+	if err != nil {
+		return nil, nil, err
+	}
+	configurer, err := newNeo4jConfigurer(config.Neo4j())
+	if err != nil {
+		return nil, nil, err
+	}
+	manager, err := neo4j.NewTransactionManager(
+		config.Neo4j().Uri(),
+		config.Neo4j().DbName(),
+		auth,
+		configurer)
+	if err != nil {
+		return nil, nil, err
+	}
+	shutdown := func() {
+		log.Println("closing neo4j conn")
+		manager.Stop()
+	}
+	return neo4j.NewRHABACRepo(manager, neo4j.NewSimpleCypherFactory(config.Server().MaxInheritanceDepth())), shutdown, nil
+}
+
+func newRhabacMemoryRepo(config configs.Config) (domain.RHABACRepo, func(), error) {
+	log.Println("storing the resource graph in memory")
+	return memory.NewRHABACRepo(), func() {}, nil
+}
+
+func newRhabacBoltRepo(config configs.Config) (domain.RHABACRepo, func(), error) {
+	db, err := bolt.Open(config.Bolt().Path())
+	if err != nil {
+		return nil, nil, err
+	}
+	log.Printf("storing the resource graph in %s", config.Bolt().Path())
+	shutdown := func() {
+		log.Println("closing bolt db")
+		if err := db.Close(); err != nil {
+			log.Println(err)
+		}
+	}
+	return bolt.NewRHABACRepo(db), shutdown, nil
+}
@@ -9,6 +9,7 @@ package api
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -26,6 +27,8 @@ type CreateResourceReq struct {
 	unknownFields protoimpl.UnknownFields
 
 	Resource *Resource `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	// required by the attribute schema of the resource kind unless they have default values
+	Attributes []*Attribute `protobuf:"bytes,2,rep,name=attributes,proto3" json:"attributes,omitempty"`
 }
 
 func (x *CreateResourceReq) Reset() {
@@ -67,6 +70,13 @@ func (x *CreateResourceReq) GetResource() *Resource {
 	return nil
 }
 
+func (x *CreateResourceReq) GetAttributes() []*Attribute {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
 type DeleteResourceReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -114,13 +124,15 @@ func (x *DeleteResourceReq) GetResource() *Resource {
 	return nil
 }
 
+// to is related to from by a relation of the given type, INHERITS_FROM if it is empty
 type CreateInheritanceRelReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	From *Resource `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
-	To   *Resource `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	From         *Resource `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To           *Resource `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	RelationType string    `protobuf:"bytes,3,opt,name=relationType,proto3" json:"relationType,omitempty"`
 }
 
 func (x *CreateInheritanceRelReq) Reset() {
@@ -169,13 +181,21 @@ func (x *CreateInheritanceRelReq) GetTo() *Resource {
 	return nil
 }
 
+func (x *CreateInheritanceRelReq) GetRelationType() string {
+	if x != nil {
+		return x.RelationType
+	}
+	return ""
+}
+
 type DeleteInheritanceRelReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	From *Resource `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
-	To   *Resource `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	From         *Resource `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To           *Resource `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	RelationType string    `protobuf:"bytes,3,opt,name=relationType,proto3" json:"relationType,omitempty"`
 }
 
 func (x *DeleteInheritanceRelReq) Reset() {
@@ -224,6 +244,13 @@ func (x *DeleteInheritanceRelReq) GetTo() *Resource {
 	return nil
 }
 
+func (x *DeleteInheritanceRelReq) GetRelationType() string {
+	if x != nil {
+		return x.RelationType
+	}
+	return ""
+}
+
 type PutAttributeReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -342,6 +369,9 @@ type CreatePolicyReq struct {
 	SubjectScope *Resource   `protobuf:"bytes,1,opt,name=subjectScope,proto3" json:"subjectScope,omitempty"`
 	ObjectScope  *Resource   `protobuf:"bytes,2,opt,name=objectScope,proto3" json:"objectScope,omitempty"`
 	Permission   *Permission `protobuf:"bytes,3,opt,name=permission,proto3" json:"permission,omitempty"`
+	// unset bounds leave the policy active indefinitely in that direction
+	NotBefore *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=notBefore,proto3" json:"notBefore,omitempty"`
+	NotAfter  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=notAfter,proto3" json:"notAfter,omitempty"`
 }
 
 func (x *CreatePolicyReq) Reset() {
@@ -397,6 +427,20 @@ func (x *CreatePolicyReq) GetPermission() *Permission {
 	return nil
 }
 
+func (x *CreatePolicyReq) GetNotBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NotBefore
+	}
+	return nil
+}
+
+func (x *CreatePolicyReq) GetNotAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NotAfter
+	}
+	return nil
+}
+
 type DeletePolicyReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -460,14 +504,16 @@ func (x *DeletePolicyReq) GetPermission() *Permission {
 	return nil
 }
 
-type AdministrationResp struct {
+type PutAttributeSchemaReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Schema *AttributeSchema `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
 }
 
-func (x *AdministrationResp) Reset() {
-	*x = AdministrationResp{}
+func (x *PutAttributeSchemaReq) Reset() {
+	*x = PutAttributeSchemaReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_administrator_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -475,13 +521,13 @@ func (x *AdministrationResp) Reset() {
 	}
 }
 
-func (x *AdministrationResp) String() string {
+func (x *PutAttributeSchemaReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdministrationResp) ProtoMessage() {}
+func (*PutAttributeSchemaReq) ProtoMessage() {}
 
-func (x *AdministrationResp) ProtoReflect() protoreflect.Message {
+func (x *PutAttributeSchemaReq) ProtoReflect() protoreflect.Message {
 	mi := &file_administrator_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -493,243 +539,1789 @@ func (x *AdministrationResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdministrationResp.ProtoReflect.Descriptor instead.
-func (*AdministrationResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use PutAttributeSchemaReq.ProtoReflect.Descriptor instead.
+func (*PutAttributeSchemaReq) Descriptor() ([]byte, []int) {
 	return file_administrator_proto_rawDescGZIP(), []int{8}
 }
 
-var File_administrator_proto protoreflect.FileDescriptor
+func (x *PutAttributeSchemaReq) GetSchema() *AttributeSchema {
+	if x != nil {
+		return x.Schema
+	}
+	return nil
+}
 
-var file_administrator_proto_rawDesc = []byte{
-	0x0a, 0x13, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0b, 0x6d, 0x6f,
-	0x64, 0x65, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x40, 0x0a, 0x11, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x12, 0x2b,
-	0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x40, 0x0a, 0x11, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71,
-	0x12, 0x2b, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x5f, 0x0a,
-	0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x52, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x23, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x1f, 0x0a,
-	0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x02, 0x74, 0x6f, 0x22, 0x5f,
-	0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x52, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x23, 0x0a, 0x04, 0x66, 0x72, 0x6f,
-	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x1f,
-	0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x02, 0x74, 0x6f, 0x22,
-	0x6e, 0x0a, 0x0f, 0x50, 0x75, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52,
-	0x65, 0x71, 0x12, 0x2b, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
-	0x2e, 0x0a, 0x09, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69,
-	0x62, 0x75, 0x74, 0x65, 0x52, 0x09, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x22,
-	0x77, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
-	0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x2b, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x12, 0x34, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x49,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x49, 0x64, 0x52, 0x0b, 0x61, 0x74, 0x74,
-	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x49, 0x64, 0x22, 0xac, 0x01, 0x0a, 0x0f, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x12, 0x33, 0x0a, 0x0c,
-	0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x63, 0x6f, 0x70,
-	0x65, 0x12, 0x31, 0x0a, 0x0b, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x63, 0x6f, 0x70, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x0b, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53,
-	0x63, 0x6f, 0x70, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x65, 0x72,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xac, 0x01, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x12, 0x33, 0x0a, 0x0c, 0x73,
-	0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x63, 0x6f, 0x70, 0x65,
-	0x12, 0x31, 0x0a, 0x0b, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x0b, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x63,
-	0x6f, 0x70, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x14, 0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x69,
-	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x32, 0xe9, 0x04, 0x0a,
-	0x11, 0x4f, 0x6f, 0x72, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
-	0x6f, 0x72, 0x12, 0x47, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x19,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x18, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e,
-	0x68, 0x65, 0x72, 0x69, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x6c, 0x12, 0x1e, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x68, 0x65, 0x72,
-	0x69, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x14, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x49, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
-	0x6c, 0x12, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x49, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x6c, 0x52, 0x65,
-	0x71, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x69,
-	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x43,
-	0x0a, 0x0c, 0x50, 0x75, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x16,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x75, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
-	0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41,
-	0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x74, 0x74,
-	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x65,
-	0x71, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x69,
-	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x43,
-	0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x16,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c,
-	0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41,
-	0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x6c,
-	0x69, 0x63, 0x79, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x42, 0x1e, 0x5a, 0x1c, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x31, 0x32, 0x73, 0x2f, 0x6f, 0x6f, 0x72, 0x74,
-	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+type DeleteAttributeSchemaReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ResourceKind string `protobuf:"bytes,1,opt,name=resourceKind,proto3" json:"resourceKind,omitempty"`
 }
 
-var (
-	file_administrator_proto_rawDescOnce sync.Once
-	file_administrator_proto_rawDescData = file_administrator_proto_rawDesc
-)
+func (x *DeleteAttributeSchemaReq) Reset() {
+	*x = DeleteAttributeSchemaReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_administrator_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_administrator_proto_rawDescGZIP() []byte {
-	file_administrator_proto_rawDescOnce.Do(func() {
-		file_administrator_proto_rawDescData = protoimpl.X.CompressGZIP(file_administrator_proto_rawDescData)
-	})
-	return file_administrator_proto_rawDescData
+func (x *DeleteAttributeSchemaReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_administrator_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
-var file_administrator_proto_goTypes = []interface{}{
-	(*CreateResourceReq)(nil),       // 0: proto.CreateResourceReq
-	(*DeleteResourceReq)(nil),       // 1: proto.DeleteResourceReq
-	(*CreateInheritanceRelReq)(nil), // 2: proto.CreateInheritanceRelReq
-	(*DeleteInheritanceRelReq)(nil), // 3: proto.DeleteInheritanceRelReq
-	(*PutAttributeReq)(nil),         // 4: proto.PutAttributeReq
-	(*DeleteAttributeReq)(nil),      // 5: proto.DeleteAttributeReq
-	(*CreatePolicyReq)(nil),         // 6: proto.CreatePolicyReq
-	(*DeletePolicyReq)(nil),         // 7: proto.DeletePolicyReq
-	(*AdministrationResp)(nil),      // 8: proto.AdministrationResp
-	(*Resource)(nil),                // 9: proto.Resource
-	(*Attribute)(nil),               // 10: proto.Attribute
-	(*AttributeId)(nil),             // 11: proto.AttributeId
-	(*Permission)(nil),              // 12: proto.Permission
+func (*DeleteAttributeSchemaReq) ProtoMessage() {}
+
+func (x *DeleteAttributeSchemaReq) ProtoReflect() protoreflect.Message {
+	mi := &file_administrator_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_administrator_proto_depIdxs = []int32{
-	9,  // 0: proto.CreateResourceReq.resource:type_name -> proto.Resource
-	9,  // 1: proto.DeleteResourceReq.resource:type_name -> proto.Resource
-	9,  // 2: proto.CreateInheritanceRelReq.from:type_name -> proto.Resource
-	9,  // 3: proto.CreateInheritanceRelReq.to:type_name -> proto.Resource
-	9,  // 4: proto.DeleteInheritanceRelReq.from:type_name -> proto.Resource
-	9,  // 5: proto.DeleteInheritanceRelReq.to:type_name -> proto.Resource
-	9,  // 6: proto.PutAttributeReq.resource:type_name -> proto.Resource
-	10, // 7: proto.PutAttributeReq.attribute:type_name -> proto.Attribute
-	9,  // 8: proto.DeleteAttributeReq.resource:type_name -> proto.Resource
-	11, // 9: proto.DeleteAttributeReq.attributeId:type_name -> proto.AttributeId
-	9,  // 10: proto.CreatePolicyReq.subjectScope:type_name -> proto.Resource
-	9,  // 11: proto.CreatePolicyReq.objectScope:type_name -> proto.Resource
-	12, // 12: proto.CreatePolicyReq.permission:type_name -> proto.Permission
-	9,  // 13: proto.DeletePolicyReq.subjectScope:type_name -> proto.Resource
-	9,  // 14: proto.DeletePolicyReq.objectScope:type_name -> proto.Resource
-	12, // 15: proto.DeletePolicyReq.permission:type_name -> proto.Permission
-	0,  // 16: proto.OortAdministrator.CreateResource:input_type -> proto.CreateResourceReq
-	1,  // 17: proto.OortAdministrator.DeleteResource:input_type -> proto.DeleteResourceReq
-	2,  // 18: proto.OortAdministrator.CreateInheritanceRel:input_type -> proto.CreateInheritanceRelReq
-	3,  // 19: proto.OortAdministrator.DeleteInheritanceRel:input_type -> proto.DeleteInheritanceRelReq
-	4,  // 20: proto.OortAdministrator.PutAttribute:input_type -> proto.PutAttributeReq
-	5,  // 21: proto.OortAdministrator.DeleteAttribute:input_type -> proto.DeleteAttributeReq
-	6,  // 22: proto.OortAdministrator.CreatePolicy:input_type -> proto.CreatePolicyReq
-	7,  // 23: proto.OortAdministrator.DeletePolicy:input_type -> proto.DeletePolicyReq
-	8,  // 24: proto.OortAdministrator.CreateResource:output_type -> proto.AdministrationResp
-	8,  // 25: proto.OortAdministrator.DeleteResource:output_type -> proto.AdministrationResp
-	8,  // 26: proto.OortAdministrator.CreateInheritanceRel:output_type -> proto.AdministrationResp
-	8,  // 27: proto.OortAdministrator.DeleteInheritanceRel:output_type -> proto.AdministrationResp
-	8,  // 28: proto.OortAdministrator.PutAttribute:output_type -> proto.AdministrationResp
-	8,  // 29: proto.OortAdministrator.DeleteAttribute:output_type -> proto.AdministrationResp
-	8,  // 30: proto.OortAdministrator.CreatePolicy:output_type -> proto.AdministrationResp
-	8,  // 31: proto.OortAdministrator.DeletePolicy:output_type -> proto.AdministrationResp
-	24, // [24:32] is the sub-list for method output_type
-	16, // [16:24] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+
+// Deprecated: Use DeleteAttributeSchemaReq.ProtoReflect.Descriptor instead.
+func (*DeleteAttributeSchemaReq) Descriptor() ([]byte, []int) {
+	return file_administrator_proto_rawDescGZIP(), []int{9}
 }
 
-func init() { file_administrator_proto_init() }
-func file_administrator_proto_init() {
-	if File_administrator_proto != nil {
-		return
+func (x *DeleteAttributeSchemaReq) GetResourceKind() string {
+	if x != nil {
+		return x.ResourceKind
 	}
-	file_model_proto_init()
-	if !protoimpl.UnsafeEnabled {
-		file_administrator_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateResourceReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	return ""
+}
+
+type GetAttributeSchemaReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ResourceKind string `protobuf:"bytes,1,opt,name=resourceKind,proto3" json:"resourceKind,omitempty"`
+}
+
+func (x *GetAttributeSchemaReq) Reset() {
+	*x = GetAttributeSchemaReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_administrator_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAttributeSchemaReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttributeSchemaReq) ProtoMessage() {}
+
+func (x *GetAttributeSchemaReq) ProtoReflect() protoreflect.Message {
+	mi := &file_administrator_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_administrator_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteResourceReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttributeSchemaReq.ProtoReflect.Descriptor instead.
+func (*GetAttributeSchemaReq) Descriptor() ([]byte, []int) {
+	return file_administrator_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetAttributeSchemaReq) GetResourceKind() string {
+	if x != nil {
+		return x.ResourceKind
+	}
+	return ""
+}
+
+type GetAttributeSchemaResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Schema *AttributeSchema `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
+}
+
+func (x *GetAttributeSchemaResp) Reset() {
+	*x = GetAttributeSchemaResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_administrator_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAttributeSchemaResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttributeSchemaResp) ProtoMessage() {}
+
+func (x *GetAttributeSchemaResp) ProtoReflect() protoreflect.Message {
+	mi := &file_administrator_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_administrator_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateInheritanceRelReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttributeSchemaResp.ProtoReflect.Descriptor instead.
+func (*GetAttributeSchemaResp) Descriptor() ([]byte, []int) {
+	return file_administrator_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetAttributeSchemaResp) GetSchema() *AttributeSchema {
+	if x != nil {
+		return x.Schema
+	}
+	return nil
+}
+
+type SetCombiningAlgorithmReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PermissionName string             `protobuf:"bytes,1,opt,name=permissionName,proto3" json:"permissionName,omitempty"`
+	Algorithm      CombiningAlgorithm `protobuf:"varint,2,opt,name=algorithm,proto3,enum=proto.CombiningAlgorithm" json:"algorithm,omitempty"`
+}
+
+func (x *SetCombiningAlgorithmReq) Reset() {
+	*x = SetCombiningAlgorithmReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_administrator_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetCombiningAlgorithmReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCombiningAlgorithmReq) ProtoMessage() {}
+
+func (x *SetCombiningAlgorithmReq) ProtoReflect() protoreflect.Message {
+	mi := &file_administrator_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_administrator_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteInheritanceRelReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCombiningAlgorithmReq.ProtoReflect.Descriptor instead.
+func (*SetCombiningAlgorithmReq) Descriptor() ([]byte, []int) {
+	return file_administrator_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SetCombiningAlgorithmReq) GetPermissionName() string {
+	if x != nil {
+		return x.PermissionName
+	}
+	return ""
+}
+
+func (x *SetCombiningAlgorithmReq) GetAlgorithm() CombiningAlgorithm {
+	if x != nil {
+		return x.Algorithm
+	}
+	return CombiningAlgorithm_DENY_OVERRIDES
+}
+
+type CreatePermissionImplicationReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PermissionName        string `protobuf:"bytes,1,opt,name=permissionName,proto3" json:"permissionName,omitempty"`
+	ImpliedPermissionName string `protobuf:"bytes,2,opt,name=impliedPermissionName,proto3" json:"impliedPermissionName,omitempty"`
+}
+
+func (x *CreatePermissionImplicationReq) Reset() {
+	*x = CreatePermissionImplicationReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_administrator_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePermissionImplicationReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePermissionImplicationReq) ProtoMessage() {}
+
+func (x *CreatePermissionImplicationReq) ProtoReflect() protoreflect.Message {
+	mi := &file_administrator_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_administrator_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PutAttributeReq); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePermissionImplicationReq.ProtoReflect.Descriptor instead.
+func (*CreatePermissionImplicationReq) Descriptor() ([]byte, []int) {
+	return file_administrator_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CreatePermissionImplicationReq) GetPermissionName() string {
+	if x != nil {
+		return x.PermissionName
+	}
+	return ""
+}
+
+func (x *CreatePermissionImplicationReq) GetImpliedPermissionName() string {
+	if x != nil {
+		return x.ImpliedPermissionName
+	}
+	return ""
+}
+
+type DeletePermissionImplicationReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PermissionName        string `protobuf:"bytes,1,opt,name=permissionName,proto3" json:"permissionName,omitempty"`
+	ImpliedPermissionName string `protobuf:"bytes,2,opt,name=impliedPermissionName,proto3" json:"impliedPermissionName,omitempty"`
+}
+
+func (x *DeletePermissionImplicationReq) Reset() {
+	*x = DeletePermissionImplicationReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_administrator_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePermissionImplicationReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePermissionImplicationReq) ProtoMessage() {}
+
+func (x *DeletePermissionImplicationReq) ProtoReflect() protoreflect.Message {
+	mi := &file_administrator_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePermissionImplicationReq.ProtoReflect.Descriptor instead.
+func (*DeletePermissionImplicationReq) Descriptor() ([]byte, []int) {
+	return file_administrator_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DeletePermissionImplicationReq) GetPermissionName() string {
+	if x != nil {
+		return x.PermissionName
+	}
+	return ""
+}
+
+func (x *DeletePermissionImplicationReq) GetImpliedPermissionName() string {
+	if x != nil {
+		return x.ImpliedPermissionName
+	}
+	return ""
+}
+
+type CreateSoDConstraintReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Constraint *SoDConstraint `protobuf:"bytes,1,opt,name=constraint,proto3" json:"constraint,omitempty"`
+}
+
+func (x *CreateSoDConstraintReq) Reset() {
+	*x = CreateSoDConstraintReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_administrator_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateSoDConstraintReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSoDConstraintReq) ProtoMessage() {}
+
+func (x *CreateSoDConstraintReq) ProtoReflect() protoreflect.Message {
+	mi := &file_administrator_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSoDConstraintReq.ProtoReflect.Descriptor instead.
+func (*CreateSoDConstraintReq) Descriptor() ([]byte, []int) {
+	return file_administrator_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CreateSoDConstraintReq) GetConstraint() *SoDConstraint {
+	if x != nil {
+		return x.Constraint
+	}
+	return nil
+}
+
+type DeleteSoDConstraintReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *DeleteSoDConstraintReq) Reset() {
+	*x = DeleteSoDConstraintReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_administrator_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteSoDConstraintReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSoDConstraintReq) ProtoMessage() {}
+
+func (x *DeleteSoDConstraintReq) ProtoReflect() protoreflect.Message {
+	mi := &file_administrator_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSoDConstraintReq.ProtoReflect.Descriptor instead.
+func (*DeleteSoDConstraintReq) Descriptor() ([]byte, []int) {
+	return file_administrator_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DeleteSoDConstraintReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetInheritanceCyclesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetInheritanceCyclesReq) Reset() {
+	*x = GetInheritanceCyclesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_administrator_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInheritanceCyclesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInheritanceCyclesReq) ProtoMessage() {}
+
+func (x *GetInheritanceCyclesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_administrator_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInheritanceCyclesReq.ProtoReflect.Descriptor instead.
+func (*GetInheritanceCyclesReq) Descriptor() ([]byte, []int) {
+	return file_administrator_proto_rawDescGZIP(), []int{17}
+}
+
+type InheritanceCycle struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// each resource inherits from the next one, the last one inherits from the first one
+	Resources []*Resource `protobuf:"bytes,1,rep,name=resources,proto3" json:"resources,omitempty"`
+}
+
+func (x *InheritanceCycle) Reset() {
+	*x = InheritanceCycle{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_administrator_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InheritanceCycle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InheritanceCycle) ProtoMessage() {}
+
+func (x *InheritanceCycle) ProtoReflect() protoreflect.Message {
+	mi := &file_administrator_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InheritanceCycle.ProtoReflect.Descriptor instead.
+func (*InheritanceCycle) Descriptor() ([]byte, []int) {
+	return file_administrator_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *InheritanceCycle) GetResources() []*Resource {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+type GetInheritanceCyclesResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cycles []*InheritanceCycle `protobuf:"bytes,1,rep,name=cycles,proto3" json:"cycles,omitempty"`
+}
+
+func (x *GetInheritanceCyclesResp) Reset() {
+	*x = GetInheritanceCyclesResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_administrator_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInheritanceCyclesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInheritanceCyclesResp) ProtoMessage() {}
+
+func (x *GetInheritanceCyclesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_administrator_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInheritanceCyclesResp.ProtoReflect.Descriptor instead.
+func (*GetInheritanceCyclesResp) Descriptor() ([]byte, []int) {
+	return file_administrator_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetInheritanceCyclesResp) GetCycles() []*InheritanceCycle {
+	if x != nil {
+		return x.Cycles
+	}
+	return nil
+}
+
+type PutRelationTypeReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RelationType *RelationType `protobuf:"bytes,1,opt,name=relationType,proto3" json:"relationType,omitempty"`
+}
+
+func (x *PutRelationTypeReq) Reset() {
+	*x = PutRelationTypeReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_administrator_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PutRelationTypeReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutRelationTypeReq) ProtoMessage() {}
+
+func (x *PutRelationTypeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_administrator_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutRelationTypeReq.ProtoReflect.Descriptor instead.
+func (*PutRelationTypeReq) Descriptor() ([]byte, []int) {
+	return file_administrator_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *PutRelationTypeReq) GetRelationType() *RelationType {
+	if x != nil {
+		return x.RelationType
+	}
+	return nil
+}
+
+type DeleteRelationTypeReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *DeleteRelationTypeReq) Reset() {
+	*x = DeleteRelationTypeReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_administrator_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteRelationTypeReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRelationTypeReq) ProtoMessage() {}
+
+func (x *DeleteRelationTypeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_administrator_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRelationTypeReq.ProtoReflect.Descriptor instead.
+func (*DeleteRelationTypeReq) Descriptor() ([]byte, []int) {
+	return file_administrator_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DeleteRelationTypeReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetRelationTypesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetRelationTypesReq) Reset() {
+	*x = GetRelationTypesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_administrator_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRelationTypesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRelationTypesReq) ProtoMessage() {}
+
+func (x *GetRelationTypesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_administrator_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRelationTypesReq.ProtoReflect.Descriptor instead.
+func (*GetRelationTypesReq) Descriptor() ([]byte, []int) {
+	return file_administrator_proto_rawDescGZIP(), []int{22}
+}
+
+type GetRelationTypesResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RelationTypes []*RelationType `protobuf:"bytes,1,rep,name=relationTypes,proto3" json:"relationTypes,omitempty"`
+}
+
+func (x *GetRelationTypesResp) Reset() {
+	*x = GetRelationTypesResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_administrator_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRelationTypesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRelationTypesResp) ProtoMessage() {}
+
+func (x *GetRelationTypesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_administrator_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRelationTypesResp.ProtoReflect.Descriptor instead.
+func (*GetRelationTypesResp) Descriptor() ([]byte, []int) {
+	return file_administrator_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetRelationTypesResp) GetRelationTypes() []*RelationType {
+	if x != nil {
+		return x.RelationTypes
+	}
+	return nil
+}
+
+// BatchOp is one operation of a batch, exactly one request is set
+type BatchOp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Op:
+	//	*BatchOp_CreateResource
+	//	*BatchOp_DeleteResource
+	//	*BatchOp_PutAttribute
+	//	*BatchOp_DeleteAttribute
+	//	*BatchOp_CreateInheritanceRel
+	//	*BatchOp_DeleteInheritanceRel
+	//	*BatchOp_CreatePolicy
+	//	*BatchOp_DeletePolicy
+	//	*BatchOp_PutAttributeSchema
+	//	*BatchOp_DeleteAttributeSchema
+	//	*BatchOp_SetCombiningAlgorithm
+	//	*BatchOp_CreatePermissionImplication
+	//	*BatchOp_DeletePermissionImplication
+	//	*BatchOp_CreateSoDConstraint
+	//	*BatchOp_DeleteSoDConstraint
+	//	*BatchOp_PutRelationType
+	//	*BatchOp_DeleteRelationType
+	Op isBatchOp_Op `protobuf_oneof:"op"`
+}
+
+func (x *BatchOp) Reset() {
+	*x = BatchOp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_administrator_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchOp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchOp) ProtoMessage() {}
+
+func (x *BatchOp) ProtoReflect() protoreflect.Message {
+	mi := &file_administrator_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchOp.ProtoReflect.Descriptor instead.
+func (*BatchOp) Descriptor() ([]byte, []int) {
+	return file_administrator_proto_rawDescGZIP(), []int{24}
+}
+
+func (m *BatchOp) GetOp() isBatchOp_Op {
+	if m != nil {
+		return m.Op
+	}
+	return nil
+}
+
+func (x *BatchOp) GetCreateResource() *CreateResourceReq {
+	if x, ok := x.GetOp().(*BatchOp_CreateResource); ok {
+		return x.CreateResource
+	}
+	return nil
+}
+
+func (x *BatchOp) GetDeleteResource() *DeleteResourceReq {
+	if x, ok := x.GetOp().(*BatchOp_DeleteResource); ok {
+		return x.DeleteResource
+	}
+	return nil
+}
+
+func (x *BatchOp) GetPutAttribute() *PutAttributeReq {
+	if x, ok := x.GetOp().(*BatchOp_PutAttribute); ok {
+		return x.PutAttribute
+	}
+	return nil
+}
+
+func (x *BatchOp) GetDeleteAttribute() *DeleteAttributeReq {
+	if x, ok := x.GetOp().(*BatchOp_DeleteAttribute); ok {
+		return x.DeleteAttribute
+	}
+	return nil
+}
+
+func (x *BatchOp) GetCreateInheritanceRel() *CreateInheritanceRelReq {
+	if x, ok := x.GetOp().(*BatchOp_CreateInheritanceRel); ok {
+		return x.CreateInheritanceRel
+	}
+	return nil
+}
+
+func (x *BatchOp) GetDeleteInheritanceRel() *DeleteInheritanceRelReq {
+	if x, ok := x.GetOp().(*BatchOp_DeleteInheritanceRel); ok {
+		return x.DeleteInheritanceRel
+	}
+	return nil
+}
+
+func (x *BatchOp) GetCreatePolicy() *CreatePolicyReq {
+	if x, ok := x.GetOp().(*BatchOp_CreatePolicy); ok {
+		return x.CreatePolicy
+	}
+	return nil
+}
+
+func (x *BatchOp) GetDeletePolicy() *DeletePolicyReq {
+	if x, ok := x.GetOp().(*BatchOp_DeletePolicy); ok {
+		return x.DeletePolicy
+	}
+	return nil
+}
+
+func (x *BatchOp) GetPutAttributeSchema() *PutAttributeSchemaReq {
+	if x, ok := x.GetOp().(*BatchOp_PutAttributeSchema); ok {
+		return x.PutAttributeSchema
+	}
+	return nil
+}
+
+func (x *BatchOp) GetDeleteAttributeSchema() *DeleteAttributeSchemaReq {
+	if x, ok := x.GetOp().(*BatchOp_DeleteAttributeSchema); ok {
+		return x.DeleteAttributeSchema
+	}
+	return nil
+}
+
+func (x *BatchOp) GetSetCombiningAlgorithm() *SetCombiningAlgorithmReq {
+	if x, ok := x.GetOp().(*BatchOp_SetCombiningAlgorithm); ok {
+		return x.SetCombiningAlgorithm
+	}
+	return nil
+}
+
+func (x *BatchOp) GetCreatePermissionImplication() *CreatePermissionImplicationReq {
+	if x, ok := x.GetOp().(*BatchOp_CreatePermissionImplication); ok {
+		return x.CreatePermissionImplication
+	}
+	return nil
+}
+
+func (x *BatchOp) GetDeletePermissionImplication() *DeletePermissionImplicationReq {
+	if x, ok := x.GetOp().(*BatchOp_DeletePermissionImplication); ok {
+		return x.DeletePermissionImplication
+	}
+	return nil
+}
+
+func (x *BatchOp) GetCreateSoDConstraint() *CreateSoDConstraintReq {
+	if x, ok := x.GetOp().(*BatchOp_CreateSoDConstraint); ok {
+		return x.CreateSoDConstraint
+	}
+	return nil
+}
+
+func (x *BatchOp) GetDeleteSoDConstraint() *DeleteSoDConstraintReq {
+	if x, ok := x.GetOp().(*BatchOp_DeleteSoDConstraint); ok {
+		return x.DeleteSoDConstraint
+	}
+	return nil
+}
+
+func (x *BatchOp) GetPutRelationType() *PutRelationTypeReq {
+	if x, ok := x.GetOp().(*BatchOp_PutRelationType); ok {
+		return x.PutRelationType
+	}
+	return nil
+}
+
+func (x *BatchOp) GetDeleteRelationType() *DeleteRelationTypeReq {
+	if x, ok := x.GetOp().(*BatchOp_DeleteRelationType); ok {
+		return x.DeleteRelationType
+	}
+	return nil
+}
+
+type isBatchOp_Op interface {
+	isBatchOp_Op()
+}
+
+type BatchOp_CreateResource struct {
+	CreateResource *CreateResourceReq `protobuf:"bytes,1,opt,name=createResource,proto3,oneof"`
+}
+
+type BatchOp_DeleteResource struct {
+	DeleteResource *DeleteResourceReq `protobuf:"bytes,2,opt,name=deleteResource,proto3,oneof"`
+}
+
+type BatchOp_PutAttribute struct {
+	PutAttribute *PutAttributeReq `protobuf:"bytes,3,opt,name=putAttribute,proto3,oneof"`
+}
+
+type BatchOp_DeleteAttribute struct {
+	DeleteAttribute *DeleteAttributeReq `protobuf:"bytes,4,opt,name=deleteAttribute,proto3,oneof"`
+}
+
+type BatchOp_CreateInheritanceRel struct {
+	CreateInheritanceRel *CreateInheritanceRelReq `protobuf:"bytes,5,opt,name=createInheritanceRel,proto3,oneof"`
+}
+
+type BatchOp_DeleteInheritanceRel struct {
+	DeleteInheritanceRel *DeleteInheritanceRelReq `protobuf:"bytes,6,opt,name=deleteInheritanceRel,proto3,oneof"`
+}
+
+type BatchOp_CreatePolicy struct {
+	CreatePolicy *CreatePolicyReq `protobuf:"bytes,7,opt,name=createPolicy,proto3,oneof"`
+}
+
+type BatchOp_DeletePolicy struct {
+	DeletePolicy *DeletePolicyReq `protobuf:"bytes,8,opt,name=deletePolicy,proto3,oneof"`
+}
+
+type BatchOp_PutAttributeSchema struct {
+	PutAttributeSchema *PutAttributeSchemaReq `protobuf:"bytes,9,opt,name=putAttributeSchema,proto3,oneof"`
+}
+
+type BatchOp_DeleteAttributeSchema struct {
+	DeleteAttributeSchema *DeleteAttributeSchemaReq `protobuf:"bytes,10,opt,name=deleteAttributeSchema,proto3,oneof"`
+}
+
+type BatchOp_SetCombiningAlgorithm struct {
+	SetCombiningAlgorithm *SetCombiningAlgorithmReq `protobuf:"bytes,11,opt,name=setCombiningAlgorithm,proto3,oneof"`
+}
+
+type BatchOp_CreatePermissionImplication struct {
+	CreatePermissionImplication *CreatePermissionImplicationReq `protobuf:"bytes,12,opt,name=createPermissionImplication,proto3,oneof"`
+}
+
+type BatchOp_DeletePermissionImplication struct {
+	DeletePermissionImplication *DeletePermissionImplicationReq `protobuf:"bytes,13,opt,name=deletePermissionImplication,proto3,oneof"`
+}
+
+type BatchOp_CreateSoDConstraint struct {
+	CreateSoDConstraint *CreateSoDConstraintReq `protobuf:"bytes,14,opt,name=createSoDConstraint,proto3,oneof"`
+}
+
+type BatchOp_DeleteSoDConstraint struct {
+	DeleteSoDConstraint *DeleteSoDConstraintReq `protobuf:"bytes,15,opt,name=deleteSoDConstraint,proto3,oneof"`
+}
+
+type BatchOp_PutRelationType struct {
+	PutRelationType *PutRelationTypeReq `protobuf:"bytes,16,opt,name=putRelationType,proto3,oneof"`
+}
+
+type BatchOp_DeleteRelationType struct {
+	DeleteRelationType *DeleteRelationTypeReq `protobuf:"bytes,17,opt,name=deleteRelationType,proto3,oneof"`
+}
+
+func (*BatchOp_CreateResource) isBatchOp_Op() {}
+
+func (*BatchOp_DeleteResource) isBatchOp_Op() {}
+
+func (*BatchOp_PutAttribute) isBatchOp_Op() {}
+
+func (*BatchOp_DeleteAttribute) isBatchOp_Op() {}
+
+func (*BatchOp_CreateInheritanceRel) isBatchOp_Op() {}
+
+func (*BatchOp_DeleteInheritanceRel) isBatchOp_Op() {}
+
+func (*BatchOp_CreatePolicy) isBatchOp_Op() {}
+
+func (*BatchOp_DeletePolicy) isBatchOp_Op() {}
+
+func (*BatchOp_PutAttributeSchema) isBatchOp_Op() {}
+
+func (*BatchOp_DeleteAttributeSchema) isBatchOp_Op() {}
+
+func (*BatchOp_SetCombiningAlgorithm) isBatchOp_Op() {}
+
+func (*BatchOp_CreatePermissionImplication) isBatchOp_Op() {}
+
+func (*BatchOp_DeletePermissionImplication) isBatchOp_Op() {}
+
+func (*BatchOp_CreateSoDConstraint) isBatchOp_Op() {}
+
+func (*BatchOp_DeleteSoDConstraint) isBatchOp_Op() {}
+
+func (*BatchOp_PutRelationType) isBatchOp_Op() {}
+
+func (*BatchOp_DeleteRelationType) isBatchOp_Op() {}
+
+// the operations are applied in order, either all of them or none
+type BatchReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ops []*BatchOp `protobuf:"bytes,1,rep,name=ops,proto3" json:"ops,omitempty"`
+}
+
+func (x *BatchReq) Reset() {
+	*x = BatchReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_administrator_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchReq) ProtoMessage() {}
+
+func (x *BatchReq) ProtoReflect() protoreflect.Message {
+	mi := &file_administrator_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchReq.ProtoReflect.Descriptor instead.
+func (*BatchReq) Descriptor() ([]byte, []int) {
+	return file_administrator_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *BatchReq) GetOps() []*BatchOp {
+	if x != nil {
+		return x.Ops
+	}
+	return nil
+}
+
+type BatchOpResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// empty if the operation was applied
+	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	// gRPC status code of the error, ABORTED if the operation was rolled back because another one failed
+	Code uint32 `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *BatchOpResult) Reset() {
+	*x = BatchOpResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_administrator_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchOpResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchOpResult) ProtoMessage() {}
+
+func (x *BatchOpResult) ProtoReflect() protoreflect.Message {
+	mi := &file_administrator_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchOpResult.ProtoReflect.Descriptor instead.
+func (*BatchOpResult) Descriptor() ([]byte, []int) {
+	return file_administrator_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *BatchOpResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *BatchOpResult) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+type BatchResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// one result per operation, in the order of the request
+	Results []*BatchOpResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Applied bool             `protobuf:"varint,2,opt,name=applied,proto3" json:"applied,omitempty"`
+}
+
+func (x *BatchResp) Reset() {
+	*x = BatchResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_administrator_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchResp) ProtoMessage() {}
+
+func (x *BatchResp) ProtoReflect() protoreflect.Message {
+	mi := &file_administrator_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchResp.ProtoReflect.Descriptor instead.
+func (*BatchResp) Descriptor() ([]byte, []int) {
+	return file_administrator_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *BatchResp) GetResults() []*BatchOpResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BatchResp) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+type AdministrationResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdministrationResp) Reset() {
+	*x = AdministrationResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_administrator_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdministrationResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdministrationResp) ProtoMessage() {}
+
+func (x *AdministrationResp) ProtoReflect() protoreflect.Message {
+	mi := &file_administrator_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdministrationResp.ProtoReflect.Descriptor instead.
+func (*AdministrationResp) Descriptor() ([]byte, []int) {
+	return file_administrator_proto_rawDescGZIP(), []int{28}
+}
+
+var File_administrator_proto protoreflect.FileDescriptor
+
+var file_administrator_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0b, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x72, 0x0a, 0x11, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x12,
+	0x2b, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x30, 0x0a, 0x0a,
+	0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x22, 0x40,
+	0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x12, 0x2b, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x22, 0x83, 0x01, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x68, 0x65, 0x72,
+	0x69, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x23, 0x0a, 0x04,
+	0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x12, 0x1f, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x02,
+	0x74, 0x6f, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x22, 0x83, 0x01, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x49, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x6c, 0x52,
+	0x65, 0x71, 0x12, 0x23, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x1f, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x22, 0x6e, 0x0a, 0x0f,
+	0x50, 0x75, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12,
+	0x2b, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x2e, 0x0a, 0x09,
+	0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x52, 0x09, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x22, 0x77, 0x0a, 0x12,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x12, 0x2b, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
+	0x34, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x49, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x49, 0x64, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x49, 0x64, 0x22, 0x9e, 0x02, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x12, 0x33, 0x0a, 0x0c, 0x73, 0x75, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x52, 0x0c, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x31,
+	0x0a, 0x0b, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x52, 0x0b, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x63, 0x6f, 0x70,
+	0x65, 0x12, 0x31, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x09, 0x6e, 0x6f, 0x74, 0x42, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x6e, 0x6f, 0x74, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x36,
+	0x0a, 0x08, 0x6e, 0x6f, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x6e, 0x6f,
+	0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x22, 0xac, 0x01, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x12, 0x33, 0x0a, 0x0c, 0x73, 0x75,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12,
+	0x31, 0x0a, 0x0b, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x0b, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x63, 0x6f,
+	0x70, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x47, 0x0a, 0x15, 0x50, 0x75, 0x74, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x12, 0x2e,
+	0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x22, 0x3e,
+	0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x22, 0x3b,
+	0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x53, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x22, 0x48, 0x0a, 0x16, 0x47,
+	0x65, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x06, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x22, 0x7b, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x62,
+	0x69, 0x6e, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x52, 0x65,
+	0x71, 0x12, 0x26, 0x0a, 0x0e, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x65, 0x72, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x09, 0x61, 0x6c, 0x67,
+	0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x41, 0x6c,
+	0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x52, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74,
+	0x68, 0x6d, 0x22, 0x7e, 0x0a, 0x1e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x12, 0x26, 0x0a, 0x0e, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x15,
+	0x69, 0x6d, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x69, 0x6d, 0x70,
+	0x6c, 0x69, 0x65, 0x64, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x61,
+	0x6d, 0x65, 0x22, 0x7e, 0x0a, 0x1e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x65, 0x72, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x12, 0x26, 0x0a, 0x0e, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x15,
+	0x69, 0x6d, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x69, 0x6d, 0x70,
+	0x6c, 0x69, 0x65, 0x64, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x61,
+	0x6d, 0x65, 0x22, 0x4e, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6f, 0x44, 0x43,
+	0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x34, 0x0a, 0x0a,
+	0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x6f, 0x44, 0x43, 0x6f, 0x6e, 0x73,
+	0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69,
+	0x6e, 0x74, 0x22, 0x2c, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6f, 0x44, 0x43,
+	0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x22, 0x19, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x22, 0x41, 0x0a, 0x10, 0x49,
+	0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x12,
+	0x2d, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x22, 0x4b,
+	0x0a, 0x18, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x43, 0x79, 0x63, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2f, 0x0a, 0x06, 0x63, 0x79,
+	0x63, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x49, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x79,
+	0x63, 0x6c, 0x65, 0x52, 0x06, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x73, 0x22, 0x4d, 0x0a, 0x12, 0x50,
+	0x75, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65,
+	0x71, 0x12, 0x37, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x72, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x22, 0x2b, 0x0a, 0x15, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x15, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x71, 0x22, 0x51,
+	0x0a, 0x14, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x39, 0x0a, 0x0d, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x0d, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x73, 0x22, 0xd9, 0x0a, 0x0a, 0x07, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x70, 0x12, 0x42, 0x0a,
+	0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x48,
+	0x00, 0x52, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x12, 0x42, 0x0a, 0x0e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x48, 0x00, 0x52, 0x0e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x3c, 0x0a, 0x0c, 0x70, 0x75, 0x74, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x75, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x48, 0x00, 0x52, 0x0c, 0x70, 0x75, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x12, 0x45, 0x0a, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x48, 0x00, 0x52, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x54, 0x0a, 0x14, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x52, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x48, 0x00, 0x52, 0x14, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x49, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x6c,
+	0x12, 0x54, 0x0a, 0x14, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x68, 0x65, 0x72, 0x69,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x68,
+	0x65, 0x72, 0x69, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x48, 0x00,
+	0x52, 0x14, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x6c, 0x12, 0x3c, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x65, 0x71, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x12, 0x3c, 0x0a, 0x0c, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52,
+	0x65, 0x71, 0x48, 0x00, 0x52, 0x0c, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x12, 0x4e, 0x0a, 0x12, 0x70, 0x75, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x75, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x48, 0x00, 0x52, 0x12,
+	0x70, 0x75, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x12, 0x57, 0x0a, 0x15, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52,
+	0x65, 0x71, 0x48, 0x00, 0x52, 0x15, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x57, 0x0a, 0x15, 0x73,
+	0x65, 0x74, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x67, 0x6f, 0x72,
+	0x69, 0x74, 0x68, 0x6d, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x41,
+	0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x52, 0x65, 0x71, 0x48, 0x00, 0x52, 0x15, 0x73,
+	0x65, 0x74, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x67, 0x6f, 0x72,
+	0x69, 0x74, 0x68, 0x6d, 0x12, 0x69, 0x0a, 0x1b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x48, 0x00, 0x52, 0x1b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x69, 0x0a, 0x1b, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x48, 0x00, 0x52, 0x1b, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x6d, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x51, 0x0a, 0x13, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x53, 0x6f, 0x44, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6f, 0x44, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61,
+	0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x48, 0x00, 0x52, 0x13, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x53, 0x6f, 0x44, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x12, 0x51, 0x0a,
+	0x13, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6f, 0x44, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72,
+	0x61, 0x69, 0x6e, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6f, 0x44, 0x43, 0x6f, 0x6e, 0x73,
+	0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x48, 0x00, 0x52, 0x13, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x53, 0x6f, 0x44, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74,
+	0x12, 0x45, 0x0a, 0x0f, 0x70, 0x75, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x79, 0x70, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x50, 0x75, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x65, 0x71, 0x48, 0x00, 0x52, 0x0f, 0x70, 0x75, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x4e, 0x0a, 0x12, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65,
+	0x71, 0x48, 0x00, 0x52, 0x12, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x42, 0x04, 0x0a, 0x02, 0x6f, 0x70, 0x22, 0x2c, 0x0a,
+	0x08, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x12, 0x20, 0x0a, 0x03, 0x6f, 0x70, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x4f, 0x70, 0x52, 0x03, 0x6f, 0x70, 0x73, 0x22, 0x39, 0x0a, 0x0d, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x4f, 0x70, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x55, 0x0a, 0x09, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x2e, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x4f, 0x70, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x22, 0x14, 0x0a,
+	0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x32, 0x9d, 0x0d, 0x0a, 0x11, 0x4f, 0x6f, 0x72, 0x74, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x47, 0x0a, 0x0e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x18, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x22, 0x00, 0x12, 0x47, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x19,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x14, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x6c, 0x12, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x49, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x6c,
+	0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00,
+	0x12, 0x53, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x68, 0x65, 0x72, 0x69,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x6c, 0x12, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x52, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0c, 0x50, 0x75, 0x74, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x75,
+	0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0f, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x19, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0c, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52,
+	0x65, 0x71, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12,
+	0x4f, 0x0a, 0x12, 0x50, 0x75, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x53,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x75,
+	0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00,
+	0x12, 0x55, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x1c, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x15,
+	0x53, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x67, 0x6f,
+	0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x65,
+	0x74, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69,
+	0x74, 0x68, 0x6d, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x1b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6d,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x13, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x53, 0x6f, 0x44, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74,
+	0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
+	0x6f, 0x44, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a,
+	0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x13,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6f, 0x44, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61,
+	0x69, 0x6e, 0x74, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x53, 0x6f, 0x44, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12,
+	0x59, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x73, 0x12, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x47, 0x65, 0x74, 0x49, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x79,
+	0x63, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x47, 0x65, 0x74, 0x49, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x79,
+	0x63, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0f, 0x50, 0x75,
+	0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x75, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x2c, 0x0a, 0x05, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x0f,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a,
+	0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73,
+	0x70, 0x22, 0x00, 0x42, 0x1e, 0x5a, 0x1c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x63, 0x31, 0x32, 0x73, 0x2f, 0x6f, 0x6f, 0x72, 0x74, 0x2f, 0x70, 0x6b, 0x67, 0x2f,
+	0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_administrator_proto_rawDescOnce sync.Once
+	file_administrator_proto_rawDescData = file_administrator_proto_rawDesc
+)
+
+func file_administrator_proto_rawDescGZIP() []byte {
+	file_administrator_proto_rawDescOnce.Do(func() {
+		file_administrator_proto_rawDescData = protoimpl.X.CompressGZIP(file_administrator_proto_rawDescData)
+	})
+	return file_administrator_proto_rawDescData
+}
+
+var file_administrator_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
+var file_administrator_proto_goTypes = []interface{}{
+	(*CreateResourceReq)(nil),              // 0: proto.CreateResourceReq
+	(*DeleteResourceReq)(nil),              // 1: proto.DeleteResourceReq
+	(*CreateInheritanceRelReq)(nil),        // 2: proto.CreateInheritanceRelReq
+	(*DeleteInheritanceRelReq)(nil),        // 3: proto.DeleteInheritanceRelReq
+	(*PutAttributeReq)(nil),                // 4: proto.PutAttributeReq
+	(*DeleteAttributeReq)(nil),             // 5: proto.DeleteAttributeReq
+	(*CreatePolicyReq)(nil),                // 6: proto.CreatePolicyReq
+	(*DeletePolicyReq)(nil),                // 7: proto.DeletePolicyReq
+	(*PutAttributeSchemaReq)(nil),          // 8: proto.PutAttributeSchemaReq
+	(*DeleteAttributeSchemaReq)(nil),       // 9: proto.DeleteAttributeSchemaReq
+	(*GetAttributeSchemaReq)(nil),          // 10: proto.GetAttributeSchemaReq
+	(*GetAttributeSchemaResp)(nil),         // 11: proto.GetAttributeSchemaResp
+	(*SetCombiningAlgorithmReq)(nil),       // 12: proto.SetCombiningAlgorithmReq
+	(*CreatePermissionImplicationReq)(nil), // 13: proto.CreatePermissionImplicationReq
+	(*DeletePermissionImplicationReq)(nil), // 14: proto.DeletePermissionImplicationReq
+	(*CreateSoDConstraintReq)(nil),         // 15: proto.CreateSoDConstraintReq
+	(*DeleteSoDConstraintReq)(nil),         // 16: proto.DeleteSoDConstraintReq
+	(*GetInheritanceCyclesReq)(nil),        // 17: proto.GetInheritanceCyclesReq
+	(*InheritanceCycle)(nil),               // 18: proto.InheritanceCycle
+	(*GetInheritanceCyclesResp)(nil),       // 19: proto.GetInheritanceCyclesResp
+	(*PutRelationTypeReq)(nil),             // 20: proto.PutRelationTypeReq
+	(*DeleteRelationTypeReq)(nil),          // 21: proto.DeleteRelationTypeReq
+	(*GetRelationTypesReq)(nil),            // 22: proto.GetRelationTypesReq
+	(*GetRelationTypesResp)(nil),           // 23: proto.GetRelationTypesResp
+	(*BatchOp)(nil),                        // 24: proto.BatchOp
+	(*BatchReq)(nil),                       // 25: proto.BatchReq
+	(*BatchOpResult)(nil),                  // 26: proto.BatchOpResult
+	(*BatchResp)(nil),                      // 27: proto.BatchResp
+	(*AdministrationResp)(nil),             // 28: proto.AdministrationResp
+	(*Resource)(nil),                       // 29: proto.Resource
+	(*Attribute)(nil),                      // 30: proto.Attribute
+	(*AttributeId)(nil),                    // 31: proto.AttributeId
+	(*Permission)(nil),                     // 32: proto.Permission
+	(*timestamppb.Timestamp)(nil),          // 33: google.protobuf.Timestamp
+	(*AttributeSchema)(nil),                // 34: proto.AttributeSchema
+	(CombiningAlgorithm)(0),                // 35: proto.CombiningAlgorithm
+	(*SoDConstraint)(nil),                  // 36: proto.SoDConstraint
+	(*RelationType)(nil),                   // 37: proto.RelationType
+}
+var file_administrator_proto_depIdxs = []int32{
+	29, // 0: proto.CreateResourceReq.resource:type_name -> proto.Resource
+	30, // 1: proto.CreateResourceReq.attributes:type_name -> proto.Attribute
+	29, // 2: proto.DeleteResourceReq.resource:type_name -> proto.Resource
+	29, // 3: proto.CreateInheritanceRelReq.from:type_name -> proto.Resource
+	29, // 4: proto.CreateInheritanceRelReq.to:type_name -> proto.Resource
+	29, // 5: proto.DeleteInheritanceRelReq.from:type_name -> proto.Resource
+	29, // 6: proto.DeleteInheritanceRelReq.to:type_name -> proto.Resource
+	29, // 7: proto.PutAttributeReq.resource:type_name -> proto.Resource
+	30, // 8: proto.PutAttributeReq.attribute:type_name -> proto.Attribute
+	29, // 9: proto.DeleteAttributeReq.resource:type_name -> proto.Resource
+	31, // 10: proto.DeleteAttributeReq.attributeId:type_name -> proto.AttributeId
+	29, // 11: proto.CreatePolicyReq.subjectScope:type_name -> proto.Resource
+	29, // 12: proto.CreatePolicyReq.objectScope:type_name -> proto.Resource
+	32, // 13: proto.CreatePolicyReq.permission:type_name -> proto.Permission
+	33, // 14: proto.CreatePolicyReq.notBefore:type_name -> google.protobuf.Timestamp
+	33, // 15: proto.CreatePolicyReq.notAfter:type_name -> google.protobuf.Timestamp
+	29, // 16: proto.DeletePolicyReq.subjectScope:type_name -> proto.Resource
+	29, // 17: proto.DeletePolicyReq.objectScope:type_name -> proto.Resource
+	32, // 18: proto.DeletePolicyReq.permission:type_name -> proto.Permission
+	34, // 19: proto.PutAttributeSchemaReq.schema:type_name -> proto.AttributeSchema
+	34, // 20: proto.GetAttributeSchemaResp.schema:type_name -> proto.AttributeSchema
+	35, // 21: proto.SetCombiningAlgorithmReq.algorithm:type_name -> proto.CombiningAlgorithm
+	36, // 22: proto.CreateSoDConstraintReq.constraint:type_name -> proto.SoDConstraint
+	29, // 23: proto.InheritanceCycle.resources:type_name -> proto.Resource
+	18, // 24: proto.GetInheritanceCyclesResp.cycles:type_name -> proto.InheritanceCycle
+	37, // 25: proto.PutRelationTypeReq.relationType:type_name -> proto.RelationType
+	37, // 26: proto.GetRelationTypesResp.relationTypes:type_name -> proto.RelationType
+	0,  // 27: proto.BatchOp.createResource:type_name -> proto.CreateResourceReq
+	1,  // 28: proto.BatchOp.deleteResource:type_name -> proto.DeleteResourceReq
+	4,  // 29: proto.BatchOp.putAttribute:type_name -> proto.PutAttributeReq
+	5,  // 30: proto.BatchOp.deleteAttribute:type_name -> proto.DeleteAttributeReq
+	2,  // 31: proto.BatchOp.createInheritanceRel:type_name -> proto.CreateInheritanceRelReq
+	3,  // 32: proto.BatchOp.deleteInheritanceRel:type_name -> proto.DeleteInheritanceRelReq
+	6,  // 33: proto.BatchOp.createPolicy:type_name -> proto.CreatePolicyReq
+	7,  // 34: proto.BatchOp.deletePolicy:type_name -> proto.DeletePolicyReq
+	8,  // 35: proto.BatchOp.putAttributeSchema:type_name -> proto.PutAttributeSchemaReq
+	9,  // 36: proto.BatchOp.deleteAttributeSchema:type_name -> proto.DeleteAttributeSchemaReq
+	12, // 37: proto.BatchOp.setCombiningAlgorithm:type_name -> proto.SetCombiningAlgorithmReq
+	13, // 38: proto.BatchOp.createPermissionImplication:type_name -> proto.CreatePermissionImplicationReq
+	14, // 39: proto.BatchOp.deletePermissionImplication:type_name -> proto.DeletePermissionImplicationReq
+	15, // 40: proto.BatchOp.createSoDConstraint:type_name -> proto.CreateSoDConstraintReq
+	16, // 41: proto.BatchOp.deleteSoDConstraint:type_name -> proto.DeleteSoDConstraintReq
+	20, // 42: proto.BatchOp.putRelationType:type_name -> proto.PutRelationTypeReq
+	21, // 43: proto.BatchOp.deleteRelationType:type_name -> proto.DeleteRelationTypeReq
+	24, // 44: proto.BatchReq.ops:type_name -> proto.BatchOp
+	26, // 45: proto.BatchResp.results:type_name -> proto.BatchOpResult
+	0,  // 46: proto.OortAdministrator.CreateResource:input_type -> proto.CreateResourceReq
+	1,  // 47: proto.OortAdministrator.DeleteResource:input_type -> proto.DeleteResourceReq
+	2,  // 48: proto.OortAdministrator.CreateInheritanceRel:input_type -> proto.CreateInheritanceRelReq
+	3,  // 49: proto.OortAdministrator.DeleteInheritanceRel:input_type -> proto.DeleteInheritanceRelReq
+	4,  // 50: proto.OortAdministrator.PutAttribute:input_type -> proto.PutAttributeReq
+	5,  // 51: proto.OortAdministrator.DeleteAttribute:input_type -> proto.DeleteAttributeReq
+	6,  // 52: proto.OortAdministrator.CreatePolicy:input_type -> proto.CreatePolicyReq
+	7,  // 53: proto.OortAdministrator.DeletePolicy:input_type -> proto.DeletePolicyReq
+	8,  // 54: proto.OortAdministrator.PutAttributeSchema:input_type -> proto.PutAttributeSchemaReq
+	9,  // 55: proto.OortAdministrator.DeleteAttributeSchema:input_type -> proto.DeleteAttributeSchemaReq
+	10, // 56: proto.OortAdministrator.GetAttributeSchema:input_type -> proto.GetAttributeSchemaReq
+	12, // 57: proto.OortAdministrator.SetCombiningAlgorithm:input_type -> proto.SetCombiningAlgorithmReq
+	13, // 58: proto.OortAdministrator.CreatePermissionImplication:input_type -> proto.CreatePermissionImplicationReq
+	14, // 59: proto.OortAdministrator.DeletePermissionImplication:input_type -> proto.DeletePermissionImplicationReq
+	15, // 60: proto.OortAdministrator.CreateSoDConstraint:input_type -> proto.CreateSoDConstraintReq
+	16, // 61: proto.OortAdministrator.DeleteSoDConstraint:input_type -> proto.DeleteSoDConstraintReq
+	17, // 62: proto.OortAdministrator.GetInheritanceCycles:input_type -> proto.GetInheritanceCyclesReq
+	20, // 63: proto.OortAdministrator.PutRelationType:input_type -> proto.PutRelationTypeReq
+	21, // 64: proto.OortAdministrator.DeleteRelationType:input_type -> proto.DeleteRelationTypeReq
+	22, // 65: proto.OortAdministrator.GetRelationTypes:input_type -> proto.GetRelationTypesReq
+	25, // 66: proto.OortAdministrator.Batch:input_type -> proto.BatchReq
+	28, // 67: proto.OortAdministrator.CreateResource:output_type -> proto.AdministrationResp
+	28, // 68: proto.OortAdministrator.DeleteResource:output_type -> proto.AdministrationResp
+	28, // 69: proto.OortAdministrator.CreateInheritanceRel:output_type -> proto.AdministrationResp
+	28, // 70: proto.OortAdministrator.DeleteInheritanceRel:output_type -> proto.AdministrationResp
+	28, // 71: proto.OortAdministrator.PutAttribute:output_type -> proto.AdministrationResp
+	28, // 72: proto.OortAdministrator.DeleteAttribute:output_type -> proto.AdministrationResp
+	28, // 73: proto.OortAdministrator.CreatePolicy:output_type -> proto.AdministrationResp
+	28, // 74: proto.OortAdministrator.DeletePolicy:output_type -> proto.AdministrationResp
+	28, // 75: proto.OortAdministrator.PutAttributeSchema:output_type -> proto.AdministrationResp
+	28, // 76: proto.OortAdministrator.DeleteAttributeSchema:output_type -> proto.AdministrationResp
+	11, // 77: proto.OortAdministrator.GetAttributeSchema:output_type -> proto.GetAttributeSchemaResp
+	28, // 78: proto.OortAdministrator.SetCombiningAlgorithm:output_type -> proto.AdministrationResp
+	28, // 79: proto.OortAdministrator.CreatePermissionImplication:output_type -> proto.AdministrationResp
+	28, // 80: proto.OortAdministrator.DeletePermissionImplication:output_type -> proto.AdministrationResp
+	28, // 81: proto.OortAdministrator.CreateSoDConstraint:output_type -> proto.AdministrationResp
+	28, // 82: proto.OortAdministrator.DeleteSoDConstraint:output_type -> proto.AdministrationResp
+	19, // 83: proto.OortAdministrator.GetInheritanceCycles:output_type -> proto.GetInheritanceCyclesResp
+	28, // 84: proto.OortAdministrator.PutRelationType:output_type -> proto.AdministrationResp
+	28, // 85: proto.OortAdministrator.DeleteRelationType:output_type -> proto.AdministrationResp
+	23, // 86: proto.OortAdministrator.GetRelationTypes:output_type -> proto.GetRelationTypesResp
+	27, // 87: proto.OortAdministrator.Batch:output_type -> proto.BatchResp
+	67, // [67:88] is the sub-list for method output_type
+	46, // [46:67] is the sub-list for method input_type
+	46, // [46:46] is the sub-list for extension type_name
+	46, // [46:46] is the sub-list for extension extendee
+	0,  // [0:46] is the sub-list for field type_name
+}
+
+func init() { file_administrator_proto_init() }
+func file_administrator_proto_init() {
+	if File_administrator_proto != nil {
+		return
+	}
+	file_model_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_administrator_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateResourceReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_administrator_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteResourceReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_administrator_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateInheritanceRelReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_administrator_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteInheritanceRelReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_administrator_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PutAttributeReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -777,6 +2369,246 @@ func file_administrator_proto_init() {
 			}
 		}
 		file_administrator_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PutAttributeSchemaReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_administrator_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteAttributeSchemaReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_administrator_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAttributeSchemaReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_administrator_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAttributeSchemaResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_administrator_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetCombiningAlgorithmReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_administrator_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePermissionImplicationReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_administrator_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletePermissionImplicationReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_administrator_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSoDConstraintReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_administrator_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteSoDConstraintReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_administrator_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInheritanceCyclesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_administrator_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InheritanceCycle); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_administrator_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInheritanceCyclesResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_administrator_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PutRelationTypeReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_administrator_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteRelationTypeReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_administrator_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRelationTypesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_administrator_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRelationTypesResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_administrator_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchOp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_administrator_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_administrator_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchOpResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_administrator_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_administrator_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AdministrationResp); i {
 			case 0:
 				return &v.state
@@ -789,13 +2621,32 @@ func file_administrator_proto_init() {
 			}
 		}
 	}
+	file_administrator_proto_msgTypes[24].OneofWrappers = []interface{}{
+		(*BatchOp_CreateResource)(nil),
+		(*BatchOp_DeleteResource)(nil),
+		(*BatchOp_PutAttribute)(nil),
+		(*BatchOp_DeleteAttribute)(nil),
+		(*BatchOp_CreateInheritanceRel)(nil),
+		(*BatchOp_DeleteInheritanceRel)(nil),
+		(*BatchOp_CreatePolicy)(nil),
+		(*BatchOp_DeletePolicy)(nil),
+		(*BatchOp_PutAttributeSchema)(nil),
+		(*BatchOp_DeleteAttributeSchema)(nil),
+		(*BatchOp_SetCombiningAlgorithm)(nil),
+		(*BatchOp_CreatePermissionImplication)(nil),
+		(*BatchOp_DeletePermissionImplication)(nil),
+		(*BatchOp_CreateSoDConstraint)(nil),
+		(*BatchOp_DeleteSoDConstraint)(nil),
+		(*BatchOp_PutRelationType)(nil),
+		(*BatchOp_DeleteRelationType)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_administrator_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   29,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
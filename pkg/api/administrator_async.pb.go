@@ -23,37 +23,67 @@ const (
 type AdministrationAsyncReq_ReqKind int32
 
 const (
-	AdministrationAsyncReq_CreateResource       AdministrationAsyncReq_ReqKind = 0
-	AdministrationAsyncReq_DeleteResource       AdministrationAsyncReq_ReqKind = 1
-	AdministrationAsyncReq_PutAttribute         AdministrationAsyncReq_ReqKind = 2
-	AdministrationAsyncReq_DeleteAttribute      AdministrationAsyncReq_ReqKind = 3
-	AdministrationAsyncReq_CreateInheritanceRel AdministrationAsyncReq_ReqKind = 4
-	AdministrationAsyncReq_DeleteInheritanceRel AdministrationAsyncReq_ReqKind = 5
-	AdministrationAsyncReq_CreatePolicy         AdministrationAsyncReq_ReqKind = 6
-	AdministrationAsyncReq_DeletePolicy         AdministrationAsyncReq_ReqKind = 7
+	AdministrationAsyncReq_CreateResource              AdministrationAsyncReq_ReqKind = 0
+	AdministrationAsyncReq_DeleteResource              AdministrationAsyncReq_ReqKind = 1
+	AdministrationAsyncReq_PutAttribute                AdministrationAsyncReq_ReqKind = 2
+	AdministrationAsyncReq_DeleteAttribute             AdministrationAsyncReq_ReqKind = 3
+	AdministrationAsyncReq_CreateInheritanceRel        AdministrationAsyncReq_ReqKind = 4
+	AdministrationAsyncReq_DeleteInheritanceRel        AdministrationAsyncReq_ReqKind = 5
+	AdministrationAsyncReq_CreatePolicy                AdministrationAsyncReq_ReqKind = 6
+	AdministrationAsyncReq_DeletePolicy                AdministrationAsyncReq_ReqKind = 7
+	AdministrationAsyncReq_PutAttributeSchema          AdministrationAsyncReq_ReqKind = 8
+	AdministrationAsyncReq_DeleteAttributeSchema       AdministrationAsyncReq_ReqKind = 9
+	AdministrationAsyncReq_SetCombiningAlgorithm       AdministrationAsyncReq_ReqKind = 10
+	AdministrationAsyncReq_CreatePermissionImplication AdministrationAsyncReq_ReqKind = 11
+	AdministrationAsyncReq_DeletePermissionImplication AdministrationAsyncReq_ReqKind = 12
+	AdministrationAsyncReq_CreateSoDConstraint         AdministrationAsyncReq_ReqKind = 13
+	AdministrationAsyncReq_DeleteSoDConstraint         AdministrationAsyncReq_ReqKind = 14
+	AdministrationAsyncReq_PutRelationType             AdministrationAsyncReq_ReqKind = 15
+	AdministrationAsyncReq_DeleteRelationType          AdministrationAsyncReq_ReqKind = 16
+	AdministrationAsyncReq_Batch                       AdministrationAsyncReq_ReqKind = 17
 )
 
 // Enum value maps for AdministrationAsyncReq_ReqKind.
 var (
 	AdministrationAsyncReq_ReqKind_name = map[int32]string{
-		0: "CreateResource",
-		1: "DeleteResource",
-		2: "PutAttribute",
-		3: "DeleteAttribute",
-		4: "CreateInheritanceRel",
-		5: "DeleteInheritanceRel",
-		6: "CreatePolicy",
-		7: "DeletePolicy",
+		0:  "CreateResource",
+		1:  "DeleteResource",
+		2:  "PutAttribute",
+		3:  "DeleteAttribute",
+		4:  "CreateInheritanceRel",
+		5:  "DeleteInheritanceRel",
+		6:  "CreatePolicy",
+		7:  "DeletePolicy",
+		8:  "PutAttributeSchema",
+		9:  "DeleteAttributeSchema",
+		10: "SetCombiningAlgorithm",
+		11: "CreatePermissionImplication",
+		12: "DeletePermissionImplication",
+		13: "CreateSoDConstraint",
+		14: "DeleteSoDConstraint",
+		15: "PutRelationType",
+		16: "DeleteRelationType",
+		17: "Batch",
 	}
 	AdministrationAsyncReq_ReqKind_value = map[string]int32{
-		"CreateResource":       0,
-		"DeleteResource":       1,
-		"PutAttribute":         2,
-		"DeleteAttribute":      3,
-		"CreateInheritanceRel": 4,
-		"DeleteInheritanceRel": 5,
-		"CreatePolicy":         6,
-		"DeletePolicy":         7,
+		"CreateResource":              0,
+		"DeleteResource":              1,
+		"PutAttribute":                2,
+		"DeleteAttribute":             3,
+		"CreateInheritanceRel":        4,
+		"DeleteInheritanceRel":        5,
+		"CreatePolicy":                6,
+		"DeletePolicy":                7,
+		"PutAttributeSchema":          8,
+		"DeleteAttributeSchema":       9,
+		"SetCombiningAlgorithm":       10,
+		"CreatePermissionImplication": 11,
+		"DeletePermissionImplication": 12,
+		"CreateSoDConstraint":         13,
+		"DeleteSoDConstraint":         14,
+		"PutRelationType":             15,
+		"DeleteRelationType":          16,
+		"Batch":                       17,
 	}
 )
 
@@ -145,6 +175,10 @@ type AdministrationAsyncResp struct {
 	unknownFields protoimpl.UnknownFields
 
 	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	// gRPC status code of the error
+	Code uint32 `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	// results of the operations of a Batch request
+	BatchResults []*BatchOpResult `protobuf:"bytes,3,rep,name=batchResults,proto3" json:"batchResults,omitempty"`
 }
 
 func (x *AdministrationAsyncResp) Reset() {
@@ -186,36 +220,198 @@ func (x *AdministrationAsyncResp) GetError() string {
 	return ""
 }
 
+func (x *AdministrationAsyncResp) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *AdministrationAsyncResp) GetBatchResults() []*BatchOpResult {
+	if x != nil {
+		return x.BatchResults
+	}
+	return nil
+}
+
+type ExpiredPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PermissionName string    `protobuf:"bytes,1,opt,name=permissionName,proto3" json:"permissionName,omitempty"`
+	SubjectScope   *Resource `protobuf:"bytes,2,opt,name=subjectScope,proto3" json:"subjectScope,omitempty"`
+	ObjectScope    *Resource `protobuf:"bytes,3,opt,name=objectScope,proto3" json:"objectScope,omitempty"`
+}
+
+func (x *ExpiredPolicy) Reset() {
+	*x = ExpiredPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_administrator_async_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExpiredPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpiredPolicy) ProtoMessage() {}
+
+func (x *ExpiredPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_administrator_async_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpiredPolicy.ProtoReflect.Descriptor instead.
+func (*ExpiredPolicy) Descriptor() ([]byte, []int) {
+	return file_administrator_async_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ExpiredPolicy) GetPermissionName() string {
+	if x != nil {
+		return x.PermissionName
+	}
+	return ""
+}
+
+func (x *ExpiredPolicy) GetSubjectScope() *Resource {
+	if x != nil {
+		return x.SubjectScope
+	}
+	return nil
+}
+
+func (x *ExpiredPolicy) GetObjectScope() *Resource {
+	if x != nil {
+		return x.ObjectScope
+	}
+	return nil
+}
+
+type PoliciesExpiredNotification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Policies []*ExpiredPolicy `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies,omitempty"`
+}
+
+func (x *PoliciesExpiredNotification) Reset() {
+	*x = PoliciesExpiredNotification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_administrator_async_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PoliciesExpiredNotification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PoliciesExpiredNotification) ProtoMessage() {}
+
+func (x *PoliciesExpiredNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_administrator_async_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PoliciesExpiredNotification.ProtoReflect.Descriptor instead.
+func (*PoliciesExpiredNotification) Descriptor() ([]byte, []int) {
+	return file_administrator_async_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PoliciesExpiredNotification) GetPolicies() []*ExpiredPolicy {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
 var File_administrator_async_proto protoreflect.FileDescriptor
 
 var file_administrator_async_proto_rawDesc = []byte{
 	0x0a, 0x19, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f,
 	0x61, 0x73, 0x79, 0x6e, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0xac, 0x02, 0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x12, 0x39, 0x0a,
-	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x2e, 0x52, 0x65, 0x71, 0x4b, 0x69,
-	0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x71, 0x4d,
-	0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x0d, 0x72, 0x65, 0x71, 0x4d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x22, 0xb0,
-	0x01, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x10, 0x00, 0x12, 0x12,
-	0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x75, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
-	0x74, 0x65, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x74,
-	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x10, 0x03, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x49, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
-	0x6c, 0x10, 0x04, 0x12, 0x18, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x68,
-	0x65, 0x72, 0x69, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x6c, 0x10, 0x05, 0x12, 0x10, 0x0a,
-	0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x10, 0x06, 0x12,
-	0x10, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x10,
-	0x07, 0x22, 0x2f, 0x0a, 0x17, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x42, 0x1e, 0x5a, 0x1c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x63, 0x31, 0x32, 0x73, 0x2f, 0x6f, 0x6f, 0x72, 0x74, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61,
-	0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x6f, 0x1a, 0x0b, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x13, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa6, 0x04, 0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x12,
+	0x39, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x2e, 0x52, 0x65, 0x71,
+	0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65,
+	0x71, 0x4d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x4d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x64,
+	0x22, 0xaa, 0x03, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x0e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x10, 0x00,
+	0x12, 0x12, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x75, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x10, 0x03, 0x12, 0x18, 0x0a, 0x14, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x6c, 0x10, 0x04, 0x12, 0x18, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49,
+	0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x6c, 0x10, 0x05, 0x12,
+	0x10, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x10,
+	0x06, 0x12, 0x10, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x10, 0x07, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x75, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x10, 0x08, 0x12, 0x19, 0x0a, 0x15, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x53, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x10, 0x09, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6d,
+	0x62, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x10,
+	0x0a, 0x12, 0x1f, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x10, 0x0b, 0x12, 0x1f, 0x0a, 0x1b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x65, 0x72, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x10, 0x0c, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6f, 0x44,
+	0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x10, 0x0d, 0x12, 0x17, 0x0a, 0x13,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x6f, 0x44, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61,
+	0x69, 0x6e, 0x74, 0x10, 0x0e, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x75, 0x74, 0x52, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x10, 0x0f, 0x12, 0x16, 0x0a, 0x12, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x10, 0x10, 0x12, 0x09, 0x0a, 0x05, 0x42, 0x61, 0x74, 0x63, 0x68, 0x10, 0x11, 0x22, 0x7d, 0x0a,
+	0x17, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41,
+	0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x12,
+	0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x12, 0x38, 0x0a, 0x0c, 0x62, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x70, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0c,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x9f, 0x01, 0x0a,
+	0x0d, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x26,
+	0x0a, 0x0e, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x0c, 0x73,
+	0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x31, 0x0a, 0x0b, 0x6f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x52, 0x0b, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x22, 0x4f,
+	0x0a, 0x1b, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a,
+	0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x42,
+	0x1e, 0x5a, 0x1c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x31,
+	0x32, 0x73, 0x2f, 0x6f, 0x6f, 0x72, 0x74, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -231,19 +427,27 @@ func file_administrator_async_proto_rawDescGZIP() []byte {
 }
 
 var file_administrator_async_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_administrator_async_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_administrator_async_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_administrator_async_proto_goTypes = []interface{}{
 	(AdministrationAsyncReq_ReqKind)(0), // 0: proto.AdministrationAsyncReq.ReqKind
 	(*AdministrationAsyncReq)(nil),      // 1: proto.AdministrationAsyncReq
 	(*AdministrationAsyncResp)(nil),     // 2: proto.AdministrationAsyncResp
+	(*ExpiredPolicy)(nil),               // 3: proto.ExpiredPolicy
+	(*PoliciesExpiredNotification)(nil), // 4: proto.PoliciesExpiredNotification
+	(*BatchOpResult)(nil),               // 5: proto.BatchOpResult
+	(*Resource)(nil),                    // 6: proto.Resource
 }
 var file_administrator_async_proto_depIdxs = []int32{
 	0, // 0: proto.AdministrationAsyncReq.kind:type_name -> proto.AdministrationAsyncReq.ReqKind
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	5, // 1: proto.AdministrationAsyncResp.batchResults:type_name -> proto.BatchOpResult
+	6, // 2: proto.ExpiredPolicy.subjectScope:type_name -> proto.Resource
+	6, // 3: proto.ExpiredPolicy.objectScope:type_name -> proto.Resource
+	3, // 4: proto.PoliciesExpiredNotification.policies:type_name -> proto.ExpiredPolicy
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_administrator_async_proto_init() }
@@ -251,6 +455,8 @@ func file_administrator_async_proto_init() {
 	if File_administrator_async_proto != nil {
 		return
 	}
+	file_model_proto_init()
+	file_administrator_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_administrator_async_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AdministrationAsyncReq); i {
@@ -276,6 +482,30 @@ func file_administrator_async_proto_init() {
 				return nil
 			}
 		}
+		file_administrator_async_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExpiredPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_administrator_async_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PoliciesExpiredNotification); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -283,7 +513,7 @@ func file_administrator_async_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_administrator_async_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   2,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -30,6 +30,19 @@ type OortAdministratorClient interface {
 	DeleteAttribute(ctx context.Context, in *DeleteAttributeReq, opts ...grpc.CallOption) (*AdministrationResp, error)
 	CreatePolicy(ctx context.Context, in *CreatePolicyReq, opts ...grpc.CallOption) (*AdministrationResp, error)
 	DeletePolicy(ctx context.Context, in *DeletePolicyReq, opts ...grpc.CallOption) (*AdministrationResp, error)
+	PutAttributeSchema(ctx context.Context, in *PutAttributeSchemaReq, opts ...grpc.CallOption) (*AdministrationResp, error)
+	DeleteAttributeSchema(ctx context.Context, in *DeleteAttributeSchemaReq, opts ...grpc.CallOption) (*AdministrationResp, error)
+	GetAttributeSchema(ctx context.Context, in *GetAttributeSchemaReq, opts ...grpc.CallOption) (*GetAttributeSchemaResp, error)
+	SetCombiningAlgorithm(ctx context.Context, in *SetCombiningAlgorithmReq, opts ...grpc.CallOption) (*AdministrationResp, error)
+	CreatePermissionImplication(ctx context.Context, in *CreatePermissionImplicationReq, opts ...grpc.CallOption) (*AdministrationResp, error)
+	DeletePermissionImplication(ctx context.Context, in *DeletePermissionImplicationReq, opts ...grpc.CallOption) (*AdministrationResp, error)
+	CreateSoDConstraint(ctx context.Context, in *CreateSoDConstraintReq, opts ...grpc.CallOption) (*AdministrationResp, error)
+	DeleteSoDConstraint(ctx context.Context, in *DeleteSoDConstraintReq, opts ...grpc.CallOption) (*AdministrationResp, error)
+	GetInheritanceCycles(ctx context.Context, in *GetInheritanceCyclesReq, opts ...grpc.CallOption) (*GetInheritanceCyclesResp, error)
+	PutRelationType(ctx context.Context, in *PutRelationTypeReq, opts ...grpc.CallOption) (*AdministrationResp, error)
+	DeleteRelationType(ctx context.Context, in *DeleteRelationTypeReq, opts ...grpc.CallOption) (*AdministrationResp, error)
+	GetRelationTypes(ctx context.Context, in *GetRelationTypesReq, opts ...grpc.CallOption) (*GetRelationTypesResp, error)
+	Batch(ctx context.Context, in *BatchReq, opts ...grpc.CallOption) (*BatchResp, error)
 }
 
 type oortAdministratorClient struct {
@@ -112,6 +125,123 @@ func (c *oortAdministratorClient) DeletePolicy(ctx context.Context, in *DeletePo
 	return out, nil
 }
 
+func (c *oortAdministratorClient) PutAttributeSchema(ctx context.Context, in *PutAttributeSchemaReq, opts ...grpc.CallOption) (*AdministrationResp, error) {
+	out := new(AdministrationResp)
+	err := c.cc.Invoke(ctx, "/proto.OortAdministrator/PutAttributeSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oortAdministratorClient) DeleteAttributeSchema(ctx context.Context, in *DeleteAttributeSchemaReq, opts ...grpc.CallOption) (*AdministrationResp, error) {
+	out := new(AdministrationResp)
+	err := c.cc.Invoke(ctx, "/proto.OortAdministrator/DeleteAttributeSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oortAdministratorClient) GetAttributeSchema(ctx context.Context, in *GetAttributeSchemaReq, opts ...grpc.CallOption) (*GetAttributeSchemaResp, error) {
+	out := new(GetAttributeSchemaResp)
+	err := c.cc.Invoke(ctx, "/proto.OortAdministrator/GetAttributeSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oortAdministratorClient) SetCombiningAlgorithm(ctx context.Context, in *SetCombiningAlgorithmReq, opts ...grpc.CallOption) (*AdministrationResp, error) {
+	out := new(AdministrationResp)
+	err := c.cc.Invoke(ctx, "/proto.OortAdministrator/SetCombiningAlgorithm", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oortAdministratorClient) CreatePermissionImplication(ctx context.Context, in *CreatePermissionImplicationReq, opts ...grpc.CallOption) (*AdministrationResp, error) {
+	out := new(AdministrationResp)
+	err := c.cc.Invoke(ctx, "/proto.OortAdministrator/CreatePermissionImplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oortAdministratorClient) DeletePermissionImplication(ctx context.Context, in *DeletePermissionImplicationReq, opts ...grpc.CallOption) (*AdministrationResp, error) {
+	out := new(AdministrationResp)
+	err := c.cc.Invoke(ctx, "/proto.OortAdministrator/DeletePermissionImplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oortAdministratorClient) CreateSoDConstraint(ctx context.Context, in *CreateSoDConstraintReq, opts ...grpc.CallOption) (*AdministrationResp, error) {
+	out := new(AdministrationResp)
+	err := c.cc.Invoke(ctx, "/proto.OortAdministrator/CreateSoDConstraint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oortAdministratorClient) DeleteSoDConstraint(ctx context.Context, in *DeleteSoDConstraintReq, opts ...grpc.CallOption) (*AdministrationResp, error) {
+	out := new(AdministrationResp)
+	err := c.cc.Invoke(ctx, "/proto.OortAdministrator/DeleteSoDConstraint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oortAdministratorClient) GetInheritanceCycles(ctx context.Context, in *GetInheritanceCyclesReq, opts ...grpc.CallOption) (*GetInheritanceCyclesResp, error) {
+	out := new(GetInheritanceCyclesResp)
+	err := c.cc.Invoke(ctx, "/proto.OortAdministrator/GetInheritanceCycles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oortAdministratorClient) PutRelationType(ctx context.Context, in *PutRelationTypeReq, opts ...grpc.CallOption) (*AdministrationResp, error) {
+	out := new(AdministrationResp)
+	err := c.cc.Invoke(ctx, "/proto.OortAdministrator/PutRelationType", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oortAdministratorClient) DeleteRelationType(ctx context.Context, in *DeleteRelationTypeReq, opts ...grpc.CallOption) (*AdministrationResp, error) {
+	out := new(AdministrationResp)
+	err := c.cc.Invoke(ctx, "/proto.OortAdministrator/DeleteRelationType", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oortAdministratorClient) GetRelationTypes(ctx context.Context, in *GetRelationTypesReq, opts ...grpc.CallOption) (*GetRelationTypesResp, error) {
+	out := new(GetRelationTypesResp)
+	err := c.cc.Invoke(ctx, "/proto.OortAdministrator/GetRelationTypes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oortAdministratorClient) Batch(ctx context.Context, in *BatchReq, opts ...grpc.CallOption) (*BatchResp, error) {
+	out := new(BatchResp)
+	err := c.cc.Invoke(ctx, "/proto.OortAdministrator/Batch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OortAdministratorServer is the server API for OortAdministrator service.
 // All implementations must embed UnimplementedOortAdministratorServer
 // for forward compatibility
@@ -124,6 +254,19 @@ type OortAdministratorServer interface {
 	DeleteAttribute(context.Context, *DeleteAttributeReq) (*AdministrationResp, error)
 	CreatePolicy(context.Context, *CreatePolicyReq) (*AdministrationResp, error)
 	DeletePolicy(context.Context, *DeletePolicyReq) (*AdministrationResp, error)
+	PutAttributeSchema(context.Context, *PutAttributeSchemaReq) (*AdministrationResp, error)
+	DeleteAttributeSchema(context.Context, *DeleteAttributeSchemaReq) (*AdministrationResp, error)
+	GetAttributeSchema(context.Context, *GetAttributeSchemaReq) (*GetAttributeSchemaResp, error)
+	SetCombiningAlgorithm(context.Context, *SetCombiningAlgorithmReq) (*AdministrationResp, error)
+	CreatePermissionImplication(context.Context, *CreatePermissionImplicationReq) (*AdministrationResp, error)
+	DeletePermissionImplication(context.Context, *DeletePermissionImplicationReq) (*AdministrationResp, error)
+	CreateSoDConstraint(context.Context, *CreateSoDConstraintReq) (*AdministrationResp, error)
+	DeleteSoDConstraint(context.Context, *DeleteSoDConstraintReq) (*AdministrationResp, error)
+	GetInheritanceCycles(context.Context, *GetInheritanceCyclesReq) (*GetInheritanceCyclesResp, error)
+	PutRelationType(context.Context, *PutRelationTypeReq) (*AdministrationResp, error)
+	DeleteRelationType(context.Context, *DeleteRelationTypeReq) (*AdministrationResp, error)
+	GetRelationTypes(context.Context, *GetRelationTypesReq) (*GetRelationTypesResp, error)
+	Batch(context.Context, *BatchReq) (*BatchResp, error)
 	mustEmbedUnimplementedOortAdministratorServer()
 }
 
@@ -155,6 +298,45 @@ func (UnimplementedOortAdministratorServer) CreatePolicy(context.Context, *Creat
 func (UnimplementedOortAdministratorServer) DeletePolicy(context.Context, *DeletePolicyReq) (*AdministrationResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeletePolicy not implemented")
 }
+func (UnimplementedOortAdministratorServer) PutAttributeSchema(context.Context, *PutAttributeSchemaReq) (*AdministrationResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutAttributeSchema not implemented")
+}
+func (UnimplementedOortAdministratorServer) DeleteAttributeSchema(context.Context, *DeleteAttributeSchemaReq) (*AdministrationResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAttributeSchema not implemented")
+}
+func (UnimplementedOortAdministratorServer) GetAttributeSchema(context.Context, *GetAttributeSchemaReq) (*GetAttributeSchemaResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttributeSchema not implemented")
+}
+func (UnimplementedOortAdministratorServer) SetCombiningAlgorithm(context.Context, *SetCombiningAlgorithmReq) (*AdministrationResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCombiningAlgorithm not implemented")
+}
+func (UnimplementedOortAdministratorServer) CreatePermissionImplication(context.Context, *CreatePermissionImplicationReq) (*AdministrationResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePermissionImplication not implemented")
+}
+func (UnimplementedOortAdministratorServer) DeletePermissionImplication(context.Context, *DeletePermissionImplicationReq) (*AdministrationResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePermissionImplication not implemented")
+}
+func (UnimplementedOortAdministratorServer) CreateSoDConstraint(context.Context, *CreateSoDConstraintReq) (*AdministrationResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSoDConstraint not implemented")
+}
+func (UnimplementedOortAdministratorServer) DeleteSoDConstraint(context.Context, *DeleteSoDConstraintReq) (*AdministrationResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSoDConstraint not implemented")
+}
+func (UnimplementedOortAdministratorServer) GetInheritanceCycles(context.Context, *GetInheritanceCyclesReq) (*GetInheritanceCyclesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInheritanceCycles not implemented")
+}
+func (UnimplementedOortAdministratorServer) PutRelationType(context.Context, *PutRelationTypeReq) (*AdministrationResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutRelationType not implemented")
+}
+func (UnimplementedOortAdministratorServer) DeleteRelationType(context.Context, *DeleteRelationTypeReq) (*AdministrationResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRelationType not implemented")
+}
+func (UnimplementedOortAdministratorServer) GetRelationTypes(context.Context, *GetRelationTypesReq) (*GetRelationTypesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRelationTypes not implemented")
+}
+func (UnimplementedOortAdministratorServer) Batch(context.Context, *BatchReq) (*BatchResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Batch not implemented")
+}
 func (UnimplementedOortAdministratorServer) mustEmbedUnimplementedOortAdministratorServer() {}
 
 // UnsafeOortAdministratorServer may be embedded to opt out of forward compatibility for this service.
@@ -312,6 +494,240 @@ func _OortAdministrator_DeletePolicy_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OortAdministrator_PutAttributeSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutAttributeSchemaReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OortAdministratorServer).PutAttributeSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.OortAdministrator/PutAttributeSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OortAdministratorServer).PutAttributeSchema(ctx, req.(*PutAttributeSchemaReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OortAdministrator_DeleteAttributeSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAttributeSchemaReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OortAdministratorServer).DeleteAttributeSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.OortAdministrator/DeleteAttributeSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OortAdministratorServer).DeleteAttributeSchema(ctx, req.(*DeleteAttributeSchemaReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OortAdministrator_GetAttributeSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAttributeSchemaReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OortAdministratorServer).GetAttributeSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.OortAdministrator/GetAttributeSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OortAdministratorServer).GetAttributeSchema(ctx, req.(*GetAttributeSchemaReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OortAdministrator_SetCombiningAlgorithm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCombiningAlgorithmReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OortAdministratorServer).SetCombiningAlgorithm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.OortAdministrator/SetCombiningAlgorithm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OortAdministratorServer).SetCombiningAlgorithm(ctx, req.(*SetCombiningAlgorithmReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OortAdministrator_CreatePermissionImplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePermissionImplicationReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OortAdministratorServer).CreatePermissionImplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.OortAdministrator/CreatePermissionImplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OortAdministratorServer).CreatePermissionImplication(ctx, req.(*CreatePermissionImplicationReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OortAdministrator_DeletePermissionImplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePermissionImplicationReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OortAdministratorServer).DeletePermissionImplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.OortAdministrator/DeletePermissionImplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OortAdministratorServer).DeletePermissionImplication(ctx, req.(*DeletePermissionImplicationReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OortAdministrator_CreateSoDConstraint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSoDConstraintReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OortAdministratorServer).CreateSoDConstraint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.OortAdministrator/CreateSoDConstraint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OortAdministratorServer).CreateSoDConstraint(ctx, req.(*CreateSoDConstraintReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OortAdministrator_DeleteSoDConstraint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSoDConstraintReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OortAdministratorServer).DeleteSoDConstraint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.OortAdministrator/DeleteSoDConstraint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OortAdministratorServer).DeleteSoDConstraint(ctx, req.(*DeleteSoDConstraintReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OortAdministrator_GetInheritanceCycles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInheritanceCyclesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OortAdministratorServer).GetInheritanceCycles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.OortAdministrator/GetInheritanceCycles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OortAdministratorServer).GetInheritanceCycles(ctx, req.(*GetInheritanceCyclesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OortAdministrator_PutRelationType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutRelationTypeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OortAdministratorServer).PutRelationType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.OortAdministrator/PutRelationType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OortAdministratorServer).PutRelationType(ctx, req.(*PutRelationTypeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OortAdministrator_DeleteRelationType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRelationTypeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OortAdministratorServer).DeleteRelationType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.OortAdministrator/DeleteRelationType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OortAdministratorServer).DeleteRelationType(ctx, req.(*DeleteRelationTypeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OortAdministrator_GetRelationTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRelationTypesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OortAdministratorServer).GetRelationTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.OortAdministrator/GetRelationTypes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OortAdministratorServer).GetRelationTypes(ctx, req.(*GetRelationTypesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OortAdministrator_Batch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OortAdministratorServer).Batch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.OortAdministrator/Batch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OortAdministratorServer).Batch(ctx, req.(*BatchReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // OortAdministrator_ServiceDesc is the grpc.ServiceDesc for OortAdministrator service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -351,6 +767,58 @@ var OortAdministrator_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeletePolicy",
 			Handler:    _OortAdministrator_DeletePolicy_Handler,
 		},
+		{
+			MethodName: "PutAttributeSchema",
+			Handler:    _OortAdministrator_PutAttributeSchema_Handler,
+		},
+		{
+			MethodName: "DeleteAttributeSchema",
+			Handler:    _OortAdministrator_DeleteAttributeSchema_Handler,
+		},
+		{
+			MethodName: "GetAttributeSchema",
+			Handler:    _OortAdministrator_GetAttributeSchema_Handler,
+		},
+		{
+			MethodName: "SetCombiningAlgorithm",
+			Handler:    _OortAdministrator_SetCombiningAlgorithm_Handler,
+		},
+		{
+			MethodName: "CreatePermissionImplication",
+			Handler:    _OortAdministrator_CreatePermissionImplication_Handler,
+		},
+		{
+			MethodName: "DeletePermissionImplication",
+			Handler:    _OortAdministrator_DeletePermissionImplication_Handler,
+		},
+		{
+			MethodName: "CreateSoDConstraint",
+			Handler:    _OortAdministrator_CreateSoDConstraint_Handler,
+		},
+		{
+			MethodName: "DeleteSoDConstraint",
+			Handler:    _OortAdministrator_DeleteSoDConstraint_Handler,
+		},
+		{
+			MethodName: "GetInheritanceCycles",
+			Handler:    _OortAdministrator_GetInheritanceCycles_Handler,
+		},
+		{
+			MethodName: "PutRelationType",
+			Handler:    _OortAdministrator_PutRelationType_Handler,
+		},
+		{
+			MethodName: "DeleteRelationType",
+			Handler:    _OortAdministrator_DeleteRelationType_Handler,
+		},
+		{
+			MethodName: "GetRelationTypes",
+			Handler:    _OortAdministrator_GetRelationTypes_Handler,
+		},
+		{
+			MethodName: "Batch",
+			Handler:    _OortAdministrator_Batch_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "administrator.proto",
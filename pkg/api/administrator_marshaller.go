@@ -106,6 +106,126 @@ func (x *DeletePolicyReq) Kind() AdministrationAsyncReq_ReqKind {
 	return AdministrationAsyncReq_DeletePolicy
 }
 
+func (x *PutAttributeSchemaReq) Marshal() ([]byte, error) {
+	return proto.Marshal(x)
+}
+
+func (x *PutAttributeSchemaReq) Unmarshal(marshalled []byte) error {
+	return proto.Unmarshal(marshalled, x)
+}
+
+func (x *PutAttributeSchemaReq) Kind() AdministrationAsyncReq_ReqKind {
+	return AdministrationAsyncReq_PutAttributeSchema
+}
+
+func (x *DeleteAttributeSchemaReq) Marshal() ([]byte, error) {
+	return proto.Marshal(x)
+}
+
+func (x *DeleteAttributeSchemaReq) Unmarshal(marshalled []byte) error {
+	return proto.Unmarshal(marshalled, x)
+}
+
+func (x *DeleteAttributeSchemaReq) Kind() AdministrationAsyncReq_ReqKind {
+	return AdministrationAsyncReq_DeleteAttributeSchema
+}
+
+func (x *SetCombiningAlgorithmReq) Marshal() ([]byte, error) {
+	return proto.Marshal(x)
+}
+
+func (x *SetCombiningAlgorithmReq) Unmarshal(marshalled []byte) error {
+	return proto.Unmarshal(marshalled, x)
+}
+
+func (x *SetCombiningAlgorithmReq) Kind() AdministrationAsyncReq_ReqKind {
+	return AdministrationAsyncReq_SetCombiningAlgorithm
+}
+
+func (x *CreatePermissionImplicationReq) Marshal() ([]byte, error) {
+	return proto.Marshal(x)
+}
+
+func (x *CreatePermissionImplicationReq) Unmarshal(marshalled []byte) error {
+	return proto.Unmarshal(marshalled, x)
+}
+
+func (x *CreatePermissionImplicationReq) Kind() AdministrationAsyncReq_ReqKind {
+	return AdministrationAsyncReq_CreatePermissionImplication
+}
+
+func (x *DeletePermissionImplicationReq) Marshal() ([]byte, error) {
+	return proto.Marshal(x)
+}
+
+func (x *DeletePermissionImplicationReq) Unmarshal(marshalled []byte) error {
+	return proto.Unmarshal(marshalled, x)
+}
+
+func (x *DeletePermissionImplicationReq) Kind() AdministrationAsyncReq_ReqKind {
+	return AdministrationAsyncReq_DeletePermissionImplication
+}
+
+func (x *CreateSoDConstraintReq) Marshal() ([]byte, error) {
+	return proto.Marshal(x)
+}
+
+func (x *CreateSoDConstraintReq) Unmarshal(marshalled []byte) error {
+	return proto.Unmarshal(marshalled, x)
+}
+
+func (x *CreateSoDConstraintReq) Kind() AdministrationAsyncReq_ReqKind {
+	return AdministrationAsyncReq_CreateSoDConstraint
+}
+
+func (x *DeleteSoDConstraintReq) Marshal() ([]byte, error) {
+	return proto.Marshal(x)
+}
+
+func (x *DeleteSoDConstraintReq) Unmarshal(marshalled []byte) error {
+	return proto.Unmarshal(marshalled, x)
+}
+
+func (x *DeleteSoDConstraintReq) Kind() AdministrationAsyncReq_ReqKind {
+	return AdministrationAsyncReq_DeleteSoDConstraint
+}
+
+func (x *PutRelationTypeReq) Marshal() ([]byte, error) {
+	return proto.Marshal(x)
+}
+
+func (x *PutRelationTypeReq) Unmarshal(marshalled []byte) error {
+	return proto.Unmarshal(marshalled, x)
+}
+
+func (x *PutRelationTypeReq) Kind() AdministrationAsyncReq_ReqKind {
+	return AdministrationAsyncReq_PutRelationType
+}
+
+func (x *DeleteRelationTypeReq) Marshal() ([]byte, error) {
+	return proto.Marshal(x)
+}
+
+func (x *DeleteRelationTypeReq) Unmarshal(marshalled []byte) error {
+	return proto.Unmarshal(marshalled, x)
+}
+
+func (x *DeleteRelationTypeReq) Kind() AdministrationAsyncReq_ReqKind {
+	return AdministrationAsyncReq_DeleteRelationType
+}
+
+func (x *BatchReq) Marshal() ([]byte, error) {
+	return proto.Marshal(x)
+}
+
+func (x *BatchReq) Unmarshal(marshalled []byte) error {
+	return proto.Unmarshal(marshalled, x)
+}
+
+func (x *BatchReq) Kind() AdministrationAsyncReq_ReqKind {
+	return AdministrationAsyncReq_Batch
+}
+
 func (x *AdministrationAsyncReq) Marshal() ([]byte, error) {
 	return proto.Marshal(x)
 }
@@ -121,3 +241,11 @@ func (x *AdministrationAsyncResp) Marshal() ([]byte, error) {
 func (x *AdministrationAsyncResp) Unmarshal(marshalled []byte) error {
 	return proto.Unmarshal(marshalled, x)
 }
+
+func (x *PoliciesExpiredNotification) Marshal() ([]byte, error) {
+	return proto.Marshal(x)
+}
+
+func (x *PoliciesExpiredNotification) Unmarshal(marshalled []byte) error {
+	return proto.Unmarshal(marshalled, x)
+}
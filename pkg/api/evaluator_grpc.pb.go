@@ -24,6 +24,7 @@ const _ = grpc.SupportPackageIsVersion7
 type OortEvaluatorClient interface {
 	Authorize(ctx context.Context, in *AuthorizationReq, opts ...grpc.CallOption) (*AuthorizationResp, error)
 	GetGrantedPermissions(ctx context.Context, in *GetGrantedPermissionsReq, opts ...grpc.CallOption) (*GetGrantedPermissionsResp, error)
+	BreakGlass(ctx context.Context, in *BreakGlassReq, opts ...grpc.CallOption) (*BreakGlassResp, error)
 }
 
 type oortEvaluatorClient struct {
@@ -52,12 +53,22 @@ func (c *oortEvaluatorClient) GetGrantedPermissions(ctx context.Context, in *Get
 	return out, nil
 }
 
+func (c *oortEvaluatorClient) BreakGlass(ctx context.Context, in *BreakGlassReq, opts ...grpc.CallOption) (*BreakGlassResp, error) {
+	out := new(BreakGlassResp)
+	err := c.cc.Invoke(ctx, "/proto.OortEvaluator/BreakGlass", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OortEvaluatorServer is the server API for OortEvaluator service.
 // All implementations must embed UnimplementedOortEvaluatorServer
 // for forward compatibility
 type OortEvaluatorServer interface {
 	Authorize(context.Context, *AuthorizationReq) (*AuthorizationResp, error)
 	GetGrantedPermissions(context.Context, *GetGrantedPermissionsReq) (*GetGrantedPermissionsResp, error)
+	BreakGlass(context.Context, *BreakGlassReq) (*BreakGlassResp, error)
 	mustEmbedUnimplementedOortEvaluatorServer()
 }
 
@@ -71,6 +82,9 @@ func (UnimplementedOortEvaluatorServer) Authorize(context.Context, *Authorizatio
 func (UnimplementedOortEvaluatorServer) GetGrantedPermissions(context.Context, *GetGrantedPermissionsReq) (*GetGrantedPermissionsResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetGrantedPermissions not implemented")
 }
+func (UnimplementedOortEvaluatorServer) BreakGlass(context.Context, *BreakGlassReq) (*BreakGlassResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BreakGlass not implemented")
+}
 func (UnimplementedOortEvaluatorServer) mustEmbedUnimplementedOortEvaluatorServer() {}
 
 // UnsafeOortEvaluatorServer may be embedded to opt out of forward compatibility for this service.
@@ -120,6 +134,24 @@ func _OortEvaluator_GetGrantedPermissions_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OortEvaluator_BreakGlass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BreakGlassReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OortEvaluatorServer).BreakGlass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.OortEvaluator/BreakGlass",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OortEvaluatorServer).BreakGlass(ctx, req.(*BreakGlassReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // OortEvaluator_ServiceDesc is the grpc.ServiceDesc for OortEvaluator service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -135,6 +167,10 @@ var OortEvaluator_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetGrantedPermissions",
 			Handler:    _OortEvaluator_GetGrantedPermissions_Handler,
 		},
+		{
+			MethodName: "BreakGlass",
+			Handler:    _OortEvaluator_BreakGlass_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "evaluator.proto",
@@ -0,0 +1,13 @@
+package api
+
+import (
+	"google.golang.org/protobuf/proto"
+)
+
+func (x *BreakGlass) Marshal() ([]byte, error) {
+	return proto.Marshal(x)
+}
+
+func (x *BreakGlass) Unmarshal(marshalled []byte) error {
+	return proto.Unmarshal(marshalled, x)
+}
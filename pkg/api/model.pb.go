@@ -9,6 +9,8 @@ package api
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -20,13 +22,72 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type CombiningAlgorithm int32
+
+const (
+	CombiningAlgorithm_DENY_OVERRIDES     CombiningAlgorithm = 0
+	CombiningAlgorithm_PERMIT_OVERRIDES   CombiningAlgorithm = 1
+	CombiningAlgorithm_FIRST_APPLICABLE   CombiningAlgorithm = 2
+	CombiningAlgorithm_DENY_UNLESS_PERMIT CombiningAlgorithm = 3
+	CombiningAlgorithm_PERMIT_UNLESS_DENY CombiningAlgorithm = 4
+)
+
+// Enum value maps for CombiningAlgorithm.
+var (
+	CombiningAlgorithm_name = map[int32]string{
+		0: "DENY_OVERRIDES",
+		1: "PERMIT_OVERRIDES",
+		2: "FIRST_APPLICABLE",
+		3: "DENY_UNLESS_PERMIT",
+		4: "PERMIT_UNLESS_DENY",
+	}
+	CombiningAlgorithm_value = map[string]int32{
+		"DENY_OVERRIDES":     0,
+		"PERMIT_OVERRIDES":   1,
+		"FIRST_APPLICABLE":   2,
+		"DENY_UNLESS_PERMIT": 3,
+		"PERMIT_UNLESS_DENY": 4,
+	}
+)
+
+func (x CombiningAlgorithm) Enum() *CombiningAlgorithm {
+	p := new(CombiningAlgorithm)
+	*p = x
+	return p
+}
+
+func (x CombiningAlgorithm) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CombiningAlgorithm) Descriptor() protoreflect.EnumDescriptor {
+	return file_model_proto_enumTypes[0].Descriptor()
+}
+
+func (CombiningAlgorithm) Type() protoreflect.EnumType {
+	return &file_model_proto_enumTypes[0]
+}
+
+func (x CombiningAlgorithm) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CombiningAlgorithm.Descriptor instead.
+func (CombiningAlgorithm) EnumDescriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{0}
+}
+
 type Attribute_AttributeKind int32
 
 const (
-	Attribute_INT64   Attribute_AttributeKind = 0
-	Attribute_FLOAT64 Attribute_AttributeKind = 1
-	Attribute_STRING  Attribute_AttributeKind = 2
-	Attribute_BOOL    Attribute_AttributeKind = 3
+	Attribute_INT64       Attribute_AttributeKind = 0
+	Attribute_FLOAT64     Attribute_AttributeKind = 1
+	Attribute_STRING      Attribute_AttributeKind = 2
+	Attribute_BOOL        Attribute_AttributeKind = 3
+	Attribute_STRING_LIST Attribute_AttributeKind = 4
+	Attribute_INT64_LIST  Attribute_AttributeKind = 5
+	Attribute_TIMESTAMP   Attribute_AttributeKind = 6
+	Attribute_DURATION    Attribute_AttributeKind = 7
 )
 
 // Enum value maps for Attribute_AttributeKind.
@@ -36,12 +97,20 @@ var (
 		1: "FLOAT64",
 		2: "STRING",
 		3: "BOOL",
+		4: "STRING_LIST",
+		5: "INT64_LIST",
+		6: "TIMESTAMP",
+		7: "DURATION",
 	}
 	Attribute_AttributeKind_value = map[string]int32{
-		"INT64":   0,
-		"FLOAT64": 1,
-		"STRING":  2,
-		"BOOL":    3,
+		"INT64":       0,
+		"FLOAT64":     1,
+		"STRING":      2,
+		"BOOL":        3,
+		"STRING_LIST": 4,
+		"INT64_LIST":  5,
+		"TIMESTAMP":   6,
+		"DURATION":    7,
 	}
 )
 
@@ -56,11 +125,11 @@ func (x Attribute_AttributeKind) String() string {
 }
 
 func (Attribute_AttributeKind) Descriptor() protoreflect.EnumDescriptor {
-	return file_model_proto_enumTypes[0].Descriptor()
+	return file_model_proto_enumTypes[1].Descriptor()
 }
 
 func (Attribute_AttributeKind) Type() protoreflect.EnumType {
-	return &file_model_proto_enumTypes[0]
+	return &file_model_proto_enumTypes[1]
 }
 
 func (x Attribute_AttributeKind) Number() protoreflect.EnumNumber {
@@ -102,11 +171,11 @@ func (x Permission_PermissionKind) String() string {
 }
 
 func (Permission_PermissionKind) Descriptor() protoreflect.EnumDescriptor {
-	return file_model_proto_enumTypes[1].Descriptor()
+	return file_model_proto_enumTypes[2].Descriptor()
 }
 
 func (Permission_PermissionKind) Type() protoreflect.EnumType {
-	return &file_model_proto_enumTypes[1]
+	return &file_model_proto_enumTypes[2]
 }
 
 func (x Permission_PermissionKind) Number() protoreflect.EnumNumber {
@@ -115,7 +184,145 @@ func (x Permission_PermissionKind) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Permission_PermissionKind.Descriptor instead.
 func (Permission_PermissionKind) EnumDescriptor() ([]byte, []int) {
-	return file_model_proto_rawDescGZIP(), []int{8, 0}
+	return file_model_proto_rawDescGZIP(), []int{14, 0}
+}
+
+type Permission_ConditionErrorPolicy int32
+
+const (
+	Permission_SKIP          Permission_ConditionErrorPolicy = 0
+	Permission_DENY_ON_ERROR Permission_ConditionErrorPolicy = 1
+)
+
+// Enum value maps for Permission_ConditionErrorPolicy.
+var (
+	Permission_ConditionErrorPolicy_name = map[int32]string{
+		0: "SKIP",
+		1: "DENY_ON_ERROR",
+	}
+	Permission_ConditionErrorPolicy_value = map[string]int32{
+		"SKIP":          0,
+		"DENY_ON_ERROR": 1,
+	}
+)
+
+func (x Permission_ConditionErrorPolicy) Enum() *Permission_ConditionErrorPolicy {
+	p := new(Permission_ConditionErrorPolicy)
+	*p = x
+	return p
+}
+
+func (x Permission_ConditionErrorPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Permission_ConditionErrorPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_model_proto_enumTypes[3].Descriptor()
+}
+
+func (Permission_ConditionErrorPolicy) Type() protoreflect.EnumType {
+	return &file_model_proto_enumTypes[3]
+}
+
+func (x Permission_ConditionErrorPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Permission_ConditionErrorPolicy.Descriptor instead.
+func (Permission_ConditionErrorPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{14, 1}
+}
+
+type Condition_ConditionLanguage int32
+
+const (
+	Condition_GOVALUATE Condition_ConditionLanguage = 0
+	Condition_CEL       Condition_ConditionLanguage = 1
+)
+
+// Enum value maps for Condition_ConditionLanguage.
+var (
+	Condition_ConditionLanguage_name = map[int32]string{
+		0: "GOVALUATE",
+		1: "CEL",
+	}
+	Condition_ConditionLanguage_value = map[string]int32{
+		"GOVALUATE": 0,
+		"CEL":       1,
+	}
+)
+
+func (x Condition_ConditionLanguage) Enum() *Condition_ConditionLanguage {
+	p := new(Condition_ConditionLanguage)
+	*p = x
+	return p
+}
+
+func (x Condition_ConditionLanguage) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Condition_ConditionLanguage) Descriptor() protoreflect.EnumDescriptor {
+	return file_model_proto_enumTypes[4].Descriptor()
+}
+
+func (Condition_ConditionLanguage) Type() protoreflect.EnumType {
+	return &file_model_proto_enumTypes[4]
+}
+
+func (x Condition_ConditionLanguage) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Condition_ConditionLanguage.Descriptor instead.
+func (Condition_ConditionLanguage) EnumDescriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{17, 0}
+}
+
+type SoDConstraint_SoDKind int32
+
+const (
+	SoDConstraint_STATIC  SoDConstraint_SoDKind = 0
+	SoDConstraint_DYNAMIC SoDConstraint_SoDKind = 1
+)
+
+// Enum value maps for SoDConstraint_SoDKind.
+var (
+	SoDConstraint_SoDKind_name = map[int32]string{
+		0: "STATIC",
+		1: "DYNAMIC",
+	}
+	SoDConstraint_SoDKind_value = map[string]int32{
+		"STATIC":  0,
+		"DYNAMIC": 1,
+	}
+)
+
+func (x SoDConstraint_SoDKind) Enum() *SoDConstraint_SoDKind {
+	p := new(SoDConstraint_SoDKind)
+	*p = x
+	return p
+}
+
+func (x SoDConstraint_SoDKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SoDConstraint_SoDKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_model_proto_enumTypes[5].Descriptor()
+}
+
+func (SoDConstraint_SoDKind) Type() protoreflect.EnumType {
+	return &file_model_proto_enumTypes[5]
+}
+
+func (x SoDConstraint_SoDKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SoDConstraint_SoDKind.Descriptor instead.
+func (SoDConstraint_SoDKind) EnumDescriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{19, 0}
 }
 
 type AttributeId struct {
@@ -173,6 +380,8 @@ type Attribute struct {
 	Id    *AttributeId            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Kind  Attribute_AttributeKind `protobuf:"varint,2,opt,name=kind,proto3,enum=proto.Attribute_AttributeKind" json:"kind,omitempty"`
 	Value []byte                  `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	// inheritable attributes are visible on the descendants that don't define them
+	Inheritable bool `protobuf:"varint,4,opt,name=inheritable,proto3" json:"inheritable,omitempty"`
 }
 
 func (x *Attribute) Reset() {
@@ -228,6 +437,13 @@ func (x *Attribute) GetValue() []byte {
 	return nil
 }
 
+func (x *Attribute) GetInheritable() bool {
+	if x != nil {
+		return x.Inheritable
+	}
+	return false
+}
+
 type AttributeList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -463,17 +679,16 @@ func (x *BoolAttribute) GetValue() bool {
 	return false
 }
 
-type Resource struct {
+type StringListAttribute struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Kind string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Value []string `protobuf:"bytes,1,rep,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *Resource) Reset() {
-	*x = Resource{}
+func (x *StringListAttribute) Reset() {
+	*x = StringListAttribute{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_model_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -481,13 +696,13 @@ func (x *Resource) Reset() {
 	}
 }
 
-func (x *Resource) String() string {
+func (x *StringListAttribute) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Resource) ProtoMessage() {}
+func (*StringListAttribute) ProtoMessage() {}
 
-func (x *Resource) ProtoReflect() protoreflect.Message {
+func (x *StringListAttribute) ProtoReflect() protoreflect.Message {
 	mi := &file_model_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -499,37 +714,28 @@ func (x *Resource) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Resource.ProtoReflect.Descriptor instead.
-func (*Resource) Descriptor() ([]byte, []int) {
+// Deprecated: Use StringListAttribute.ProtoReflect.Descriptor instead.
+func (*StringListAttribute) Descriptor() ([]byte, []int) {
 	return file_model_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *Resource) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *Resource) GetKind() string {
+func (x *StringListAttribute) GetValue() []string {
 	if x != nil {
-		return x.Kind
+		return x.Value
 	}
-	return ""
+	return nil
 }
 
-type Permission struct {
+type Int64ListAttribute struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name      string                    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Kind      Permission_PermissionKind `protobuf:"varint,2,opt,name=kind,proto3,enum=proto.Permission_PermissionKind" json:"kind,omitempty"`
-	Condition *Condition                `protobuf:"bytes,3,opt,name=condition,proto3" json:"condition,omitempty"`
+	Value []int64 `protobuf:"varint,1,rep,packed,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *Permission) Reset() {
-	*x = Permission{}
+func (x *Int64ListAttribute) Reset() {
+	*x = Int64ListAttribute{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_model_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -537,13 +743,13 @@ func (x *Permission) Reset() {
 	}
 }
 
-func (x *Permission) String() string {
+func (x *Int64ListAttribute) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Permission) ProtoMessage() {}
+func (*Int64ListAttribute) ProtoMessage() {}
 
-func (x *Permission) ProtoReflect() protoreflect.Message {
+func (x *Int64ListAttribute) ProtoReflect() protoreflect.Message {
 	mi := &file_model_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -555,57 +761,90 @@ func (x *Permission) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Permission.ProtoReflect.Descriptor instead.
-func (*Permission) Descriptor() ([]byte, []int) {
+// Deprecated: Use Int64ListAttribute.ProtoReflect.Descriptor instead.
+func (*Int64ListAttribute) Descriptor() ([]byte, []int) {
 	return file_model_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *Permission) GetName() string {
+func (x *Int64ListAttribute) GetValue() []int64 {
 	if x != nil {
-		return x.Name
+		return x.Value
 	}
-	return ""
+	return nil
 }
 
-func (x *Permission) GetKind() Permission_PermissionKind {
-	if x != nil {
-		return x.Kind
+type TimestampAttribute struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *TimestampAttribute) Reset() {
+	*x = TimestampAttribute{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_model_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return Permission_ALLOW
 }
 
-func (x *Permission) GetCondition() *Condition {
+func (x *TimestampAttribute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimestampAttribute) ProtoMessage() {}
+
+func (x *TimestampAttribute) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimestampAttribute.ProtoReflect.Descriptor instead.
+func (*TimestampAttribute) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *TimestampAttribute) GetValue() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Condition
+		return x.Value
 	}
 	return nil
 }
 
-type Condition struct {
+type DurationAttribute struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Expression string `protobuf:"bytes,1,opt,name=expression,proto3" json:"expression,omitempty"`
+	Value *durationpb.Duration `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *Condition) Reset() {
-	*x = Condition{}
+func (x *DurationAttribute) Reset() {
+	*x = DurationAttribute{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_model_proto_msgTypes[9]
+		mi := &file_model_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Condition) String() string {
+func (x *DurationAttribute) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Condition) ProtoMessage() {}
+func (*DurationAttribute) ProtoMessage() {}
 
-func (x *Condition) ProtoReflect() protoreflect.Message {
-	mi := &file_model_proto_msgTypes[9]
+func (x *DurationAttribute) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -616,44 +855,47 @@ func (x *Condition) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Condition.ProtoReflect.Descriptor instead.
-func (*Condition) Descriptor() ([]byte, []int) {
-	return file_model_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use DurationAttribute.ProtoReflect.Descriptor instead.
+func (*DurationAttribute) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *Condition) GetExpression() string {
+func (x *DurationAttribute) GetValue() *durationpb.Duration {
 	if x != nil {
-		return x.Expression
+		return x.Value
 	}
-	return ""
+	return nil
 }
 
-type GrantedPermission struct {
+type AttributeDefinition struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name   string    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Object *Resource `protobuf:"bytes,2,opt,name=object,proto3" json:"object,omitempty"`
+	Name     string                  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Kind     Attribute_AttributeKind `protobuf:"varint,2,opt,name=kind,proto3,enum=proto.Attribute_AttributeKind" json:"kind,omitempty"`
+	Required bool                    `protobuf:"varint,3,opt,name=required,proto3" json:"required,omitempty"`
+	// optional, its kind must match the definition kind
+	Default *Attribute `protobuf:"bytes,4,opt,name=default,proto3" json:"default,omitempty"`
 }
 
-func (x *GrantedPermission) Reset() {
-	*x = GrantedPermission{}
+func (x *AttributeDefinition) Reset() {
+	*x = AttributeDefinition{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_model_proto_msgTypes[10]
+		mi := &file_model_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GrantedPermission) String() string {
+func (x *AttributeDefinition) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GrantedPermission) ProtoMessage() {}
+func (*AttributeDefinition) ProtoMessage() {}
 
-func (x *GrantedPermission) ProtoReflect() protoreflect.Message {
-	mi := &file_model_proto_msgTypes[10]
+func (x *AttributeDefinition) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -664,131 +906,917 @@ func (x *GrantedPermission) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GrantedPermission.ProtoReflect.Descriptor instead.
-func (*GrantedPermission) Descriptor() ([]byte, []int) {
-	return file_model_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use AttributeDefinition.ProtoReflect.Descriptor instead.
+func (*AttributeDefinition) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *GrantedPermission) GetName() string {
+func (x *AttributeDefinition) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *GrantedPermission) GetObject() *Resource {
+func (x *AttributeDefinition) GetKind() Attribute_AttributeKind {
 	if x != nil {
-		return x.Object
+		return x.Kind
 	}
-	return nil
+	return Attribute_INT64
 }
 
-var File_model_proto protoreflect.FileDescriptor
+func (x *AttributeDefinition) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
 
-var file_model_proto_rawDesc = []byte{
-	0x0a, 0x0b, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x21, 0x0a, 0x0b, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
-	0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xb8, 0x01, 0x0a, 0x09, 0x41, 0x74, 0x74, 0x72,
-	0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
-	0x75, 0x74, 0x65, 0x49, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12, 0x32, 0x0a, 0x04, 0x6b, 0x69, 0x6e,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
-	0x75, 0x74, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x22, 0x3d, 0x0a, 0x0d, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
-	0x4b, 0x69, 0x6e, 0x64, 0x12, 0x09, 0x0a, 0x05, 0x49, 0x4e, 0x54, 0x36, 0x34, 0x10, 0x00, 0x12,
-	0x0b, 0x0a, 0x07, 0x46, 0x4c, 0x4f, 0x41, 0x54, 0x36, 0x34, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06,
-	0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x42, 0x4f, 0x4f, 0x4c,
-	0x10, 0x03, 0x22, 0x41, 0x0a, 0x0d, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x4c,
-	0x69, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69,
-	0x62, 0x75, 0x74, 0x65, 0x73, 0x22, 0x26, 0x0a, 0x0e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x41, 0x74,
-	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x28, 0x0a,
-	0x10, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x36, 0x34, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x27, 0x0a, 0x0f, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x22, 0x25, 0x0a, 0x0d, 0x42, 0x6f, 0x6f, 0x6c, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x22, 0xad, 0x01, 0x0a, 0x0a, 0x50, 0x65, 0x72, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x04, 0x6b, 0x69,
-	0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x50, 0x65, 0x72, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64,
-	0x12, 0x2e, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x64,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x22, 0x25, 0x0a, 0x0e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4b, 0x69,
-	0x6e, 0x64, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x10, 0x00, 0x12, 0x08, 0x0a,
-	0x04, 0x44, 0x45, 0x4e, 0x59, 0x10, 0x01, 0x22, 0x2b, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x64, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x50, 0x0a, 0x11, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x50,
-	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a,
-	0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06,
-	0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x1e, 0x5a, 0x1c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x31, 0x32, 0x73, 0x2f, 0x6f, 0x6f, 0x72, 0x74, 0x2f, 0x70,
-	0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *AttributeDefinition) GetDefault() *Attribute {
+	if x != nil {
+		return x.Default
+	}
+	return nil
 }
 
-var (
-	file_model_proto_rawDescOnce sync.Once
-	file_model_proto_rawDescData = file_model_proto_rawDesc
-)
+type AttributeSchema struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func file_model_proto_rawDescGZIP() []byte {
-	file_model_proto_rawDescOnce.Do(func() {
-		file_model_proto_rawDescData = protoimpl.X.CompressGZIP(file_model_proto_rawDescData)
-	})
-	return file_model_proto_rawDescData
+	ResourceKind string                 `protobuf:"bytes,1,opt,name=resourceKind,proto3" json:"resourceKind,omitempty"`
+	Attributes   []*AttributeDefinition `protobuf:"bytes,2,rep,name=attributes,proto3" json:"attributes,omitempty"`
 }
 
-var file_model_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_model_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
-var file_model_proto_goTypes = []interface{}{
-	(Attribute_AttributeKind)(0),   // 0: proto.Attribute.AttributeKind
-	(Permission_PermissionKind)(0), // 1: proto.Permission.PermissionKind
-	(*AttributeId)(nil),            // 2: proto.AttributeId
-	(*Attribute)(nil),              // 3: proto.Attribute
-	(*AttributeList)(nil),          // 4: proto.AttributeList
-	(*Int64Attribute)(nil),         // 5: proto.Int64Attribute
-	(*Float64Attribute)(nil),       // 6: proto.Float64Attribute
-	(*StringAttribute)(nil),        // 7: proto.StringAttribute
-	(*BoolAttribute)(nil),          // 8: proto.BoolAttribute
-	(*Resource)(nil),               // 9: proto.Resource
-	(*Permission)(nil),             // 10: proto.Permission
-	(*Condition)(nil),              // 11: proto.Condition
-	(*GrantedPermission)(nil),      // 12: proto.GrantedPermission
+func (x *AttributeSchema) Reset() {
+	*x = AttributeSchema{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_model_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
-var file_model_proto_depIdxs = []int32{
-	2,  // 0: proto.Attribute.id:type_name -> proto.AttributeId
-	0,  // 1: proto.Attribute.kind:type_name -> proto.Attribute.AttributeKind
-	3,  // 2: proto.AttributeList.attributes:type_name -> proto.Attribute
-	1,  // 3: proto.Permission.kind:type_name -> proto.Permission.PermissionKind
-	11, // 4: proto.Permission.condition:type_name -> proto.Condition
-	9,  // 5: proto.GrantedPermission.object:type_name -> proto.Resource
-	6,  // [6:6] is the sub-list for method output_type
-	6,  // [6:6] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+
+func (x *AttributeSchema) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func init() { file_model_proto_init() }
-func file_model_proto_init() {
-	if File_model_proto != nil {
-		return
+func (*AttributeSchema) ProtoMessage() {}
+
+func (x *AttributeSchema) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttributeSchema.ProtoReflect.Descriptor instead.
+func (*AttributeSchema) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AttributeSchema) GetResourceKind() string {
+	if x != nil {
+		return x.ResourceKind
+	}
+	return ""
+}
+
+func (x *AttributeSchema) GetAttributes() []*AttributeDefinition {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type Resource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+}
+
+func (x *Resource) Reset() {
+	*x = Resource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_model_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Resource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Resource) ProtoMessage() {}
+
+func (x *Resource) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Resource.ProtoReflect.Descriptor instead.
+func (*Resource) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Resource) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Resource) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+type Permission struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name             string                          `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Kind             Permission_PermissionKind       `protobuf:"varint,2,opt,name=kind,proto3,enum=proto.Permission_PermissionKind" json:"kind,omitempty"`
+	Condition        *Condition                      `protobuf:"bytes,3,opt,name=condition,proto3" json:"condition,omitempty"`
+	OnConditionError Permission_ConditionErrorPolicy `protobuf:"varint,4,opt,name=onConditionError,proto3,enum=proto.Permission_ConditionErrorPolicy" json:"onConditionError,omitempty"`
+	Obligations      []*Obligation                   `protobuf:"bytes,5,rep,name=obligations,proto3" json:"obligations,omitempty"`
+}
+
+func (x *Permission) Reset() {
+	*x = Permission{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_model_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Permission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Permission) ProtoMessage() {}
+
+func (x *Permission) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Permission.ProtoReflect.Descriptor instead.
+func (*Permission) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Permission) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Permission) GetKind() Permission_PermissionKind {
+	if x != nil {
+		return x.Kind
+	}
+	return Permission_ALLOW
+}
+
+func (x *Permission) GetCondition() *Condition {
+	if x != nil {
+		return x.Condition
+	}
+	return nil
+}
+
+func (x *Permission) GetOnConditionError() Permission_ConditionErrorPolicy {
+	if x != nil {
+		return x.OnConditionError
+	}
+	return Permission_SKIP
+}
+
+func (x *Permission) GetObligations() []*Obligation {
+	if x != nil {
+		return x.Obligations
+	}
+	return nil
+}
+
+type Obligation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Params map[string]string `protobuf:"bytes,2,rep,name=params,proto3" json:"params,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Advice bool              `protobuf:"varint,3,opt,name=advice,proto3" json:"advice,omitempty"`
+}
+
+func (x *Obligation) Reset() {
+	*x = Obligation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_model_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Obligation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Obligation) ProtoMessage() {}
+
+func (x *Obligation) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Obligation.ProtoReflect.Descriptor instead.
+func (*Obligation) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Obligation) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Obligation) GetParams() map[string]string {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *Obligation) GetAdvice() bool {
+	if x != nil {
+		return x.Advice
+	}
+	return false
+}
+
+type ConditionError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PermissionName   string `protobuf:"bytes,1,opt,name=permissionName,proto3" json:"permissionName,omitempty"`
+	MissingAttribute string `protobuf:"bytes,2,opt,name=missingAttribute,proto3" json:"missingAttribute,omitempty"`
+	Message          string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ConditionError) Reset() {
+	*x = ConditionError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_model_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConditionError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConditionError) ProtoMessage() {}
+
+func (x *ConditionError) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConditionError.ProtoReflect.Descriptor instead.
+func (*ConditionError) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ConditionError) GetPermissionName() string {
+	if x != nil {
+		return x.PermissionName
+	}
+	return ""
+}
+
+func (x *ConditionError) GetMissingAttribute() string {
+	if x != nil {
+		return x.MissingAttribute
+	}
+	return ""
+}
+
+func (x *ConditionError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type Condition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Expression string                      `protobuf:"bytes,1,opt,name=expression,proto3" json:"expression,omitempty"`
+	Language   Condition_ConditionLanguage `protobuf:"varint,2,opt,name=language,proto3,enum=proto.Condition_ConditionLanguage" json:"language,omitempty"`
+}
+
+func (x *Condition) Reset() {
+	*x = Condition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_model_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Condition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Condition) ProtoMessage() {}
+
+func (x *Condition) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Condition.ProtoReflect.Descriptor instead.
+func (*Condition) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Condition) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *Condition) GetLanguage() Condition_ConditionLanguage {
+	if x != nil {
+		return x.Language
+	}
+	return Condition_GOVALUATE
+}
+
+type GrantedPermission struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Object      *Resource     `protobuf:"bytes,2,opt,name=object,proto3" json:"object,omitempty"`
+	Obligations []*Obligation `protobuf:"bytes,3,rep,name=obligations,proto3" json:"obligations,omitempty"`
+}
+
+func (x *GrantedPermission) Reset() {
+	*x = GrantedPermission{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_model_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GrantedPermission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantedPermission) ProtoMessage() {}
+
+func (x *GrantedPermission) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantedPermission.ProtoReflect.Descriptor instead.
+func (*GrantedPermission) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GrantedPermission) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GrantedPermission) GetObject() *Resource {
+	if x != nil {
+		return x.Object
+	}
+	return nil
+}
+
+func (x *GrantedPermission) GetObligations() []*Obligation {
+	if x != nil {
+		return x.Obligations
+	}
+	return nil
+}
+
+type SoDConstraint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string                `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Kind        SoDConstraint_SoDKind `protobuf:"varint,2,opt,name=kind,proto3,enum=proto.SoDConstraint_SoDKind" json:"kind,omitempty"`
+	Permissions []string              `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty"`
+}
+
+func (x *SoDConstraint) Reset() {
+	*x = SoDConstraint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_model_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SoDConstraint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SoDConstraint) ProtoMessage() {}
+
+func (x *SoDConstraint) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SoDConstraint.ProtoReflect.Descriptor instead.
+func (*SoDConstraint) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SoDConstraint) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SoDConstraint) GetKind() SoDConstraint_SoDKind {
+	if x != nil {
+		return x.Kind
+	}
+	return SoDConstraint_STATIC
+}
+
+func (x *SoDConstraint) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+type SoDViolation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Constraint string    `protobuf:"bytes,1,opt,name=constraint,proto3" json:"constraint,omitempty"`
+	Subject    *Resource `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	Object     *Resource `protobuf:"bytes,3,opt,name=object,proto3" json:"object,omitempty"`
+}
+
+func (x *SoDViolation) Reset() {
+	*x = SoDViolation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_model_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SoDViolation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SoDViolation) ProtoMessage() {}
+
+func (x *SoDViolation) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SoDViolation.ProtoReflect.Descriptor instead.
+func (*SoDViolation) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SoDViolation) GetConstraint() string {
+	if x != nil {
+		return x.Constraint
+	}
+	return ""
+}
+
+func (x *SoDViolation) GetSubject() *Resource {
+	if x != nil {
+		return x.Subject
+	}
+	return nil
+}
+
+func (x *SoDViolation) GetObject() *Resource {
+	if x != nil {
+		return x.Object
+	}
+	return nil
+}
+
+type RelationType struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name                  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	PropagatesPermissions bool   `protobuf:"varint,2,opt,name=propagatesPermissions,proto3" json:"propagatesPermissions,omitempty"`
+	PropagatesAttributes  bool   `protobuf:"varint,3,opt,name=propagatesAttributes,proto3" json:"propagatesAttributes,omitempty"`
+}
+
+func (x *RelationType) Reset() {
+	*x = RelationType{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_model_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RelationType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelationType) ProtoMessage() {}
+
+func (x *RelationType) ProtoReflect() protoreflect.Message {
+	mi := &file_model_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelationType.ProtoReflect.Descriptor instead.
+func (*RelationType) Descriptor() ([]byte, []int) {
+	return file_model_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RelationType) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RelationType) GetPropagatesPermissions() bool {
+	if x != nil {
+		return x.PropagatesPermissions
+	}
+	return false
+}
+
+func (x *RelationType) GetPropagatesAttributes() bool {
+	if x != nil {
+		return x.PropagatesAttributes
+	}
+	return false
+}
+
+var File_model_proto protoreflect.FileDescriptor
+
+var file_model_proto_rawDesc = []byte{
+	0x0a, 0x0b, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x21, 0x0a, 0x0b, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x98, 0x02, 0x0a, 0x09, 0x41, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x49, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12, 0x32, 0x0a, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e, 0x68, 0x65, 0x72, 0x69, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x6e, 0x68, 0x65, 0x72,
+	0x69, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x7b, 0x0a, 0x0d, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x09, 0x0a, 0x05, 0x49, 0x4e, 0x54, 0x36, 0x34,
+	0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x46, 0x4c, 0x4f, 0x41, 0x54, 0x36, 0x34, 0x10, 0x01, 0x12,
+	0x0a, 0x0a, 0x06, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x42,
+	0x4f, 0x4f, 0x4c, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x5f,
+	0x4c, 0x49, 0x53, 0x54, 0x10, 0x04, 0x12, 0x0e, 0x0a, 0x0a, 0x49, 0x4e, 0x54, 0x36, 0x34, 0x5f,
+	0x4c, 0x49, 0x53, 0x54, 0x10, 0x05, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x49, 0x4d, 0x45, 0x53, 0x54,
+	0x41, 0x4d, 0x50, 0x10, 0x06, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x55, 0x52, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x10, 0x07, 0x22, 0x41, 0x0a, 0x0d, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x22, 0x26, 0x0a, 0x0e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x28,
+	0x0a, 0x10, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x36, 0x34, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x27, 0x0a, 0x0f, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x22, 0x25, 0x0a, 0x0d, 0x42, 0x6f, 0x6f, 0x6c, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x2b, 0x0a, 0x13, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x2a, 0x0a, 0x12, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x4c, 0x69,
+	0x73, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x22, 0x46, 0x0a, 0x12, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x41, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x44, 0x0a, 0x11, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x2f,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
+	0xa5, 0x01, 0x0a, 0x13, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x44, 0x65, 0x66,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x04, 0x6b,
+	0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x2e, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x07, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x07,
+	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22, 0x71, 0x0a, 0x0f, 0x41, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x3a,
+	0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a,
+	0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x22, 0x2e, 0x0a, 0x08, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x22, 0xeb, 0x02, 0x0a, 0x0a, 0x50,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x50,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b,
+	0x69, 0x6e, 0x64, 0x12, 0x2e, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43,
+	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x52, 0x0a, 0x10, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x10, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x33, 0x0a, 0x0b, 0x6f, 0x62, 0x6c, 0x69, 0x67,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x62, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0b, 0x6f, 0x62, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x25, 0x0a, 0x0e,
+	0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x09,
+	0x0a, 0x05, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x45, 0x4e,
+	0x59, 0x10, 0x01, 0x22, 0x33, 0x0a, 0x14, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x08, 0x0a, 0x04, 0x53,
+	0x4b, 0x49, 0x50, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x44, 0x45, 0x4e, 0x59, 0x5f, 0x4f, 0x4e,
+	0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x01, 0x22, 0xaa, 0x01, 0x0a, 0x0a, 0x4f, 0x62, 0x6c,
+	0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x06, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x62, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x64, 0x76, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x61, 0x64, 0x76, 0x69, 0x63, 0x65, 0x1a, 0x39, 0x0a, 0x0b, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x7e, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x0e, 0x70, 0x65, 0x72, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x2a, 0x0a, 0x10, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6e, 0x67, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x98, 0x01, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x3e, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f,
+	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75,
+	0x61, 0x67, 0x65, 0x22, 0x2b, 0x0a, 0x11, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x47, 0x4f, 0x56, 0x41,
+	0x4c, 0x55, 0x41, 0x54, 0x45, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x43, 0x45, 0x4c, 0x10, 0x01,
+	0x22, 0x85, 0x01, 0x0a, 0x11, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x50, 0x65, 0x72, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x6f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x6f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x12, 0x33, 0x0a, 0x0b, 0x6f, 0x62, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x4f, 0x62, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x6f, 0x62, 0x6c,
+	0x69, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x9b, 0x01, 0x0a, 0x0d, 0x53, 0x6f, 0x44,
+	0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x30,
+	0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x6f, 0x44, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69,
+	0x6e, 0x74, 0x2e, 0x53, 0x6f, 0x44, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x12, 0x20, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0x22, 0x0a, 0x07, 0x53, 0x6f, 0x44, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x0a, 0x0a,
+	0x06, 0x53, 0x54, 0x41, 0x54, 0x49, 0x43, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x59, 0x4e,
+	0x41, 0x4d, 0x49, 0x43, 0x10, 0x01, 0x22, 0x82, 0x01, 0x0a, 0x0c, 0x53, 0x6f, 0x44, 0x56, 0x69,
+	0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x73, 0x74,
+	0x72, 0x61, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e,
+	0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x12, 0x27, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x52, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x8c, 0x01, 0x0a, 0x0c,
+	0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x34, 0x0a, 0x15, 0x70, 0x72, 0x6f, 0x70, 0x61, 0x67, 0x61, 0x74, 0x65, 0x73, 0x50, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x15, 0x70, 0x72, 0x6f, 0x70, 0x61, 0x67, 0x61, 0x74, 0x65, 0x73, 0x50, 0x65, 0x72, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x32, 0x0a, 0x14, 0x70, 0x72, 0x6f, 0x70, 0x61, 0x67,
+	0x61, 0x74, 0x65, 0x73, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x70, 0x72, 0x6f, 0x70, 0x61, 0x67, 0x61, 0x74, 0x65, 0x73,
+	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x2a, 0x84, 0x01, 0x0a, 0x12, 0x43,
+	0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68,
+	0x6d, 0x12, 0x12, 0x0a, 0x0e, 0x44, 0x45, 0x4e, 0x59, 0x5f, 0x4f, 0x56, 0x45, 0x52, 0x52, 0x49,
+	0x44, 0x45, 0x53, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x54, 0x5f,
+	0x4f, 0x56, 0x45, 0x52, 0x52, 0x49, 0x44, 0x45, 0x53, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x46,
+	0x49, 0x52, 0x53, 0x54, 0x5f, 0x41, 0x50, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x42, 0x4c, 0x45, 0x10,
+	0x02, 0x12, 0x16, 0x0a, 0x12, 0x44, 0x45, 0x4e, 0x59, 0x5f, 0x55, 0x4e, 0x4c, 0x45, 0x53, 0x53,
+	0x5f, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x54, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x45, 0x52,
+	0x4d, 0x49, 0x54, 0x5f, 0x55, 0x4e, 0x4c, 0x45, 0x53, 0x53, 0x5f, 0x44, 0x45, 0x4e, 0x59, 0x10,
+	0x04, 0x42, 0x1e, 0x5a, 0x1c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x63, 0x31, 0x32, 0x73, 0x2f, 0x6f, 0x6f, 0x72, 0x74, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70,
+	0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_model_proto_rawDescOnce sync.Once
+	file_model_proto_rawDescData = file_model_proto_rawDesc
+)
+
+func file_model_proto_rawDescGZIP() []byte {
+	file_model_proto_rawDescOnce.Do(func() {
+		file_model_proto_rawDescData = protoimpl.X.CompressGZIP(file_model_proto_rawDescData)
+	})
+	return file_model_proto_rawDescData
+}
+
+var file_model_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_model_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
+var file_model_proto_goTypes = []interface{}{
+	(CombiningAlgorithm)(0),              // 0: proto.CombiningAlgorithm
+	(Attribute_AttributeKind)(0),         // 1: proto.Attribute.AttributeKind
+	(Permission_PermissionKind)(0),       // 2: proto.Permission.PermissionKind
+	(Permission_ConditionErrorPolicy)(0), // 3: proto.Permission.ConditionErrorPolicy
+	(Condition_ConditionLanguage)(0),     // 4: proto.Condition.ConditionLanguage
+	(SoDConstraint_SoDKind)(0),           // 5: proto.SoDConstraint.SoDKind
+	(*AttributeId)(nil),                  // 6: proto.AttributeId
+	(*Attribute)(nil),                    // 7: proto.Attribute
+	(*AttributeList)(nil),                // 8: proto.AttributeList
+	(*Int64Attribute)(nil),               // 9: proto.Int64Attribute
+	(*Float64Attribute)(nil),             // 10: proto.Float64Attribute
+	(*StringAttribute)(nil),              // 11: proto.StringAttribute
+	(*BoolAttribute)(nil),                // 12: proto.BoolAttribute
+	(*StringListAttribute)(nil),          // 13: proto.StringListAttribute
+	(*Int64ListAttribute)(nil),           // 14: proto.Int64ListAttribute
+	(*TimestampAttribute)(nil),           // 15: proto.TimestampAttribute
+	(*DurationAttribute)(nil),            // 16: proto.DurationAttribute
+	(*AttributeDefinition)(nil),          // 17: proto.AttributeDefinition
+	(*AttributeSchema)(nil),              // 18: proto.AttributeSchema
+	(*Resource)(nil),                     // 19: proto.Resource
+	(*Permission)(nil),                   // 20: proto.Permission
+	(*Obligation)(nil),                   // 21: proto.Obligation
+	(*ConditionError)(nil),               // 22: proto.ConditionError
+	(*Condition)(nil),                    // 23: proto.Condition
+	(*GrantedPermission)(nil),            // 24: proto.GrantedPermission
+	(*SoDConstraint)(nil),                // 25: proto.SoDConstraint
+	(*SoDViolation)(nil),                 // 26: proto.SoDViolation
+	(*RelationType)(nil),                 // 27: proto.RelationType
+	nil,                                  // 28: proto.Obligation.ParamsEntry
+	(*timestamppb.Timestamp)(nil),        // 29: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),          // 30: google.protobuf.Duration
+}
+var file_model_proto_depIdxs = []int32{
+	6,  // 0: proto.Attribute.id:type_name -> proto.AttributeId
+	1,  // 1: proto.Attribute.kind:type_name -> proto.Attribute.AttributeKind
+	7,  // 2: proto.AttributeList.attributes:type_name -> proto.Attribute
+	29, // 3: proto.TimestampAttribute.value:type_name -> google.protobuf.Timestamp
+	30, // 4: proto.DurationAttribute.value:type_name -> google.protobuf.Duration
+	1,  // 5: proto.AttributeDefinition.kind:type_name -> proto.Attribute.AttributeKind
+	7,  // 6: proto.AttributeDefinition.default:type_name -> proto.Attribute
+	17, // 7: proto.AttributeSchema.attributes:type_name -> proto.AttributeDefinition
+	2,  // 8: proto.Permission.kind:type_name -> proto.Permission.PermissionKind
+	23, // 9: proto.Permission.condition:type_name -> proto.Condition
+	3,  // 10: proto.Permission.onConditionError:type_name -> proto.Permission.ConditionErrorPolicy
+	21, // 11: proto.Permission.obligations:type_name -> proto.Obligation
+	28, // 12: proto.Obligation.params:type_name -> proto.Obligation.ParamsEntry
+	4,  // 13: proto.Condition.language:type_name -> proto.Condition.ConditionLanguage
+	19, // 14: proto.GrantedPermission.object:type_name -> proto.Resource
+	21, // 15: proto.GrantedPermission.obligations:type_name -> proto.Obligation
+	5,  // 16: proto.SoDConstraint.kind:type_name -> proto.SoDConstraint.SoDKind
+	19, // 17: proto.SoDViolation.subject:type_name -> proto.Resource
+	19, // 18: proto.SoDViolation.object:type_name -> proto.Resource
+	19, // [19:19] is the sub-list for method output_type
+	19, // [19:19] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
+}
+
+func init() { file_model_proto_init() }
+func file_model_proto_init() {
+	if File_model_proto != nil {
+		return
 	}
 	if !protoimpl.UnsafeEnabled {
 		file_model_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
@@ -876,7 +1904,7 @@ func file_model_proto_init() {
 			}
 		}
 		file_model_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Resource); i {
+			switch v := v.(*StringListAttribute); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -888,7 +1916,7 @@ func file_model_proto_init() {
 			}
 		}
 		file_model_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Permission); i {
+			switch v := v.(*Int64ListAttribute); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -900,7 +1928,7 @@ func file_model_proto_init() {
 			}
 		}
 		file_model_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Condition); i {
+			switch v := v.(*TimestampAttribute); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -912,6 +1940,102 @@ func file_model_proto_init() {
 			}
 		}
 		file_model_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DurationAttribute); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_model_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttributeDefinition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_model_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttributeSchema); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_model_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Resource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_model_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Permission); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_model_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Obligation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_model_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConditionError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_model_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Condition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_model_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GrantedPermission); i {
 			case 0:
 				return &v.state
@@ -923,14 +2047,50 @@ func file_model_proto_init() {
 				return nil
 			}
 		}
+		file_model_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SoDConstraint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_model_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SoDViolation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_model_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RelationType); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_model_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   11,
+			NumEnums:      6,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
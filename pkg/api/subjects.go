@@ -2,4 +2,6 @@ package api
 
 const (
 	AdministrationReqSubject = "oort.administration"
+	PoliciesExpiredSubject   = "oort.policies.expired"
+	BreakGlassSubject        = "oort.breakglass"
 )
@@ -1,688 +1,226 @@
 package test
 
-//import (
-//	"github.com/c12s/oort/cmd/domain/repos/acl"
-//	neo4jstore "github.com/c12s/oort/cmd/repos/rhabac/neo4j"
-//)
-//
-//var (
-//	AclStore  acl.Store
-//	TxManager *neo4jstore.TransactionManager
-//)
-
-//
-//func TestResourceConnections(t *testing.T) {
-//	setUpAclStore(t)
-//
-//	org := model.NewResource("org1", "org")
-//	group := model.NewResource("g1", "group")
-//	user := model.NewResource("u1", "user")
-//
-//	t.Run("Successfully connect nonexistent resources", func(t *testing.T) {
-//		parent := org
-//		child := group
-//
-//		parentStored := aclStore.GetResource(rhabac.GetResourceReq{
-//			Id:   parent.Id(),
-//			Kind: parent.Kind(),
-//		})
-//		assert.Nil(t, parentStored.Resource)
-//		assert.ErrorIs(t, parentStored.Error, rhabac.ErrNotFound)
-//		childStored := aclStore.GetResource(rhabac.GetResourceReq{
-//			Id:   child.Id(),
-//			Kind: child.Kind(),
-//		})
-//		assert.Nil(t, childStored.Resource)
-//		assert.ErrorIs(t, childStored.Error, rhabac.ErrNotFound)
-//
-//		req := syncer.ConnectResourcesReq{
-//			ReqId:  "1",
-//			Parent: parent,
-//			Child:  child,
-//		}
-//		resp := aclStore.ConnectResources(rhabac.ConnectResourcesReq{
-//			Parent:   req.Parent,
-//			Child:    req.Child,
-//			Callback: outboxMessageCallback(req),
-//		})
-//		assert.Nil(t, resp.Error)
-//
-//		parentStored = aclStore.GetResource(rhabac.GetResourceReq{
-//			Id:   parent.Id(),
-//			Kind: parent.Kind(),
-//		})
-//		assert.Nil(t, parentStored.Error)
-//		assert.Equal(t, parent.Id(), parentStored.Resource.Id())
-//		assert.Equal(t, parent.Kind(), parentStored.Resource.Kind())
-//		childStored = aclStore.GetResource(rhabac.GetResourceReq{
-//			Id:   child.Id(),
-//			Kind: child.Kind(),
-//		})
-//		assert.Nil(t, childStored.Error)
-//		assert.Equal(t, child.Id(), childStored.Resource.Id())
-//		assert.Equal(t, child.Kind(), childStored.Resource.Kind())
-//	})
-//
-//	t.Run("Successfully connect nonexistent resources", func(t *testing.T) {
-//		parent := group
-//		child := user
-//
-//		parentStored := aclStore.GetResource(rhabac.GetResourceReq{
-//			Id:   parent.Id(),
-//			Kind: parent.Kind(),
-//		})
-//		assert.Nil(t, parentStored.Error)
-//		assert.Equal(t, parent.Id(), parentStored.Resource.Id())
-//		assert.Equal(t, parent.Kind(), parentStored.Resource.Kind())
-//		childStored := aclStore.GetResource(rhabac.GetResourceReq{
-//			Id:   child.Id(),
-//			Kind: child.Kind(),
-//		})
-//		assert.Nil(t, childStored.Resource)
-//		assert.ErrorIs(t, childStored.Error, rhabac.ErrNotFound)
-//
-//		req := syncer.ConnectResourcesReq{
-//			ReqId:  "1",
-//			Parent: parent,
-//			Child:  child,
-//		}
-//		resp := aclStore.ConnectResources(rhabac.ConnectResourcesReq{
-//			Parent:   req.Parent,
-//			Child:    req.Child,
-//			Callback: outboxMessageCallback(req),
-//		})
-//		assert.Nil(t, resp.Error)
-//
-//		parentStored = aclStore.GetResource(rhabac.GetResourceReq{
-//			Id:   parent.Id(),
-//			Kind: parent.Kind(),
-//		})
-//		assert.Nil(t, parentStored.Error)
-//		assert.Equal(t, parent.Id(), parentStored.Resource.Id())
-//		assert.Equal(t, parent.Kind(), parentStored.Resource.Kind())
-//		childStored = aclStore.GetResource(rhabac.GetResourceReq{
-//			Id:   child.Id(),
-//			Kind: child.Kind(),
-//		})
-//		assert.Nil(t, childStored.Error)
-//		assert.Equal(t, child.Id(), childStored.Resource.Id())
-//		assert.Equal(t, child.Kind(), childStored.Resource.Kind())
-//	})
-//
-//	t.Run("Successfully connect existing resources", func(t *testing.T) {
-//		parent := org
-//		child := user
-//
-//		parentStored := aclStore.GetResource(rhabac.GetResourceReq{
-//			Id:   parent.Id(),
-//			Kind: parent.Kind(),
-//		})
-//		assert.Nil(t, parentStored.Error)
-//		assert.Equal(t, parent.Id(), parentStored.Resource.Id())
-//		assert.Equal(t, parent.Kind(), parentStored.Resource.Kind())
-//		childStored := aclStore.GetResource(rhabac.GetResourceReq{
-//			Id:   child.Id(),
-//			Kind: child.Kind(),
-//		})
-//		assert.Nil(t, childStored.Error)
-//		assert.Equal(t, child.Id(), childStored.Resource.Id())
-//		assert.Equal(t, child.Kind(), childStored.Resource.Kind())
-//
-//		req := syncer.ConnectResourcesReq{
-//			ReqId:  "1",
-//			Parent: parent,
-//			Child:  child,
-//		}
-//		resp := aclStore.ConnectResources(rhabac.ConnectResourcesReq{
-//			Parent:   req.Parent,
-//			Child:    req.Child,
-//			Callback: outboxMessageCallback(req),
-//		})
-//		assert.Nil(t, resp.Error)
-//
-//		parentStored = aclStore.GetResource(rhabac.GetResourceReq{
-//			Id:   parent.Id(),
-//			Kind: parent.Kind(),
-//		})
-//		assert.Nil(t, parentStored.Error)
-//		assert.Equal(t, parent.Id(), parentStored.Resource.Id())
-//		assert.Equal(t, parent.Kind(), parentStored.Resource.Kind())
-//		childStored = aclStore.GetResource(rhabac.GetResourceReq{
-//			Id:   child.Id(),
-//			Kind: child.Kind(),
-//		})
-//		assert.Nil(t, childStored.Error)
-//		assert.Equal(t, child.Id(), childStored.Resource.Id())
-//		assert.Equal(t, child.Kind(), childStored.Resource.Kind())
-//	})
-//
-//	//disconnect user from the group
-//	t.Run("Disconnect resource (no orphan descendants)", func(t *testing.T) {
-//		parent := group
-//		child := user
-//
-//		parentStored := aclStore.GetResource(rhabac.GetResourceReq{
-//			Id:   parent.Id(),
-//			Kind: parent.Kind(),
-//		})
-//		assert.Nil(t, parentStored.Error)
-//		assert.Equal(t, parent.Id(), parentStored.Resource.Id())
-//		assert.Equal(t, parent.Kind(), parentStored.Resource.Kind())
-//		childStored := aclStore.GetResource(rhabac.GetResourceReq{
-//			Id:   child.Id(),
-//			Kind: child.Kind(),
-//		})
-//		assert.Nil(t, childStored.Error)
-//		assert.Equal(t, child.Id(), childStored.Resource.Id())
-//		assert.Equal(t, child.Kind(), childStored.Resource.Kind())
-//
-//		req := syncer.DisconnectResourcesReq{
-//			ReqId:  "1",
-//			Parent: parent,
-//			Child:  child,
-//		}
-//		resp := aclStore.DisconnectResources(rhabac.DisconnectResourcesReq{
-//			Parent:   req.Parent,
-//			Child:    req.Child,
-//			Callback: outboxMessageCallback(req),
-//		})
-//		assert.Nil(t, resp.Error)
-//
-//		orgStored := aclStore.GetResource(rhabac.GetResourceReq{
-//			Id:   org.Id(),
-//			Kind: org.Kind(),
-//		})
-//		assert.Nil(t, orgStored.Error)
-//		assert.Equal(t, org.Id(), orgStored.Resource.Id())
-//		assert.Equal(t, org.Kind(), orgStored.Resource.Kind())
-//		userStored := aclStore.GetResource(rhabac.GetResourceReq{
-//			Id:   user.Id(),
-//			Kind: user.Kind(),
-//		})
-//		assert.Nil(t, userStored.Error)
-//		assert.Equal(t, user.Id(), userStored.Resource.Id())
-//		assert.Equal(t, user.Kind(), userStored.Resource.Kind())
-//		groupStored := aclStore.GetResource(rhabac.GetResourceReq{
-//			Id:   group.Id(),
-//			Kind: group.Kind(),
-//		})
-//		assert.Nil(t, groupStored.Error)
-//		assert.Equal(t, group.Id(), groupStored.Resource.Id())
-//		assert.Equal(t, group.Kind(), groupStored.Resource.Kind())
-//	})
-//
-//	//disconnect user from the organization (user should be deleted)
-//	t.Run("Disconnect resource (orphan descendants)", func(t *testing.T) {
-//		parent := org
-//		child := user
-//
-//		parentStored := aclStore.GetResource(rhabac.GetResourceReq{
-//			Id:   parent.Id(),
-//			Kind: parent.Kind(),
-//		})
-//		assert.Nil(t, parentStored.Error)
-//		assert.Equal(t, parent.Id(), parentStored.Resource.Id())
-//		assert.Equal(t, parent.Kind(), parentStored.Resource.Kind())
-//		childStored := aclStore.GetResource(rhabac.GetResourceReq{
-//			Id:   child.Id(),
-//			Kind: child.Kind(),
-//		})
-//		assert.Nil(t, childStored.Error)
-//		assert.Equal(t, child.Id(), childStored.Resource.Id())
-//		assert.Equal(t, child.Kind(), childStored.Resource.Kind())
-//
-//		req := syncer.DisconnectResourcesReq{
-//			ReqId:  "1",
-//			Parent: parent,
-//			Child:  child,
-//		}
-//		resp := aclStore.DisconnectResources(rhabac.DisconnectResourcesReq{
-//			Parent:   req.Parent,
-//			Child:    req.Child,
-//			Callback: outboxMessageCallback(req),
-//		})
-//		assert.Nil(t, resp.Error)
-//
-//		orgStored := aclStore.GetResource(rhabac.GetResourceReq{
-//			Id:   org.Id(),
-//			Kind: org.Kind(),
-//		})
-//		assert.Nil(t, orgStored.Error)
-//		assert.Equal(t, org.Id(), orgStored.Resource.Id())
-//		assert.Equal(t, org.Kind(), orgStored.Resource.Kind())
-//		userStored := aclStore.GetResource(rhabac.GetResourceReq{
-//			Id:   user.Id(),
-//			Kind: user.Kind(),
-//		})
-//		assert.Nil(t, userStored.Resource)
-//		assert.ErrorIs(t, userStored.Error, rhabac.ErrNotFound)
-//		groupStored := aclStore.GetResource(rhabac.GetResourceReq{
-//			Id:   group.Id(),
-//			Kind: group.Kind(),
-//		})
-//		assert.Nil(t, groupStored.Error)
-//		assert.Equal(t, group.Id(), groupStored.Resource.Id())
-//		assert.Equal(t, group.Kind(), groupStored.Resource.Kind())
-//	})
-//
-//	cleanUpAclStore(t)
-//}
-//
-//func TestResourceAttributes(t *testing.T) {
-//	setUpAclStore(t)
-//
-//	org := model.NewResource("org1", "org")
-//	user := model.NewResource("u1", "user")
-//	group := model.NewResource("g1", "group")
-//	username := model.NewAttribute(model.NewAttributeId("name", model.String), "pera")
-//	username2 := model.NewAttribute(model.NewAttributeId("name", model.String), "mika")
-//	username3 := model.NewAttribute(model.NewAttributeId("name", model.Int64), int64(123))
-//
-//	t.Run("successfully insert attribute of an existing resource", func(t *testing.T) {
-//		parent := org
-//		child := user
-//		attribute := username
-//
-//		parentStored := aclStore.GetResource(rhabac.GetResourceReq{
-//			Id:   parent.Id(),
-//			Kind: parent.Kind(),
-//		})
-//		assert.Nil(t, parentStored.Resource)
-//		assert.ErrorIs(t, parentStored.Error, rhabac.ErrNotFound)
-//		childStored := aclStore.GetResource(rhabac.GetResourceReq{
-//			Id:   child.Id(),
-//			Kind: child.Kind(),
-//		})
-//		assert.Nil(t, childStored.Resource)
-//		assert.ErrorIs(t, childStored.Error, rhabac.ErrNotFound)
-//
-//		req := syncer.ConnectResourcesReq{
-//			ReqId:  "1",
-//			Parent: parent,
-//			Child:  child,
-//		}
-//		resp := aclStore.ConnectResources(rhabac.ConnectResourcesReq{
-//			Parent:   req.Parent,
-//			Child:    req.Child,
-//			Callback: outboxMessageCallback(req),
-//		})
-//		assert.Nil(t, resp.Error)
-//
-//		upsertReq := syncer.UpsertAttributeReq{
-//			ReqId:     "1",
-//			Resource:  child,
-//			Attribute: attribute,
-//		}
-//		upsertResp := aclStore.UpsertAttribute(rhabac.UpsertAttributeReq{
-//			Resource:  upsertReq.Resource,
-//			Attribute: upsertReq.Attribute,
-//			Callback:  outboxMessageCallback(upsertReq),
-//		})
-//		assert.Nil(t, upsertResp.Error)
-//
-//		attrs := aclStore.GetAttributes(rhabac.GetAttributeReq{
-//			Resource: upsertReq.Resource,
-//		})
-//		assert.Nil(t, attrs.Error)
-//		assert.True(t, containsAttribute(attrs.Attributes, attribute))
-//	})
-//
-//	t.Run("unsuccessful insertion attempt, resource does not exist", func(t *testing.T) {
-//		resource := group
-//		attribute := username
-//
-//		parentStored := aclStore.GetResource(rhabac.GetResourceReq{
-//			Id:   resource.Id(),
-//			Kind: resource.Kind(),
-//		})
-//		assert.Nil(t, parentStored.Resource)
-//		assert.ErrorIs(t, parentStored.Error, rhabac.ErrNotFound)
-//
-//		upsertReq := syncer.UpsertAttributeReq{
-//			ReqId:     "2",
-//			Resource:  resource,
-//			Attribute: attribute,
-//		}
-//		upsertResp := aclStore.UpsertAttribute(rhabac.UpsertAttributeReq{
-//			Resource:  upsertReq.Resource,
-//			Attribute: upsertReq.Attribute,
-//			Callback:  outboxMessageCallback(upsertReq),
-//		})
-//		assert.Nil(t, upsertResp.Error)
-//
-//		attrs := aclStore.GetAttributes(rhabac.GetAttributeReq{
-//			Resource: upsertReq.Resource,
-//		})
-//		for _, attr := range attrs.Attributes {
-//			assert.NotEqual(t, attr, attribute)
-//		}
-//	})
-//
-//	t.Run("successfully update an existing attribute (type unchanged)", func(t *testing.T) {
-//		resource := user
-//		attribute := username
-//		updatedAttribute := username2
-//
-//		oldAttrResp := aclStore.GetAttributes(rhabac.GetAttributeReq{
-//			Resource: resource,
-//		})
-//		assert.Nil(t, oldAttrResp.Error)
-//		assert.True(t, containsAttribute(oldAttrResp.Attributes, attribute))
-//
-//		upsertReq := syncer.UpsertAttributeReq{
-//			ReqId:     "2",
-//			Resource:  resource,
-//			Attribute: updatedAttribute,
-//		}
-//		upsertResp := aclStore.UpsertAttribute(rhabac.UpsertAttributeReq{
-//			Resource:  upsertReq.Resource,
-//			Attribute: upsertReq.Attribute,
-//			Callback:  outboxMessageCallback(upsertReq),
-//		})
-//		assert.Nil(t, upsertResp.Error)
-//
-//		attrs := aclStore.GetAttributes(rhabac.GetAttributeReq{
-//			Resource: upsertReq.Resource,
-//		})
-//		assert.True(t, containsAttribute(attrs.Attributes, updatedAttribute))
-//		assert.False(t, containsAttribute(attrs.Attributes, attribute))
-//	})
-//
-//	t.Run("successfully update an existing attribute (type changed)", func(t *testing.T) {
-//		resource := user
-//		attribute := username2
-//		updatedAttribute := username3
-//
-//		oldAttrResp := aclStore.GetAttributes(rhabac.GetAttributeReq{
-//			Resource: resource,
-//		})
-//		assert.Nil(t, oldAttrResp.Error)
-//		assert.True(t, containsAttribute(oldAttrResp.Attributes, attribute))
-//
-//		upsertReq := syncer.UpsertAttributeReq{
-//			ReqId:     "3",
-//			Resource:  resource,
-//			Attribute: updatedAttribute,
-//		}
-//		upsertResp := aclStore.UpsertAttribute(rhabac.UpsertAttributeReq{
-//			Resource:  upsertReq.Resource,
-//			Attribute: upsertReq.Attribute,
-//			Callback:  outboxMessageCallback(upsertReq),
-//		})
-//		assert.Nil(t, upsertResp.Error)
-//
-//		attrs := aclStore.GetAttributes(rhabac.GetAttributeReq{
-//			Resource: upsertReq.Resource,
-//		})
-//		assert.True(t, containsAttribute(attrs.Attributes, updatedAttribute))
-//		assert.False(t, containsAttribute(attrs.Attributes, attribute))
-//	})
-//
-//	cleanUpAclStore(t)
-//}
-//
-//func TestPermissions(t *testing.T) {
-//	setUpAclStore(t)
-//
-//	org := model.NewResource("org1", "org")
-//	user := model.NewResource("u1", "user")
-//	region := model.NewResource("r1", "region")
-//	cluster := model.NewResource("c1", "cluster")
-//	permission := model.NewPermission("cluster.get", model.PermissionKindAllow, model.Condition{})
-//	permission2 := model.NewPermission("cluster.create", model.PermissionKindDeny, model.Condition{})
-//	permission3 := model.NewPermission("cluster.delete", model.PermissionKindDeny, model.Condition{})
-//
-//	req := syncer.ConnectResourcesReq{
-//		ReqId:  "1",
-//		Parent: org,
-//		Child:  user,
-//	}
-//	resp := aclStore.ConnectResources(rhabac.ConnectResourcesReq{
-//		Parent:   req.Parent,
-//		Child:    req.Child,
-//		Callback: outboxMessageCallback(req),
-//	})
-//	assert.Nil(t, resp.Error)
-//	req = syncer.ConnectResourcesReq{
-//		ReqId:  "1",
-//		Parent: region,
-//		Child:  cluster,
-//	}
-//	resp = aclStore.ConnectResources(rhabac.ConnectResourcesReq{
-//		Parent:   req.Parent,
-//		Child:    req.Child,
-//		Callback: outboxMessageCallback(req),
-//	})
-//	assert.Nil(t, resp.Error)
-//	resourceResp := aclStore.GetResource(rhabac.GetResourceReq{
-//		Id:   org.Id(),
-//		Kind: org.Kind(),
-//	})
-//	assert.Equal(t, *resourceResp.Resource, org)
-//	resourceResp = aclStore.GetResource(rhabac.GetResourceReq{
-//		Id:   user.Id(),
-//		Kind: user.Kind(),
-//	})
-//	assert.Equal(t, *resourceResp.Resource, user)
-//	resourceResp = aclStore.GetResource(rhabac.GetResourceReq{
-//		Id:   region.Id(),
-//		Kind: region.Kind(),
-//	})
-//	assert.Equal(t, *resourceResp.Resource, region)
-//	resourceResp = aclStore.GetResource(rhabac.GetResourceReq{
-//		Id:   cluster.Id(),
-//		Kind: cluster.Kind(),
-//	})
-//	assert.Equal(t, *resourceResp.Resource, cluster)
-//
-//	t.Run("direct permission assignment, no inheritance", func(t *testing.T) {
-//		principal := user
-//		resource := cluster
-//		permission := permission
-//
-//		req := syncer.InsertPermissionReq{
-//			ReqId:      "1",
-//			Principal:  principal,
-//			Resource:   resource,
-//			Permission: permission,
-//		}
-//		resp := aclStore.InsertPermission(rhabac.InsertPermissionReq{
-//			Principal:  req.Principal,
-//			Resource:   req.Resource,
-//			Permission: req.Permission,
-//			Callback:   outboxMessageCallback(req),
-//		})
-//		assert.Nil(t, resp.Error)
-//
-//		permissionsResp := aclStore.GetPermissionByPrecedence(rhabac.GetPermissionReq{
-//			Principal:      req.Principal,
-//			Resource:       req.Resource,
-//			PermissionName: req.Permission.Name(),
-//		})
-//		assert.Nil(t, permissionsResp.Error)
-//		assert.True(t, containsPermission(permissionsResp.Hierarchy, permission))
-//		//newly created permission didn't affect any other principal-resource combinations
-//		permissionsResp = aclStore.GetPermissionByPrecedence(rhabac.GetPermissionReq{
-//			Principal:      user,
-//			Resource:       region,
-//			PermissionName: req.Permission.Name(),
-//		})
-//		assert.Nil(t, permissionsResp.Error)
-//		assert.False(t, containsPermission(permissionsResp.Hierarchy, permission))
-//		permissionsResp = aclStore.GetPermissionByPrecedence(rhabac.GetPermissionReq{
-//			Principal:      org,
-//			Resource:       region,
-//			PermissionName: req.Permission.Name(),
-//		})
-//		assert.Nil(t, permissionsResp.Error)
-//		assert.False(t, containsPermission(permissionsResp.Hierarchy, permission))
-//		permissionsResp = aclStore.GetPermissionByPrecedence(rhabac.GetPermissionReq{
-//			Principal:      org,
-//			Resource:       cluster,
-//			PermissionName: req.Permission.Name(),
-//		})
-//		assert.Nil(t, permissionsResp.Error)
-//		assert.False(t, containsPermission(permissionsResp.Hierarchy, permission))
-//	})
-//	t.Run("subject-side inherited permission", func(t *testing.T) {
-//		principal := org
-//		resource := cluster
-//		permission := permission2
-//
-//		req := syncer.InsertPermissionReq{
-//			ReqId:      "2",
-//			Principal:  principal,
-//			Resource:   resource,
-//			Permission: permission,
-//		}
-//		resp := aclStore.InsertPermission(rhabac.InsertPermissionReq{
-//			Principal:  req.Principal,
-//			Resource:   req.Resource,
-//			Permission: req.Permission,
-//			Callback:   outboxMessageCallback(req),
-//		})
-//		assert.Nil(t, resp.Error)
-//
-//		permissionsResp := aclStore.GetPermissionByPrecedence(rhabac.GetPermissionReq{
-//			Principal:      req.Principal,
-//			Resource:       req.Resource,
-//			PermissionName: req.Permission.Name(),
-//		})
-//		assert.Nil(t, permissionsResp.Error)
-//		assert.True(t, containsPermission(permissionsResp.Hierarchy, permission))
-//		// user inherits permissions from org
-//		permissionsResp = aclStore.GetPermissionByPrecedence(rhabac.GetPermissionReq{
-//			Principal:      user,
-//			Resource:       cluster,
-//			PermissionName: req.Permission.Name(),
-//		})
-//		assert.Nil(t, permissionsResp.Error)
-//		assert.True(t, containsPermission(permissionsResp.Hierarchy, permission))
-//		// no inheritance
-//		permissionsResp = aclStore.GetPermissionByPrecedence(rhabac.GetPermissionReq{
-//			Principal:      org,
-//			Resource:       region,
-//			PermissionName: req.Permission.Name(),
-//		})
-//		assert.Nil(t, permissionsResp.Error)
-//		assert.False(t, containsPermission(permissionsResp.Hierarchy, permission))
-//		permissionsResp = aclStore.GetPermissionByPrecedence(rhabac.GetPermissionReq{
-//			Principal:      user,
-//			Resource:       region,
-//			PermissionName: req.Permission.Name(),
-//		})
-//		assert.Nil(t, permissionsResp.Error)
-//		assert.False(t, containsPermission(permissionsResp.Hierarchy, permission))
-//	})
-//	t.Run("object-side inherited permission", func(t *testing.T) {
-//		principal := user
-//		resource := region
-//		permission := permission3
-//
-//		req := syncer.InsertPermissionReq{
-//			ReqId:      "2",
-//			Principal:  principal,
-//			Resource:   resource,
-//			Permission: permission,
-//		}
-//		resp := aclStore.InsertPermission(rhabac.InsertPermissionReq{
-//			Principal:  req.Principal,
-//			Resource:   req.Resource,
-//			Permission: req.Permission,
-//			Callback:   outboxMessageCallback(req),
-//		})
-//		assert.Nil(t, resp.Error)
-//
-//		permissionsResp := aclStore.GetPermissionByPrecedence(rhabac.GetPermissionReq{
-//			Principal:      req.Principal,
-//			Resource:       req.Resource,
-//			PermissionName: req.Permission.Name(),
-//		})
-//		assert.Nil(t, permissionsResp.Error)
-//		assert.True(t, containsPermission(permissionsResp.Hierarchy, permission))
-//		// region-scoped permission -> cluster-scoped permission
-//		permissionsResp = aclStore.GetPermissionByPrecedence(rhabac.GetPermissionReq{
-//			Principal:      user,
-//			Resource:       cluster,
-//			PermissionName: req.Permission.Name(),
-//		})
-//		assert.Nil(t, permissionsResp.Error)
-//		assert.True(t, containsPermission(permissionsResp.Hierarchy, permission))
-//		// no inheritance
-//		permissionsResp = aclStore.GetPermissionByPrecedence(rhabac.GetPermissionReq{
-//			Principal:      org,
-//			Resource:       region,
-//			PermissionName: req.Permission.Name(),
-//		})
-//		assert.Nil(t, permissionsResp.Error)
-//		assert.False(t, containsPermission(permissionsResp.Hierarchy, permission))
-//		permissionsResp = aclStore.GetPermissionByPrecedence(rhabac.GetPermissionReq{
-//			Principal:      org,
-//			Resource:       cluster,
-//			PermissionName: req.Permission.Name(),
-//		})
-//		assert.Nil(t, permissionsResp.Error)
-//		assert.False(t, containsPermission(permissionsResp.Hierarchy, permission))
-//	})
-//	t.Run("successfully deleting permissions", func(t *testing.T) {
-//		principal := user
-//		resource := region
-//		permission := permission3
-//
-//		req := syncer.RemovePermissionReq{
-//			ReqId:      "2",
-//			Principal:  principal,
-//			Resource:   resource,
-//			Permission: permission,
-//		}
-//		resp := aclStore.RemovePermission(rhabac.RemovePermissionReq{
-//			Principal:  req.Principal,
-//			Resource:   req.Resource,
-//			Permission: req.Permission,
-//			Callback:   outboxMessageCallback(req),
-//		})
-//		assert.Nil(t, resp.Error)
-//
-//		permissionsResp := aclStore.GetPermissionByPrecedence(rhabac.GetPermissionReq{
-//			Principal:      req.Principal,
-//			Resource:       req.Resource,
-//			PermissionName: req.Permission.Name(),
-//		})
-//		assert.Nil(t, permissionsResp.Error)
-//		assert.False(t, containsPermission(permissionsResp.Hierarchy, permission))
-//	})
-//
-//	cleanUpAclStore(t)
-//}
-//
-//// outboxMessageCallback is a helper function that generates an OutboxMessage based on a sync request
-//// outbox messages serve the purpose of atomically committing changes and publishing events afterwards
-//func outboxMessageCallback(req syncer.Request) func(error) *model.OutboxMessage {
-//	syncRespFactory := syncerpb.NewSyncRespOutboxMessage
-//	return func(err error) *model.OutboxMessage {
-//		if err != nil {
-//			return syncRespFactory(req.Id(), err.Error(), false)
-//		}
-//		return syncRespFactory(req.Id(), "", true)
-//	}
-//}
-//
-//func containsAttribute(list []model.Attribute, attribute model.Attribute) bool {
-//	for _, attr := range list {
-//		if attr == attribute {
-//			return true
-//		}
-//	}
-//	return false
-//}
-//
-//func containsPermission(hierarchy model.PermissionHierarchy, permission model.Permission) bool {
-//	for _, level := range hierarchy {
-//		for _, perm := range level {
-//			if perm == permission {
-//				return true
-//			}
-//		}
-//	}
-//	return false
-//}
+import (
+	"testing"
+
+	"github.com/c12s/oort/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceRelations(t *testing.T) {
+	repo := setUpRepo()
+
+	org := newResource(t, "org1", "org")
+	group := newResource(t, "g1", "group")
+	user := newResource(t, "u1", "user")
+
+	t.Run("Successfully relate nonexistent resources", func(t *testing.T) {
+		assert.Error(t, repo.GetResource(ctx, domain.GetResourceReq{Resource: org}).Error)
+		assert.Error(t, repo.GetResource(ctx, domain.GetResourceReq{Resource: group}).Error)
+
+		relate(t, repo, org, group)
+
+		assert.NoError(t, repo.GetResource(ctx, domain.GetResourceReq{Resource: org}).Error)
+		assert.NoError(t, repo.GetResource(ctx, domain.GetResourceReq{Resource: group}).Error)
+		assert.True(t, inherits(t, repo, group, org))
+	})
+
+	t.Run("Successfully relate a nonexistent child to an existing parent", func(t *testing.T) {
+		assert.Error(t, repo.GetResource(ctx, domain.GetResourceReq{Resource: user}).Error)
+
+		relate(t, repo, group, user)
+
+		assert.NoError(t, repo.GetResource(ctx, domain.GetResourceReq{Resource: user}).Error)
+		assert.True(t, inherits(t, repo, user, group))
+		assert.True(t, inherits(t, repo, user, org))
+	})
+
+	t.Run("Successfully relate existing resources", func(t *testing.T) {
+		relate(t, repo, org, user)
+
+		assert.True(t, inherits(t, repo, user, org))
+	})
+
+	t.Run("Delete relation (other parents remain)", func(t *testing.T) {
+		resp := repo.DeleteRelation(ctx, domain.DeleteRelationReq{From: group, To: user, Type: domain.InheritsFrom})
+		require.NoError(t, resp.Error)
+
+		assert.False(t, inherits(t, repo, user, group))
+		assert.True(t, inherits(t, repo, user, org))
+		assert.NoError(t, repo.GetResource(ctx, domain.GetResourceReq{Resource: group}).Error)
+	})
+
+	t.Run("Delete relation (only the root remains)", func(t *testing.T) {
+		resp := repo.DeleteRelation(ctx, domain.DeleteRelationReq{From: org, To: user, Type: domain.InheritsFrom})
+		require.NoError(t, resp.Error)
+
+		// every resource inherits from the root, so the child isn't orphaned
+		assert.NoError(t, repo.GetResource(ctx, domain.GetResourceReq{Resource: user}).Error)
+		assert.False(t, inherits(t, repo, user, org))
+		assert.True(t, inherits(t, repo, user, domain.RootResource))
+	})
+}
+
+func TestResourceAttributes(t *testing.T) {
+	repo := setUpRepo()
+
+	org := newResource(t, "org1", "org")
+	user := newResource(t, "u1", "user")
+	group := newResource(t, "g1", "group")
+	username := newAttribute(t, "name", domain.String, "pera")
+	username2 := newAttribute(t, "name", domain.String, "mika")
+	username3 := newAttribute(t, "name", domain.Int64, int64(123))
+
+	t.Run("Successfully put attribute of an existing resource", func(t *testing.T) {
+		relate(t, repo, org, user)
+
+		resp := repo.PutAttribute(ctx, domain.PutAttributeReq{Resource: user, Attribute: username})
+		require.NoError(t, resp.Error)
+
+		assert.True(t, containsAttribute(attributes(t, repo, user), username))
+	})
+
+	t.Run("Successfully put attribute of a nonexistent resource", func(t *testing.T) {
+		assert.Error(t, repo.GetResource(ctx, domain.GetResourceReq{Resource: group}).Error)
+
+		resp := repo.PutAttribute(ctx, domain.PutAttributeReq{Resource: group, Attribute: username})
+		require.NoError(t, resp.Error)
+
+		assert.True(t, containsAttribute(attributes(t, repo, group), username))
+	})
+
+	t.Run("Successfully update an existing attribute (kind unchanged)", func(t *testing.T) {
+		resp := repo.PutAttribute(ctx, domain.PutAttributeReq{Resource: user, Attribute: username2})
+		require.NoError(t, resp.Error)
+
+		attrs := attributes(t, repo, user)
+		assert.True(t, containsAttribute(attrs, username2))
+		assert.False(t, containsAttribute(attrs, username))
+	})
+
+	t.Run("Successfully update an existing attribute (kind changed)", func(t *testing.T) {
+		resp := repo.PutAttribute(ctx, domain.PutAttributeReq{Resource: user, Attribute: username3})
+		require.NoError(t, resp.Error)
+
+		attrs := attributes(t, repo, user)
+		assert.True(t, containsAttribute(attrs, username3))
+		assert.False(t, containsAttribute(attrs, username2))
+	})
+
+	t.Run("Successfully delete an attribute", func(t *testing.T) {
+		id, err := domain.NewAttributeId("name")
+		require.NoError(t, err)
+		resp := repo.DeleteAttribute(ctx, domain.DeleteAttributeReq{Resource: user, AttributeId: *id})
+		require.NoError(t, resp.Error)
+
+		assert.Empty(t, attributes(t, repo, user))
+		assert.True(t, containsAttribute(attributes(t, repo, group), username))
+	})
+}
+
+func TestPermissions(t *testing.T) {
+	repo := setUpRepo()
+
+	org := newResource(t, "org1", "org")
+	user := newResource(t, "u1", "user")
+	region := newResource(t, "r1", "region")
+	cluster := newResource(t, "c1", "cluster")
+	permission := newPermission(t, "cluster.get", domain.PermissionKindAllow)
+	permission2 := newPermission(t, "cluster.create", domain.PermissionKindDeny)
+	permission3 := newPermission(t, "cluster.delete", domain.PermissionKindDeny)
+
+	relate(t, repo, org, user)
+	relate(t, repo, region, cluster)
+
+	t.Run("Direct policy, no inheritance", func(t *testing.T) {
+		createPolicy(t, repo, user, cluster, permission)
+
+		assert.True(t, containsPermission(hierarchy(t, repo, user, cluster, permission.Name()), permission))
+		// the policy doesn't affect any other subject-object combination
+		assert.False(t, containsPermission(hierarchy(t, repo, user, region, permission.Name()), permission))
+		assert.False(t, containsPermission(hierarchy(t, repo, org, region, permission.Name()), permission))
+		assert.False(t, containsPermission(hierarchy(t, repo, org, cluster, permission.Name()), permission))
+	})
+
+	t.Run("Subject-side inherited policy", func(t *testing.T) {
+		createPolicy(t, repo, org, cluster, permission2)
+
+		assert.True(t, containsPermission(hierarchy(t, repo, org, cluster, permission2.Name()), permission2))
+		// user inherits the policies of org
+		assert.True(t, containsPermission(hierarchy(t, repo, user, cluster, permission2.Name()), permission2))
+		assert.False(t, containsPermission(hierarchy(t, repo, org, region, permission2.Name()), permission2))
+		assert.False(t, containsPermission(hierarchy(t, repo, user, region, permission2.Name()), permission2))
+	})
+
+	t.Run("Object-side inherited policy", func(t *testing.T) {
+		createPolicy(t, repo, user, region, permission3)
+
+		assert.True(t, containsPermission(hierarchy(t, repo, user, region, permission3.Name()), permission3))
+		// policies on region apply to cluster
+		assert.True(t, containsPermission(hierarchy(t, repo, user, cluster, permission3.Name()), permission3))
+		assert.False(t, containsPermission(hierarchy(t, repo, org, region, permission3.Name()), permission3))
+		assert.False(t, containsPermission(hierarchy(t, repo, org, cluster, permission3.Name()), permission3))
+	})
+
+	t.Run("Successfully delete a policy", func(t *testing.T) {
+		resp := repo.DeletePolicy(ctx, domain.DeletePolicyReq{
+			SubjectScope: user,
+			ObjectScope:  region,
+			Permission:   permission3,
+		})
+		require.NoError(t, resp.Error)
+
+		assert.False(t, containsPermission(hierarchy(t, repo, user, region, permission3.Name()), permission3))
+		assert.False(t, containsPermission(hierarchy(t, repo, user, cluster, permission3.Name()), permission3))
+	})
+}
+
+func inherits(t *testing.T, repo domain.RHABACRepo, child, ancestor domain.Resource) bool {
+	resp := repo.GetAncestorAttributes(ctx, domain.GetAncestorAttributesReq{Resource: child})
+	require.NoError(t, resp.Error)
+	for _, ancestorAttrs := range resp.Ancestors {
+		if ancestorAttrs.Ancestor.Name() == ancestor.Name() {
+			return true
+		}
+	}
+	return false
+}
+
+func attributes(t *testing.T, repo domain.RHABACRepo, resource domain.Resource) []domain.Attribute {
+	resp := repo.GetResource(ctx, domain.GetResourceReq{Resource: resource})
+	require.NoError(t, resp.Error)
+	return resp.Resource.Attributes
+}
+
+func hierarchy(t *testing.T, repo domain.RHABACRepo, subject, object domain.Resource, permissionName string) domain.PermissionHierarchy {
+	resp := repo.GetPermissionHierarchy(ctx, domain.GetPermissionHierarchyReq{
+		Subject:        subject,
+		Object:         object,
+		PermissionName: permissionName,
+	})
+	require.NoError(t, resp.Error)
+	return resp.Hierarchy
+}
+
+func containsAttribute(list []domain.Attribute, attribute domain.Attribute) bool {
+	for _, attr := range list {
+		if attr.Name() == attribute.Name() && attr.Kind() == attribute.Kind() && attr.Value() == attribute.Value() {
+			return true
+		}
+	}
+	return false
+}
+
+func containsPermission(hierarchy domain.PermissionHierarchy, permission domain.Permission) bool {
+	for _, objHierarchy := range hierarchy {
+		for _, level := range objHierarchy {
+			for _, perm := range level {
+				if perm.Name() == permission.Name() && perm.Kind() == permission.Kind() {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}